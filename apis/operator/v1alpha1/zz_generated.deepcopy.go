@@ -65,6 +65,105 @@ func (in *EnrichmentSpec) DeepCopy() *EnrichmentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIngestionAuthentication) DeepCopyInto(out *ExternalIngestionAuthentication) {
+	*out = *in
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.MTLS != nil {
+		in, out := &in.MTLS, &out.MTLS
+		*out = new(ExternalIngestionMTLS)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIngestionAuthentication.
+func (in *ExternalIngestionAuthentication) DeepCopy() *ExternalIngestionAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIngestionAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIngestionMTLS) DeepCopyInto(out *ExternalIngestionMTLS) {
+	*out = *in
+	out.ClientCA = in.ClientCA
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIngestionMTLS.
+func (in *ExternalIngestionMTLS) DeepCopy() *ExternalIngestionMTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIngestionMTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIngestionService) DeepCopyInto(out *ExternalIngestionService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIngestionService.
+func (in *ExternalIngestionService) DeepCopy() *ExternalIngestionService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIngestionService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIngestionSpec) DeepCopyInto(out *ExternalIngestionSpec) {
+	*out = *in
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ExternalIngestionTLS)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ExternalIngestionService)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIngestionSpec.
+func (in *ExternalIngestionSpec) DeepCopy() *ExternalIngestionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIngestionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIngestionTLS) DeepCopyInto(out *ExternalIngestionTLS) {
+	*out = *in
+	out.Cert = in.Cert
+	out.Key = in.Key
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIngestionTLS.
+func (in *ExternalIngestionTLS) DeepCopy() *ExternalIngestionTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIngestionTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayEndpoints) DeepCopyInto(out *GatewayEndpoints) {
 	*out = *in
@@ -207,6 +306,161 @@ func (in *OTLPEndpoints) DeepCopy() *OTLPEndpoints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPGatewaySpec) DeepCopyInto(out *OTLPGatewaySpec) {
+	*out = *in
+	if in.ExternalIngestion != nil {
+		in, out := &in.ExternalIngestion, &out.ExternalIngestion
+		*out = new(ExternalIngestionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Receiver != nil {
+		in, out := &in.Receiver, &out.Receiver
+		*out = new(OTLPReceiverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPGatewaySpec.
+func (in *OTLPGatewaySpec) DeepCopy() *OTLPGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverCORS) DeepCopyInto(out *OTLPReceiverCORS) {
+	*out = *in
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHeaders != nil {
+		in, out := &in.AllowedHeaders, &out.AllowedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverCORS.
+func (in *OTLPReceiverCORS) DeepCopy() *OTLPReceiverCORS {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverCORS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverGRPCSpec) DeepCopyInto(out *OTLPReceiverGRPCSpec) {
+	*out = *in
+	if in.MaxRecvMsgSizeMiB != nil {
+		in, out := &in.MaxRecvMsgSizeMiB, &out.MaxRecvMsgSizeMiB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(OTLPReceiverKeepalive)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverGRPCSpec.
+func (in *OTLPReceiverGRPCSpec) DeepCopy() *OTLPReceiverGRPCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverGRPCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverHTTPSpec) DeepCopyInto(out *OTLPReceiverHTTPSpec) {
+	*out = *in
+	if in.MaxRequestBodySizeMiB != nil {
+		in, out := &in.MaxRequestBodySizeMiB, &out.MaxRequestBodySizeMiB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(OTLPReceiverCORS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverHTTPSpec.
+func (in *OTLPReceiverHTTPSpec) DeepCopy() *OTLPReceiverHTTPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverHTTPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverKeepalive) DeepCopyInto(out *OTLPReceiverKeepalive) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionAge != nil {
+		in, out := &in.MaxConnectionAge, &out.MaxConnectionAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverKeepalive.
+func (in *OTLPReceiverKeepalive) DeepCopy() *OTLPReceiverKeepalive {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverKeepalive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverSpec) DeepCopyInto(out *OTLPReceiverSpec) {
+	*out = *in
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(OTLPReceiverGRPCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(OTLPReceiverHTTPSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverSpec.
+func (in *OTLPReceiverSpec) DeepCopy() *OTLPReceiverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodLabel) DeepCopyInto(out *PodLabel) {
 	*out = *in
@@ -242,6 +496,21 @@ func (in *Scaling) DeepCopy() *Scaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticScaling) DeepCopyInto(out *StaticScaling) {
 	*out = *in
@@ -354,6 +623,11 @@ func (in *TelemetrySpec) DeepCopyInto(out *TelemetrySpec) {
 		*out = new(EnrichmentSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLPGateway != nil {
+		in, out := &in.OTLPGateway, &out.OTLPGateway
+		*out = new(OTLPGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetrySpec.
@@ -74,6 +74,10 @@ type TelemetrySpec struct {
 	// Enrichments configures optional enrichments of all telemetry data collected by pipelines. This field is optional.
 	// +kubebuilder:validation:Optional
 	Enrichments *EnrichmentSpec `json:"enrichments,omitempty"`
+
+	// OTLPGateway configures the OTLP Gateway, which receives OTLP data for all pipelines. This field is optional.
+	// +kubebuilder:validation:Optional
+	OTLPGateway *OTLPGatewaySpec `json:"otlpGateway,omitempty"`
 }
 
 // MetricSpec configures module settings specific to the metric features.
@@ -202,6 +206,177 @@ type StaticScaling struct {
 	Replicas int32 `json:"replicas,omitempty"`
 }
 
+// OTLPGatewaySpec configures the OTLP Gateway, which receives OTLP data for all pipelines.
+type OTLPGatewaySpec struct {
+	// ExternalIngestion enables an authenticated OTLP endpoint for senders outside the cluster, such as VMs or edge devices.
+	// +kubebuilder:validation:Optional
+	ExternalIngestion *ExternalIngestionSpec `json:"externalIngestion,omitempty"`
+
+	// Receiver configures the OTLP receiver that accepts OTLP data from senders inside the cluster for all signal types.
+	// +kubebuilder:validation:Optional
+	Receiver *OTLPReceiverSpec `json:"receiver,omitempty"`
+}
+
+// OTLPReceiverSpec configures the OTLP receiver of the OTLP Gateway.
+type OTLPReceiverSpec struct {
+	// GRPC configures the OTLP/gRPC endpoint.
+	// +kubebuilder:validation:Optional
+	GRPC *OTLPReceiverGRPCSpec `json:"grpc,omitempty"`
+
+	// HTTP configures the OTLP/HTTP endpoint.
+	// +kubebuilder:validation:Optional
+	HTTP *OTLPReceiverHTTPSpec `json:"http,omitempty"`
+}
+
+// OTLPReceiverGRPCSpec configures the OTLP/gRPC endpoint of the OTLP Gateway.
+type OTLPReceiverGRPCSpec struct {
+	// MaxRecvMsgSizeMiB is the maximum size of a received message in MiB. Larger messages are rejected. Default is 4.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	MaxRecvMsgSizeMiB *int32 `json:"maxRecvMsgSizeMiB,omitempty"`
+
+	// Keepalive configures the keepalive behavior of the gRPC server.
+	// +kubebuilder:validation:Optional
+	Keepalive *OTLPReceiverKeepalive `json:"keepalive,omitempty"`
+}
+
+// OTLPReceiverKeepalive configures the keepalive behavior of the gRPC server. The values are duration strings (for example, "30s", "5m").
+type OTLPReceiverKeepalive struct {
+	// Time is the duration after which the server pings an idle client to check whether the connection is still alive.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self >= duration('1s')",message="'time' must be at least 1s"
+	Time *metav1.Duration `json:"time,omitempty"`
+
+	// Timeout is the duration the server waits for the response to a ping before it closes the connection.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self >= duration('1s')",message="'timeout' must be at least 1s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// MaxConnectionAge is the maximum duration of a connection. Closing long-lived connections lets clients reconnect, so that the load is spread across all OTLP Gateway instances.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self >= duration('1s')",message="'maxConnectionAge' must be at least 1s"
+	MaxConnectionAge *metav1.Duration `json:"maxConnectionAge,omitempty"`
+}
+
+// OTLPReceiverHTTPSpec configures the OTLP/HTTP endpoint of the OTLP Gateway.
+// The endpoint accepts Protobuf and JSON payloads on the standard paths. A path that accepts only JSON is not offered, because the OTLP receiver selects the encoding per request.
+type OTLPReceiverHTTPSpec struct {
+	// MaxRequestBodySizeMiB is the maximum size of a request body in MiB. Larger requests are rejected. Default is 20.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	MaxRequestBodySizeMiB *int32 `json:"maxRequestBodySizeMiB,omitempty"`
+
+	// CORS configures cross-origin resource sharing, so that browser clients served from other origins can send OTLP data.
+	// +kubebuilder:validation:Optional
+	CORS *OTLPReceiverCORS `json:"cors,omitempty"`
+}
+
+// OTLPReceiverCORS configures cross-origin resource sharing for the OTLP/HTTP endpoint.
+type OTLPReceiverCORS struct {
+	// AllowedOrigins lists the origins that can send cross-origin requests, for example, `https://app.example.com`. An origin can contain one wildcard, for example, `https://*.example.com`. Use `*` to allow all origins.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^(\*|https?://[^/\s]+)$`
+	AllowedOrigins []string `json:"allowedOrigins"`
+
+	// AllowedHeaders lists the additional request headers that browser clients can send. The headers required by OTLP are always allowed.
+	// +kubebuilder:validation:Optional
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+}
+
+// ExternalIngestionSpec configures an authenticated OTLP endpoint for senders outside the cluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication.mtls) || has(self.tls)", message="'tls' must be defined if 'authentication.mtls' is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication.bearerToken) || has(self.tls)", message="'tls' must be defined if 'authentication.bearerToken' is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.service) || self.service.type != 'LoadBalancer' || has(self.tls)", message="'tls' must be defined if the service type is 'LoadBalancer'"
+type ExternalIngestionSpec struct {
+	// Tenant identifies the external senders. The value is added to all ingested data as the resource attribute `tenant.id`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`
+	Tenant string `json:"tenant"`
+
+	// Authentication defines how external senders authenticate. Exactly one of 'bearerToken' or 'mtls' must be defined.
+	// +kubebuilder:validation:Required
+	Authentication ExternalIngestionAuthentication `json:"authentication"`
+
+	// TLS defines the server certificate presented to external senders. Required if 'authentication.mtls' or 'authentication.bearerToken' is used, or if the service type is 'LoadBalancer'.
+	// +kubebuilder:validation:Optional
+	TLS *ExternalIngestionTLS `json:"tls,omitempty"`
+
+	// Service defines how the external OTLP endpoint is exposed. If not defined, a Service of type ClusterIP is created, which you can expose with your own Ingress or API gateway.
+	// +kubebuilder:validation:Optional
+	Service *ExternalIngestionService `json:"service,omitempty"`
+}
+
+// ExternalIngestionAuthentication defines how external senders authenticate.
+// +kubebuilder:validation:XValidation:rule="has(self.bearerToken) != has(self.mtls)", message="Exactly one of 'bearerToken' or 'mtls' must be defined"
+type ExternalIngestionAuthentication struct {
+	// BearerToken references the Secret key holding the token that senders must present in the `Authorization: Bearer <token>` header.
+	// +kubebuilder:validation:Optional
+	BearerToken *SecretKeyRef `json:"bearerToken,omitempty"`
+
+	// MTLS enables mutual TLS authentication. Senders must present a client certificate signed by the referenced CA.
+	// +kubebuilder:validation:Optional
+	MTLS *ExternalIngestionMTLS `json:"mtls,omitempty"`
+}
+
+// ExternalIngestionMTLS defines mutual TLS authentication of external senders.
+type ExternalIngestionMTLS struct {
+	// ClientCA references the Secret key holding the PEM-encoded CA certificate used to verify client certificates.
+	// +kubebuilder:validation:Required
+	ClientCA SecretKeyRef `json:"clientCA"`
+}
+
+// ExternalIngestionTLS defines the server certificate of the external OTLP endpoint.
+type ExternalIngestionTLS struct {
+	// Cert references the Secret key holding the PEM-encoded server certificate.
+	// +kubebuilder:validation:Required
+	Cert SecretKeyRef `json:"cert"`
+
+	// Key references the Secret key holding the PEM-encoded private key of the server certificate.
+	// +kubebuilder:validation:Required
+	Key SecretKeyRef `json:"key"`
+}
+
+// ExternalIngestionService defines the Service exposing the external OTLP endpoint.
+type ExternalIngestionService struct {
+	// Type of the Service. Use `LoadBalancer` to expose the endpoint directly, or `ClusterIP` to expose it with your own Ingress or API gateway. Default is `ClusterIP`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ClusterIP;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	Type ExternalIngestionServiceType `json:"type,omitempty"`
+}
+
+// ExternalIngestionServiceType defines the type of the Service exposing the external OTLP endpoint.
+// +enum
+type ExternalIngestionServiceType string
+
+const (
+	ExternalIngestionServiceTypeClusterIP    ExternalIngestionServiceType = "ClusterIP"
+	ExternalIngestionServiceTypeLoadBalancer ExternalIngestionServiceType = "LoadBalancer"
+)
+
+// SecretKeyRef selects a key of a Secret in the given namespace.
+type SecretKeyRef struct {
+	// Name of the Secret containing the referenced value.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace containing the Secret with the referenced value.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Key defines the name of the attribute of the Secret holding the referenced value.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
 // TelemetryStatus defines the observed state of Telemetry
 type TelemetryStatus struct {
 	Status `json:",inline"`
@@ -56,6 +56,9 @@ type LogPipeline struct {
 // +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.transform))", message="transform is only supported with otlp output"
 // +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.filter))", message="filter is only supported with otlp output"
 // +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.input.otlp))", message="otlp input is only supported with otlp output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.heartbeat))", message="heartbeat is only supported with otlp output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.redaction))", message="redaction is only supported with otlp output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.routes))", message="routes are only supported with otlp output"
 type LogPipelineSpec struct {
 	// Input configures additional inputs for log collection.
 	// +kubebuilder:validation:Optional
@@ -75,12 +78,30 @@ type LogPipelineSpec struct {
 	// FluentBitVariables is a list of mappings from Kubernetes Secret keys to environment variables. Mapped keys are mounted as environment variables, so that they are available as [FluentBitVariables](https://docs.fluentbit.io/manual/administration/configuring-fluent-bit/classic-mode/variables) in the `custom` filters and a `custom` output. Only available when using an output of type `http` and `custom`.
 	// +kubebuilder:validation:Optional
 	FluentBitVariables []FluentBitVariable `json:"variables,omitempty"`
+	// Redaction masks sensitive data before the transforms of the pipeline are applied.
+	// +kubebuilder:validation:Optional
+	Redaction *Redaction `json:"redaction,omitempty"`
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
 	// Filters specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+	// Routes send the telemetry data that matches their conditions to secondary outputs instead of the default output. The routes are evaluated in order after the filters, so that data matching multiple routes is sent to the output of the first matching route. Data that matches no route is sent to the default output. Routes are only supported for pipelines that use the otlp input only, because the agents collecting the other inputs do not apply routes.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Routes []OutputRoute `json:"routes,omitempty"`
+	// Suspend pauses the pipeline without deleting it. If set to `true`, the pipeline is left out of the collector configuration and doesn't count towards the maximum number of pipelines. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+	// Heartbeat configures synthetic heartbeat telemetry, which is sent into the pipeline regularly to verify the delivery to the backend end to end.
+	// +kubebuilder:validation:Optional
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
+
+	// Tap configures the live tap of the pipeline, which streams a sample of the data passing the pipeline for debugging.
+	// +kubebuilder:validation:Optional
+	Tap *Tap `json:"tap,omitempty"`
 }
 
 // LogPipelineInput configures additional inputs for log collection.
@@ -253,4 +274,7 @@ type LogPipelineStatus struct {
 	// Is active when the LogPipeline uses a `custom` output or filter; see [unsupported mode](https://kyma-project.io/external-content/telemetry-manager/docs/user/02-logs.html#unsupported-mode).
 	// +kubebuilder:validation:Optional
 	UnsupportedMode *bool `json:"unsupportedMode,omitempty"`
+	// LastHeartbeatDelivered is the time of the last heartbeat that the exporter of the pipeline sent to the backend. It is only reported if the heartbeat is enabled.
+	// +kubebuilder:validation:Optional
+	LastHeartbeatDelivered *metav1.Time `json:"lastHeartbeatDelivered,omitempty"`
 }
@@ -50,6 +50,7 @@ type MetricPipeline struct {
 }
 
 // MetricPipelineSpec defines the desired state of MetricPipeline.
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.routes))", message="routes are only supported with otlp output"
 type MetricPipelineSpec struct {
 	// Input configures additional inputs for metric collection.
 	// +kubebuilder:validation:Optional
@@ -59,6 +60,10 @@ type MetricPipelineSpec struct {
 	// +kubebuilder:validation:Required
 	Output MetricPipelineOutput `json:"output"`
 
+	// Redaction masks sensitive data before the transforms of the pipeline are applied.
+	// +kubebuilder:validation:Optional
+	Redaction *Redaction `json:"redaction,omitempty"`
+
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
@@ -66,6 +71,43 @@ type MetricPipelineSpec struct {
 	// Filter specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+
+	// Routes send the telemetry data that matches their conditions to secondary outputs instead of the default output. The routes are evaluated in order after the filters, so that data matching multiple routes is sent to the output of the first matching route. Data that matches no route is sent to the default output. Routes are only supported for pipelines that use the otlp input only, because the agents collecting the other inputs do not apply routes.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Routes []OutputRoute `json:"routes,omitempty"`
+
+	// Aggregation reduces the number of data points that the pipeline sends to the backend by downsampling and pre-aggregating metrics.
+	// +kubebuilder:validation:Optional
+	Aggregation *MetricPipelineAggregation `json:"aggregation,omitempty"`
+
+	// Suspend pauses the pipeline without deleting it. If set to `true`, the pipeline is left out of the collector configuration and doesn't count towards the maximum number of pipelines. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Heartbeat configures synthetic heartbeat telemetry, which is sent into the pipeline regularly to verify the delivery to the backend end to end.
+	// +kubebuilder:validation:Optional
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
+
+	// Tap configures the live tap of the pipeline, which streams a sample of the data passing the pipeline for debugging.
+	// +kubebuilder:validation:Optional
+	Tap *Tap `json:"tap,omitempty"`
+}
+
+// MetricPipelineAggregation configures downsampling and pre-aggregation of the metrics that a pipeline sends to the backend. The aggregation applies to every collector instance that runs the pipeline.
+// +kubebuilder:validation:XValidation:rule="has(self.interval) || has(self.dropAttributes)",message="At least one of 'interval' or 'dropAttributes' must be set"
+type MetricPipelineAggregation struct {
+	// Interval specifies how often the aggregated metrics are emitted. Within an interval, only the latest value of cumulative sums, cumulative histograms, and gauges is kept. The value is a duration string (for example, "60s", "5m").
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="'interval' must be greater than 0"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// DropAttributes specifies resource and data point attributes to remove from all metrics, for example, `k8s.container.name` to aggregate container metrics to Pod level. Series that become identical are merged by summing up their values. Summary metrics are not merged.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	DropAttributes []string `json:"dropAttributes,omitempty"`
 }
 
 // MetricPipelineInput configures additional inputs for metric collection.
@@ -82,6 +124,9 @@ type MetricPipelineInput struct {
 	// OTLP input configures the push endpoint to receive metrics from an OTLP source.
 	// +kubebuilder:validation:Optional
 	OTLP *OTLPInput `json:"otlp,omitempty"`
+	// ControlPlane input configures collection of metrics from the Kubernetes control plane and cluster add-ons.
+	// +kubebuilder:validation:Optional
+	ControlPlane *MetricPipelineControlPlaneInput `json:"controlPlane,omitempty"`
 }
 
 // MetricPipelinePrometheusInput collection of application metrics in the pull-based Prometheus protocol using endpoint discovery based on annotations.
@@ -95,6 +140,22 @@ type MetricPipelinePrometheusInput struct {
 	// DiagnosticMetrics configures collection of additional diagnostic metrics. The default is `false`.
 	// +kubebuilder:validation:Optional
 	DiagnosticMetrics *MetricPipelineIstioInputDiagnosticMetrics `json:"diagnosticMetrics,omitempty"`
+	// CollectionInterval defines the scrape interval of the 'prometheus' input for this pipeline, overriding the collection interval configured in the Telemetry CR.
+	// The value is a duration string (for example, "15s", "2m"). Pipelines with different intervals are scraped separately.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="'collectionInterval' must be greater than 0"
+	CollectionInterval *metav1.Duration `json:"collectionInterval,omitempty"`
+	// NativeHistograms configures scraping of Prometheus native histograms, which are collected as exponential histograms.
+	// +kubebuilder:validation:Optional
+	NativeHistograms *MetricPipelinePrometheusInputNativeHistograms `json:"nativeHistograms,omitempty"`
+}
+
+// MetricPipelinePrometheusInputNativeHistograms defines the native histograms configuration section
+type MetricPipelinePrometheusInputNativeHistograms struct {
+	// Enabled specifies that native histograms are scraped from targets that expose them. For these targets, the classic histograms are not collected. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // MetricPipelineRuntimeInput configures collection of Kubernetes runtime metrics.
@@ -113,6 +174,12 @@ type MetricPipelineRuntimeInput struct {
 	// metric name.
 	// +kubebuilder:validation:Optional
 	AdditionalMetrics []string `json:"additionalMetrics,omitempty"`
+	// CollectionInterval defines the scrape interval of the 'runtime' input for this pipeline, overriding the collection interval configured in the Telemetry CR.
+	// The value is a duration string (for example, "15s", "2m"). Pipelines with different intervals are scraped separately.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="'collectionInterval' must be greater than 0"
+	CollectionInterval *metav1.Duration `json:"collectionInterval,omitempty"`
 }
 
 // MetricPipelineRuntimeInputResources configures the Kubernetes resource types for which metrics are collected.
@@ -141,11 +208,62 @@ type MetricPipelineRuntimeInputResources struct {
 	// Job configures Job runtime metrics collection.
 	// +kubebuilder:validation:Optional
 	Job *MetricPipelineRuntimeInputResource `json:"job,omitempty"`
+	// ReplicaSet configures ReplicaSet runtime metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	ReplicaSet *MetricPipelineRuntimeInputResource `json:"replicaset,omitempty"`
+	// CronJob configures CronJob runtime metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	CronJob *MetricPipelineRuntimeInputResource `json:"cronjob,omitempty"`
+	// HorizontalPodAutoscaler configures HorizontalPodAutoscaler runtime metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	HorizontalPodAutoscaler *MetricPipelineRuntimeInputResource `json:"horizontalpodautoscaler,omitempty"`
+	// PersistentVolumeClaim configures PersistentVolumeClaim capacity and phase metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	PersistentVolumeClaim *MetricPipelineRuntimeInputResource `json:"persistentvolumeclaim,omitempty"`
+	// ResourceQuota configures ResourceQuota usage metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	ResourceQuota *MetricPipelineRuntimeInputResource `json:"resourcequota,omitempty"`
+	// Namespace configures Namespace phase metrics collection. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	Namespace *MetricPipelineRuntimeInputResource `json:"namespace,omitempty"`
+	// Host configures the collection of Node host metrics (CPU, memory, load, filesystem, and disk) from the operating system of each Node. The Metric Agent reads them from a read-only mount of the Node root filesystem. The collection is disabled by default.
+	// +kubebuilder:validation:Optional
+	Host *MetricPipelineRuntimeInputResource `json:"host,omitempty"`
 }
 
-// MetricPipelineRuntimeInputResource configures if the collection of runtime metrics is enabled for a specific resource type. The collection is enabled by default.
+// MetricPipelineRuntimeInputResource configures if the collection of runtime metrics is enabled for a specific resource type. The collection is enabled by default, unless stated otherwise for the resource type.
 type MetricPipelineRuntimeInputResource struct {
-	// Enabled specifies that the runtime metrics for the resource type are collected. The default is `true`.
+	// Enabled specifies that the runtime metrics for the resource type are collected. The default is `true`, unless stated otherwise for the resource type.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// MetricPipelineControlPlaneInput configures collection of metrics from the Kubernetes control plane and cluster add-ons.
+type MetricPipelineControlPlaneInput struct {
+	// Enabled specifies if the 'controlPlane' input is enabled. If enabled, a curated set of metrics is scraped from the Kubernetes API server, CoreDNS, and kube-proxy Pods. Each Metric Agent instance scrapes the Pods on its own Node. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Components configures the control plane and cluster add-on components from which metrics are collected.
+	// +kubebuilder:validation:Optional
+	Components *MetricPipelineControlPlaneInputComponents `json:"components,omitempty"`
+}
+
+// MetricPipelineControlPlaneInputComponents configures the control plane and cluster add-on components from which metrics are collected.
+type MetricPipelineControlPlaneInputComponents struct {
+	// APIServer configures metrics collection from the Kubernetes API server.
+	// +kubebuilder:validation:Optional
+	APIServer *MetricPipelineControlPlaneInputComponent `json:"apiServer,omitempty"`
+	// CoreDNS configures metrics collection from the CoreDNS cluster add-on.
+	// +kubebuilder:validation:Optional
+	CoreDNS *MetricPipelineControlPlaneInputComponent `json:"coreDNS,omitempty"`
+	// KubeProxy configures metrics collection from kube-proxy.
+	// +kubebuilder:validation:Optional
+	KubeProxy *MetricPipelineControlPlaneInputComponent `json:"kubeProxy,omitempty"`
+}
+
+// MetricPipelineControlPlaneInputComponent configures if the collection of metrics is enabled for a specific control plane component.
+type MetricPipelineControlPlaneInputComponent struct {
+	// Enabled specifies that the metrics of the component are collected. The default is `true`.
 	// +kubebuilder:validation:Optional
 	Enabled *bool `json:"enabled,omitempty"`
 }
@@ -164,6 +282,12 @@ type MetricPipelineIstioInput struct {
 	// EnvoyMetrics enables the collection of additional Envoy metrics with prefix `envoy_`. The default is `false`.
 	// +kubebuilder:validation:Optional
 	EnvoyMetrics *EnvoyMetrics `json:"envoyMetrics,omitempty"`
+	// CollectionInterval defines the scrape interval of the 'istio' input for this pipeline, overriding the collection interval configured in the Telemetry CR.
+	// The value is a duration string (for example, "15s", "2m"). Pipelines with different intervals are scraped separately.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="'collectionInterval' must be greater than 0"
+	CollectionInterval *metav1.Duration `json:"collectionInterval,omitempty"`
 }
 
 // MetricPipelineIstioInputDiagnosticMetrics defines the diagnostic metrics configuration section
@@ -173,10 +297,40 @@ type MetricPipelineIstioInputDiagnosticMetrics struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+// MetricPipelineOutput configures the backend to which metrics are sent. You must specify exactly one output per pipeline.
+// +kubebuilder:validation:XValidation:rule="(has(self.otlp) ? 1 : 0) + (has(self.prometheus) ? 1 : 0) == 1",message="Exactly one output out of 'otlp' or 'prometheus' must be defined"
 type MetricPipelineOutput struct {
 	// MetricPipeline OTLP output defines a metric pipeline output using the OpenTelemetry protocol.
-	// +kubebuilder:validation:Required
-	OTLP *MetricPipelineOTLPOutput `json:"otlp"`
+	// +kubebuilder:validation:Optional
+	OTLP *MetricPipelineOTLPOutput `json:"otlp,omitempty"`
+	// Prometheus defines an output that exposes the metrics on a Prometheus-compatible endpoint, from which a Prometheus server can scrape them. Only one MetricPipeline in the cluster can have a `prometheus` output.
+	// +kubebuilder:validation:Optional
+	Prometheus *MetricPipelinePrometheusOutput `json:"prometheus,omitempty"`
+}
+
+// MetricPipelinePrometheusOutput configures the Prometheus-compatible endpoint of a MetricPipeline.
+type MetricPipelinePrometheusOutput struct {
+	// ResourceToLabels configures the conversion of resource attributes, such as `k8s.namespace.name`, to metric labels.
+	// +kubebuilder:validation:Optional
+	ResourceToLabels *MetricPipelinePrometheusOutputResourceToLabels `json:"resourceToLabels,omitempty"`
+	// MetricExpiration specifies how long a metric is exposed after its last data point was received. The value is a duration string (for example, "5m", "1h"). The default is `5m`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="'metricExpiration' must be greater than 0"
+	MetricExpiration *metav1.Duration `json:"metricExpiration,omitempty"`
+
+	// Exemplars defines whether exemplars, which link data points to example traces, are exported ('preserve' or 'drop'). `preserve` keeps the exemplars with their trace and span IDs. The default is `preserve`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=preserve
+	// +kubebuilder:validation:Enum=preserve;drop
+	Exemplars *telemetryv1beta1.ExemplarsType `json:"exemplars,omitempty"`
+}
+
+// MetricPipelinePrometheusOutputResourceToLabels defines the resource-to-label conversion configuration section
+type MetricPipelinePrometheusOutputResourceToLabels struct {
+	// Enabled specifies that all resource attributes are added as labels to every exposed metric. If disabled, the resource attributes are only exposed with the `target_info` metric. The default is `true`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 type MetricPipelineOTLPOutput struct {
@@ -187,6 +341,12 @@ type MetricPipelineOTLPOutput struct {
 	// +kubebuilder:default=preserve
 	// +kubebuilder:validation:Enum=preserve;delta
 	Temporality *telemetryv1beta1.TemporalityType `json:"temporality,omitempty"`
+
+	// Exemplars defines whether exemplars, which link data points to example traces, are exported ('preserve' or 'drop'). `preserve` keeps the exemplars with their trace and span IDs. The default is `preserve`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=preserve
+	// +kubebuilder:validation:Enum=preserve;drop
+	Exemplars *telemetryv1beta1.ExemplarsType `json:"exemplars,omitempty"`
 }
 
 // MetricPipelineStatus defines the observed state of MetricPipeline.
@@ -194,6 +354,12 @@ type MetricPipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ScrapeURL is the URL from which a Prometheus server can scrape the metrics of a pipeline with a `prometheus` output. The host name resolves to all Metric Agent and OTLP Gateway Pods, and each Pod exposes the metrics that it processed.
+	// +kubebuilder:validation:Optional
+	ScrapeURL string `json:"scrapeURL,omitempty"`
+	// LastHeartbeatDelivered is the time of the last heartbeat that the exporter of the pipeline sent to the backend. It is only reported if the heartbeat is enabled.
+	// +kubebuilder:validation:Optional
+	LastHeartbeatDelivered *metav1.Time `json:"lastHeartbeatDelivered,omitempty"`
 }
 
 // EnvoyMetrics defines the configuration for scraping Envoy metrics.
@@ -51,6 +51,10 @@ type TracePipelineSpec struct {
 	// +kubebuilder:validation:Required
 	Output TracePipelineOutput `json:"output"`
 
+	// Redaction masks sensitive data before the transforms of the pipeline are applied.
+	// +kubebuilder:validation:Optional
+	Redaction *Redaction `json:"redaction,omitempty"`
+
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
@@ -58,6 +62,24 @@ type TracePipelineSpec struct {
 	// Filter specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+
+	// Routes send the telemetry data that matches their conditions to secondary outputs instead of the default output. The routes are evaluated in order after the filters, so that data matching multiple routes is sent to the output of the first matching route. Data that matches no route is sent to the default output.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Routes []OutputRoute `json:"routes,omitempty"`
+
+	// Suspend pauses the pipeline without deleting it. If set to `true`, the pipeline is left out of the collector configuration and doesn't count towards the maximum number of pipelines. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Heartbeat configures synthetic heartbeat telemetry, which is sent into the pipeline regularly to verify the delivery to the backend end to end.
+	// +kubebuilder:validation:Optional
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
+
+	// Tap configures the live tap of the pipeline, which streams a sample of the data passing the pipeline for debugging.
+	// +kubebuilder:validation:Optional
+	Tap *Tap `json:"tap,omitempty"`
 }
 
 // TracePipelineOutput defines the output configuration section.
@@ -72,4 +94,7 @@ type TracePipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// LastHeartbeatDelivered is the time of the last heartbeat that the exporter of the pipeline sent to the backend. It is only reported if the heartbeat is enabled.
+	// +kubebuilder:validation:Optional
+	LastHeartbeatDelivered *metav1.Time `json:"lastHeartbeatDelivered,omitempty"`
 }
@@ -126,6 +126,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Heartbeat)(nil), (*v1beta1.Heartbeat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Heartbeat_To_v1beta1_Heartbeat(a.(*Heartbeat), b.(*v1beta1.Heartbeat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Heartbeat)(nil), (*Heartbeat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Heartbeat_To_v1alpha1_Heartbeat(a.(*v1beta1.Heartbeat), b.(*Heartbeat), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LogPipeline)(nil), (*v1beta1.LogPipeline)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LogPipeline_To_v1beta1_LogPipeline(a.(*LogPipeline), b.(*v1beta1.LogPipeline), scope)
 	}); err != nil {
@@ -196,6 +206,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelineAggregation)(nil), (*v1beta1.MetricPipelineAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelineAggregation_To_v1beta1_MetricPipelineAggregation(a.(*MetricPipelineAggregation), b.(*v1beta1.MetricPipelineAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineAggregation)(nil), (*MetricPipelineAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineAggregation_To_v1alpha1_MetricPipelineAggregation(a.(*v1beta1.MetricPipelineAggregation), b.(*MetricPipelineAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelineControlPlaneInput)(nil), (*v1beta1.MetricPipelineControlPlaneInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelineControlPlaneInput_To_v1beta1_MetricPipelineControlPlaneInput(a.(*MetricPipelineControlPlaneInput), b.(*v1beta1.MetricPipelineControlPlaneInput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineControlPlaneInput)(nil), (*MetricPipelineControlPlaneInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineControlPlaneInput_To_v1alpha1_MetricPipelineControlPlaneInput(a.(*v1beta1.MetricPipelineControlPlaneInput), b.(*MetricPipelineControlPlaneInput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelineControlPlaneInputComponent)(nil), (*v1beta1.MetricPipelineControlPlaneInputComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelineControlPlaneInputComponent_To_v1beta1_MetricPipelineControlPlaneInputComponent(a.(*MetricPipelineControlPlaneInputComponent), b.(*v1beta1.MetricPipelineControlPlaneInputComponent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineControlPlaneInputComponent)(nil), (*MetricPipelineControlPlaneInputComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineControlPlaneInputComponent_To_v1alpha1_MetricPipelineControlPlaneInputComponent(a.(*v1beta1.MetricPipelineControlPlaneInputComponent), b.(*MetricPipelineControlPlaneInputComponent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelineControlPlaneInputComponents)(nil), (*v1beta1.MetricPipelineControlPlaneInputComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelineControlPlaneInputComponents_To_v1beta1_MetricPipelineControlPlaneInputComponents(a.(*MetricPipelineControlPlaneInputComponents), b.(*v1beta1.MetricPipelineControlPlaneInputComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineControlPlaneInputComponents)(nil), (*MetricPipelineControlPlaneInputComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineControlPlaneInputComponents_To_v1alpha1_MetricPipelineControlPlaneInputComponents(a.(*v1beta1.MetricPipelineControlPlaneInputComponents), b.(*MetricPipelineControlPlaneInputComponents), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricPipelineInput)(nil), (*v1beta1.MetricPipelineInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MetricPipelineInput_To_v1beta1_MetricPipelineInput(a.(*MetricPipelineInput), b.(*v1beta1.MetricPipelineInput), scope)
 	}); err != nil {
@@ -266,6 +316,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelinePrometheusInputNativeHistograms)(nil), (*v1beta1.MetricPipelinePrometheusInputNativeHistograms)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelinePrometheusInputNativeHistograms_To_v1beta1_MetricPipelinePrometheusInputNativeHistograms(a.(*MetricPipelinePrometheusInputNativeHistograms), b.(*v1beta1.MetricPipelinePrometheusInputNativeHistograms), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelinePrometheusInputNativeHistograms)(nil), (*MetricPipelinePrometheusInputNativeHistograms)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelinePrometheusInputNativeHistograms_To_v1alpha1_MetricPipelinePrometheusInputNativeHistograms(a.(*v1beta1.MetricPipelinePrometheusInputNativeHistograms), b.(*MetricPipelinePrometheusInputNativeHistograms), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelinePrometheusOutput)(nil), (*v1beta1.MetricPipelinePrometheusOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelinePrometheusOutput_To_v1beta1_MetricPipelinePrometheusOutput(a.(*MetricPipelinePrometheusOutput), b.(*v1beta1.MetricPipelinePrometheusOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelinePrometheusOutput)(nil), (*MetricPipelinePrometheusOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelinePrometheusOutput_To_v1alpha1_MetricPipelinePrometheusOutput(a.(*v1beta1.MetricPipelinePrometheusOutput), b.(*MetricPipelinePrometheusOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricPipelinePrometheusOutputResourceToLabels)(nil), (*v1beta1.MetricPipelinePrometheusOutputResourceToLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels_To_v1beta1_MetricPipelinePrometheusOutputResourceToLabels(a.(*MetricPipelinePrometheusOutputResourceToLabels), b.(*v1beta1.MetricPipelinePrometheusOutputResourceToLabels), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelinePrometheusOutputResourceToLabels)(nil), (*MetricPipelinePrometheusOutputResourceToLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelinePrometheusOutputResourceToLabels_To_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels(a.(*v1beta1.MetricPipelinePrometheusOutputResourceToLabels), b.(*MetricPipelinePrometheusOutputResourceToLabels), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineRuntimeInput)(nil), (*MetricPipelineRuntimeInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MetricPipelineRuntimeInput_To_v1alpha1_MetricPipelineRuntimeInput(a.(*v1beta1.MetricPipelineRuntimeInput), b.(*MetricPipelineRuntimeInput), scope)
 	}); err != nil {
@@ -336,6 +416,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OutputRoute)(nil), (*v1beta1.OutputRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(a.(*OutputRoute), b.(*v1beta1.OutputRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.OutputRoute)(nil), (*OutputRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(a.(*v1beta1.OutputRoute), b.(*OutputRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutputRouteOutput)(nil), (*v1beta1.OutputRouteOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput(a.(*OutputRouteOutput), b.(*v1beta1.OutputRouteOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.OutputRouteOutput)(nil), (*OutputRouteOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput(a.(*v1beta1.OutputRouteOutput), b.(*OutputRouteOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProxyAuthenticationOptions)(nil), (*v1beta1.ProxyAuthenticationOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProxyAuthenticationOptions_To_v1beta1_ProxyAuthenticationOptions(a.(*ProxyAuthenticationOptions), b.(*v1beta1.ProxyAuthenticationOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ProxyAuthenticationOptions)(nil), (*ProxyAuthenticationOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProxyAuthenticationOptions_To_v1alpha1_ProxyAuthenticationOptions(a.(*v1beta1.ProxyAuthenticationOptions), b.(*ProxyAuthenticationOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Redaction)(nil), (*v1beta1.Redaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Redaction_To_v1beta1_Redaction(a.(*Redaction), b.(*v1beta1.Redaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Redaction)(nil), (*Redaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Redaction_To_v1alpha1_Redaction(a.(*v1beta1.Redaction), b.(*Redaction), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretKeyRef)(nil), (*v1beta1.SecretKeyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(a.(*SecretKeyRef), b.(*v1beta1.SecretKeyRef), scope)
 	}); err != nil {
@@ -346,6 +466,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServiceAccountTokenAuthOptions)(nil), (*v1beta1.ServiceAccountTokenAuthOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceAccountTokenAuthOptions_To_v1beta1_ServiceAccountTokenAuthOptions(a.(*ServiceAccountTokenAuthOptions), b.(*v1beta1.ServiceAccountTokenAuthOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ServiceAccountTokenAuthOptions)(nil), (*ServiceAccountTokenAuthOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceAccountTokenAuthOptions_To_v1alpha1_ServiceAccountTokenAuthOptions(a.(*v1beta1.ServiceAccountTokenAuthOptions), b.(*ServiceAccountTokenAuthOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Tap)(nil), (*v1beta1.Tap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Tap_To_v1beta1_Tap(a.(*Tap), b.(*v1beta1.Tap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Tap)(nil), (*Tap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Tap_To_v1alpha1_Tap(a.(*v1beta1.Tap), b.(*Tap), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TracePipeline)(nil), (*v1beta1.TracePipeline)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TracePipeline_To_v1beta1_TracePipeline(a.(*TracePipeline), b.(*v1beta1.TracePipeline), scope)
 	}); err != nil {
@@ -472,6 +612,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha1_AuthenticationOptions_To_v1beta1_AuthenticationOptions(in *AuthenticationOptions, out *v1beta1.AuthenticationOptions, s conversion.Scope) error {
 	out.Basic = (*v1beta1.BasicAuthOptions)(unsafe.Pointer(in.Basic))
 	out.OAuth2 = (*v1beta1.OAuth2Options)(unsafe.Pointer(in.OAuth2))
+	out.ServiceAccountToken = (*v1beta1.ServiceAccountTokenAuthOptions)(unsafe.Pointer(in.ServiceAccountToken))
 	return nil
 }
 
@@ -483,6 +624,7 @@ func Convert_v1alpha1_AuthenticationOptions_To_v1beta1_AuthenticationOptions(in
 func autoConvert_v1beta1_AuthenticationOptions_To_v1alpha1_AuthenticationOptions(in *v1beta1.AuthenticationOptions, out *AuthenticationOptions, s conversion.Scope) error {
 	out.Basic = (*BasicAuthOptions)(unsafe.Pointer(in.Basic))
 	out.OAuth2 = (*OAuth2Options)(unsafe.Pointer(in.OAuth2))
+	out.ServiceAccountToken = (*ServiceAccountTokenAuthOptions)(unsafe.Pointer(in.ServiceAccountToken))
 	return nil
 }
 
@@ -701,6 +843,26 @@ func Convert_v1beta1_Header_To_v1alpha1_Header(in *v1beta1.Header, out *Header,
 	return autoConvert_v1beta1_Header_To_v1alpha1_Header(in, out, s)
 }
 
+func autoConvert_v1alpha1_Heartbeat_To_v1beta1_Heartbeat(in *Heartbeat, out *v1beta1.Heartbeat, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_Heartbeat_To_v1beta1_Heartbeat is an autogenerated conversion function.
+func Convert_v1alpha1_Heartbeat_To_v1beta1_Heartbeat(in *Heartbeat, out *v1beta1.Heartbeat, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Heartbeat_To_v1beta1_Heartbeat(in, out, s)
+}
+
+func autoConvert_v1beta1_Heartbeat_To_v1alpha1_Heartbeat(in *v1beta1.Heartbeat, out *Heartbeat, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_Heartbeat_To_v1alpha1_Heartbeat is an autogenerated conversion function.
+func Convert_v1beta1_Heartbeat_To_v1alpha1_Heartbeat(in *v1beta1.Heartbeat, out *Heartbeat, s conversion.Scope) error {
+	return autoConvert_v1beta1_Heartbeat_To_v1alpha1_Heartbeat(in, out, s)
+}
+
 func autoConvert_v1alpha1_LogPipeline_To_v1beta1_LogPipeline(in *LogPipeline, out *v1beta1.LogPipeline, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_LogPipelineSpec_To_v1beta1_LogPipelineSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -891,8 +1053,23 @@ func autoConvert_v1alpha1_LogPipelineSpec_To_v1beta1_LogPipelineSpec(in *LogPipe
 	}
 	out.FluentBitFiles = *(*[]v1beta1.FluentBitFile)(unsafe.Pointer(&in.FluentBitFiles))
 	out.FluentBitVariables = *(*[]v1beta1.FluentBitVariable)(unsafe.Pointer(&in.FluentBitVariables))
+	out.Redaction = (*v1beta1.Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]v1beta1.TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]v1beta1.FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]v1beta1.OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*v1beta1.Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*v1beta1.Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -911,8 +1088,23 @@ func autoConvert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in *v1beta1
 	}
 	out.FluentBitFiles = *(*[]FluentBitFile)(unsafe.Pointer(&in.FluentBitFiles))
 	out.FluentBitVariables = *(*[]FluentBitVariable)(unsafe.Pointer(&in.FluentBitVariables))
+	out.Redaction = (*Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -924,6 +1116,7 @@ func Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in *v1beta1.Log
 func autoConvert_v1alpha1_LogPipelineStatus_To_v1beta1_LogPipelineStatus(in *LogPipelineStatus, out *v1beta1.LogPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.UnsupportedMode = (*bool)(unsafe.Pointer(in.UnsupportedMode))
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -935,6 +1128,7 @@ func Convert_v1alpha1_LogPipelineStatus_To_v1beta1_LogPipelineStatus(in *LogPipe
 func autoConvert_v1beta1_LogPipelineStatus_To_v1alpha1_LogPipelineStatus(in *v1beta1.LogPipelineStatus, out *LogPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.UnsupportedMode = (*bool)(unsafe.Pointer(in.UnsupportedMode))
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -975,6 +1169,94 @@ func Convert_v1beta1_MetricPipeline_To_v1alpha1_MetricPipeline(in *v1beta1.Metri
 	return autoConvert_v1beta1_MetricPipeline_To_v1alpha1_MetricPipeline(in, out, s)
 }
 
+func autoConvert_v1alpha1_MetricPipelineAggregation_To_v1beta1_MetricPipelineAggregation(in *MetricPipelineAggregation, out *v1beta1.MetricPipelineAggregation, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.DropAttributes = *(*[]string)(unsafe.Pointer(&in.DropAttributes))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelineAggregation_To_v1beta1_MetricPipelineAggregation is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelineAggregation_To_v1beta1_MetricPipelineAggregation(in *MetricPipelineAggregation, out *v1beta1.MetricPipelineAggregation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelineAggregation_To_v1beta1_MetricPipelineAggregation(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelineAggregation_To_v1alpha1_MetricPipelineAggregation(in *v1beta1.MetricPipelineAggregation, out *MetricPipelineAggregation, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.DropAttributes = *(*[]string)(unsafe.Pointer(&in.DropAttributes))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelineAggregation_To_v1alpha1_MetricPipelineAggregation is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelineAggregation_To_v1alpha1_MetricPipelineAggregation(in *v1beta1.MetricPipelineAggregation, out *MetricPipelineAggregation, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineAggregation_To_v1alpha1_MetricPipelineAggregation(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricPipelineControlPlaneInput_To_v1beta1_MetricPipelineControlPlaneInput(in *MetricPipelineControlPlaneInput, out *v1beta1.MetricPipelineControlPlaneInput, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Components = (*v1beta1.MetricPipelineControlPlaneInputComponents)(unsafe.Pointer(in.Components))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelineControlPlaneInput_To_v1beta1_MetricPipelineControlPlaneInput is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelineControlPlaneInput_To_v1beta1_MetricPipelineControlPlaneInput(in *MetricPipelineControlPlaneInput, out *v1beta1.MetricPipelineControlPlaneInput, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelineControlPlaneInput_To_v1beta1_MetricPipelineControlPlaneInput(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelineControlPlaneInput_To_v1alpha1_MetricPipelineControlPlaneInput(in *v1beta1.MetricPipelineControlPlaneInput, out *MetricPipelineControlPlaneInput, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Components = (*MetricPipelineControlPlaneInputComponents)(unsafe.Pointer(in.Components))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelineControlPlaneInput_To_v1alpha1_MetricPipelineControlPlaneInput is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelineControlPlaneInput_To_v1alpha1_MetricPipelineControlPlaneInput(in *v1beta1.MetricPipelineControlPlaneInput, out *MetricPipelineControlPlaneInput, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineControlPlaneInput_To_v1alpha1_MetricPipelineControlPlaneInput(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricPipelineControlPlaneInputComponent_To_v1beta1_MetricPipelineControlPlaneInputComponent(in *MetricPipelineControlPlaneInputComponent, out *v1beta1.MetricPipelineControlPlaneInputComponent, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelineControlPlaneInputComponent_To_v1beta1_MetricPipelineControlPlaneInputComponent is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelineControlPlaneInputComponent_To_v1beta1_MetricPipelineControlPlaneInputComponent(in *MetricPipelineControlPlaneInputComponent, out *v1beta1.MetricPipelineControlPlaneInputComponent, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelineControlPlaneInputComponent_To_v1beta1_MetricPipelineControlPlaneInputComponent(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelineControlPlaneInputComponent_To_v1alpha1_MetricPipelineControlPlaneInputComponent(in *v1beta1.MetricPipelineControlPlaneInputComponent, out *MetricPipelineControlPlaneInputComponent, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelineControlPlaneInputComponent_To_v1alpha1_MetricPipelineControlPlaneInputComponent is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelineControlPlaneInputComponent_To_v1alpha1_MetricPipelineControlPlaneInputComponent(in *v1beta1.MetricPipelineControlPlaneInputComponent, out *MetricPipelineControlPlaneInputComponent, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineControlPlaneInputComponent_To_v1alpha1_MetricPipelineControlPlaneInputComponent(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricPipelineControlPlaneInputComponents_To_v1beta1_MetricPipelineControlPlaneInputComponents(in *MetricPipelineControlPlaneInputComponents, out *v1beta1.MetricPipelineControlPlaneInputComponents, s conversion.Scope) error {
+	out.APIServer = (*v1beta1.MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.APIServer))
+	out.CoreDNS = (*v1beta1.MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.CoreDNS))
+	out.KubeProxy = (*v1beta1.MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.KubeProxy))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelineControlPlaneInputComponents_To_v1beta1_MetricPipelineControlPlaneInputComponents is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelineControlPlaneInputComponents_To_v1beta1_MetricPipelineControlPlaneInputComponents(in *MetricPipelineControlPlaneInputComponents, out *v1beta1.MetricPipelineControlPlaneInputComponents, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelineControlPlaneInputComponents_To_v1beta1_MetricPipelineControlPlaneInputComponents(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelineControlPlaneInputComponents_To_v1alpha1_MetricPipelineControlPlaneInputComponents(in *v1beta1.MetricPipelineControlPlaneInputComponents, out *MetricPipelineControlPlaneInputComponents, s conversion.Scope) error {
+	out.APIServer = (*MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.APIServer))
+	out.CoreDNS = (*MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.CoreDNS))
+	out.KubeProxy = (*MetricPipelineControlPlaneInputComponent)(unsafe.Pointer(in.KubeProxy))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelineControlPlaneInputComponents_To_v1alpha1_MetricPipelineControlPlaneInputComponents is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelineControlPlaneInputComponents_To_v1alpha1_MetricPipelineControlPlaneInputComponents(in *v1beta1.MetricPipelineControlPlaneInputComponents, out *MetricPipelineControlPlaneInputComponents, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineControlPlaneInputComponents_To_v1alpha1_MetricPipelineControlPlaneInputComponents(in, out, s)
+}
+
 func autoConvert_v1alpha1_MetricPipelineInput_To_v1beta1_MetricPipelineInput(in *MetricPipelineInput, out *v1beta1.MetricPipelineInput, s conversion.Scope) error {
 	out.Prometheus = (*v1beta1.MetricPipelinePrometheusInput)(unsafe.Pointer(in.Prometheus))
 	if in.Runtime != nil {
@@ -996,6 +1278,7 @@ func autoConvert_v1alpha1_MetricPipelineInput_To_v1beta1_MetricPipelineInput(in
 	} else {
 		out.OTLP = nil
 	}
+	out.ControlPlane = (*v1beta1.MetricPipelineControlPlaneInput)(unsafe.Pointer(in.ControlPlane))
 	return nil
 }
 
@@ -1025,6 +1308,7 @@ func autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in
 	} else {
 		out.OTLP = nil
 	}
+	out.ControlPlane = (*MetricPipelineControlPlaneInput)(unsafe.Pointer(in.ControlPlane))
 	return nil
 }
 
@@ -1038,6 +1322,7 @@ func autoConvert_v1alpha1_MetricPipelineIstioInput_To_v1beta1_MetricPipelineIsti
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.DiagnosticMetrics = (*v1beta1.MetricPipelineIstioInputDiagnosticMetrics)(unsafe.Pointer(in.DiagnosticMetrics))
 	out.EnvoyMetrics = (*v1beta1.EnvoyMetrics)(unsafe.Pointer(in.EnvoyMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
 	return nil
 }
 
@@ -1051,6 +1336,7 @@ func autoConvert_v1beta1_MetricPipelineIstioInput_To_v1alpha1_MetricPipelineIsti
 	out.Namespaces = (*NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.DiagnosticMetrics = (*MetricPipelineIstioInputDiagnosticMetrics)(unsafe.Pointer(in.DiagnosticMetrics))
 	out.EnvoyMetrics = (*EnvoyMetrics)(unsafe.Pointer(in.EnvoyMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
 	return nil
 }
 
@@ -1126,6 +1412,7 @@ func autoConvert_v1alpha1_MetricPipelineOTLPOutput_To_v1beta1_MetricPipelineOTLP
 		return err
 	}
 	out.Temporality = (*v1beta1.TemporalityType)(unsafe.Pointer(in.Temporality))
+	out.Exemplars = (*v1beta1.ExemplarsType)(unsafe.Pointer(in.Exemplars))
 	return nil
 }
 
@@ -1139,6 +1426,7 @@ func autoConvert_v1beta1_MetricPipelineOTLPOutput_To_v1alpha1_MetricPipelineOTLP
 		return err
 	}
 	out.Temporality = (*v1beta1.TemporalityType)(unsafe.Pointer(in.Temporality))
+	out.Exemplars = (*v1beta1.ExemplarsType)(unsafe.Pointer(in.Exemplars))
 	return nil
 }
 
@@ -1157,6 +1445,7 @@ func autoConvert_v1alpha1_MetricPipelineOutput_To_v1beta1_MetricPipelineOutput(i
 	} else {
 		out.OTLP = nil
 	}
+	out.Prometheus = (*v1beta1.MetricPipelinePrometheusOutput)(unsafe.Pointer(in.Prometheus))
 	return nil
 }
 
@@ -1175,6 +1464,7 @@ func autoConvert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(i
 	} else {
 		out.OTLP = nil
 	}
+	out.Prometheus = (*MetricPipelinePrometheusOutput)(unsafe.Pointer(in.Prometheus))
 	return nil
 }
 
@@ -1187,6 +1477,8 @@ func autoConvert_v1alpha1_MetricPipelinePrometheusInput_To_v1beta1_MetricPipelin
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.DiagnosticMetrics = (*v1beta1.MetricPipelineIstioInputDiagnosticMetrics)(unsafe.Pointer(in.DiagnosticMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
+	out.NativeHistograms = (*v1beta1.MetricPipelinePrometheusInputNativeHistograms)(unsafe.Pointer(in.NativeHistograms))
 	return nil
 }
 
@@ -1199,6 +1491,8 @@ func autoConvert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelin
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.DiagnosticMetrics = (*MetricPipelineIstioInputDiagnosticMetrics)(unsafe.Pointer(in.DiagnosticMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
+	out.NativeHistograms = (*MetricPipelinePrometheusInputNativeHistograms)(unsafe.Pointer(in.NativeHistograms))
 	return nil
 }
 
@@ -1207,11 +1501,76 @@ func Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePro
 	return autoConvert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(in, out, s)
 }
 
+func autoConvert_v1alpha1_MetricPipelinePrometheusInputNativeHistograms_To_v1beta1_MetricPipelinePrometheusInputNativeHistograms(in *MetricPipelinePrometheusInputNativeHistograms, out *v1beta1.MetricPipelinePrometheusInputNativeHistograms, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelinePrometheusInputNativeHistograms_To_v1beta1_MetricPipelinePrometheusInputNativeHistograms is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelinePrometheusInputNativeHistograms_To_v1beta1_MetricPipelinePrometheusInputNativeHistograms(in *MetricPipelinePrometheusInputNativeHistograms, out *v1beta1.MetricPipelinePrometheusInputNativeHistograms, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelinePrometheusInputNativeHistograms_To_v1beta1_MetricPipelinePrometheusInputNativeHistograms(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelinePrometheusInputNativeHistograms_To_v1alpha1_MetricPipelinePrometheusInputNativeHistograms(in *v1beta1.MetricPipelinePrometheusInputNativeHistograms, out *MetricPipelinePrometheusInputNativeHistograms, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelinePrometheusInputNativeHistograms_To_v1alpha1_MetricPipelinePrometheusInputNativeHistograms is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelinePrometheusInputNativeHistograms_To_v1alpha1_MetricPipelinePrometheusInputNativeHistograms(in *v1beta1.MetricPipelinePrometheusInputNativeHistograms, out *MetricPipelinePrometheusInputNativeHistograms, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelinePrometheusInputNativeHistograms_To_v1alpha1_MetricPipelinePrometheusInputNativeHistograms(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricPipelinePrometheusOutput_To_v1beta1_MetricPipelinePrometheusOutput(in *MetricPipelinePrometheusOutput, out *v1beta1.MetricPipelinePrometheusOutput, s conversion.Scope) error {
+	out.ResourceToLabels = (*v1beta1.MetricPipelinePrometheusOutputResourceToLabels)(unsafe.Pointer(in.ResourceToLabels))
+	out.MetricExpiration = (*v1.Duration)(unsafe.Pointer(in.MetricExpiration))
+	out.Exemplars = (*v1beta1.ExemplarsType)(unsafe.Pointer(in.Exemplars))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelinePrometheusOutput_To_v1beta1_MetricPipelinePrometheusOutput is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelinePrometheusOutput_To_v1beta1_MetricPipelinePrometheusOutput(in *MetricPipelinePrometheusOutput, out *v1beta1.MetricPipelinePrometheusOutput, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelinePrometheusOutput_To_v1beta1_MetricPipelinePrometheusOutput(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelinePrometheusOutput_To_v1alpha1_MetricPipelinePrometheusOutput(in *v1beta1.MetricPipelinePrometheusOutput, out *MetricPipelinePrometheusOutput, s conversion.Scope) error {
+	out.ResourceToLabels = (*MetricPipelinePrometheusOutputResourceToLabels)(unsafe.Pointer(in.ResourceToLabels))
+	out.MetricExpiration = (*v1.Duration)(unsafe.Pointer(in.MetricExpiration))
+	out.Exemplars = (*v1beta1.ExemplarsType)(unsafe.Pointer(in.Exemplars))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelinePrometheusOutput_To_v1alpha1_MetricPipelinePrometheusOutput is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelinePrometheusOutput_To_v1alpha1_MetricPipelinePrometheusOutput(in *v1beta1.MetricPipelinePrometheusOutput, out *MetricPipelinePrometheusOutput, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelinePrometheusOutput_To_v1alpha1_MetricPipelinePrometheusOutput(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels_To_v1beta1_MetricPipelinePrometheusOutputResourceToLabels(in *MetricPipelinePrometheusOutputResourceToLabels, out *v1beta1.MetricPipelinePrometheusOutputResourceToLabels, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels_To_v1beta1_MetricPipelinePrometheusOutputResourceToLabels is an autogenerated conversion function.
+func Convert_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels_To_v1beta1_MetricPipelinePrometheusOutputResourceToLabels(in *MetricPipelinePrometheusOutputResourceToLabels, out *v1beta1.MetricPipelinePrometheusOutputResourceToLabels, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels_To_v1beta1_MetricPipelinePrometheusOutputResourceToLabels(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricPipelinePrometheusOutputResourceToLabels_To_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels(in *v1beta1.MetricPipelinePrometheusOutputResourceToLabels, out *MetricPipelinePrometheusOutputResourceToLabels, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1beta1_MetricPipelinePrometheusOutputResourceToLabels_To_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels is an autogenerated conversion function.
+func Convert_v1beta1_MetricPipelinePrometheusOutputResourceToLabels_To_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels(in *v1beta1.MetricPipelinePrometheusOutputResourceToLabels, out *MetricPipelinePrometheusOutputResourceToLabels, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelinePrometheusOutputResourceToLabels_To_v1alpha1_MetricPipelinePrometheusOutputResourceToLabels(in, out, s)
+}
+
 func autoConvert_v1alpha1_MetricPipelineRuntimeInput_To_v1beta1_MetricPipelineRuntimeInput(in *MetricPipelineRuntimeInput, out *v1beta1.MetricPipelineRuntimeInput, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.Resources = (*v1beta1.MetricPipelineRuntimeInputResources)(unsafe.Pointer(in.Resources))
 	out.AdditionalMetrics = *(*[]string)(unsafe.Pointer(&in.AdditionalMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
 	return nil
 }
 
@@ -1220,6 +1579,7 @@ func autoConvert_v1beta1_MetricPipelineRuntimeInput_To_v1alpha1_MetricPipelineRu
 	out.Namespaces = (*NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.Resources = (*MetricPipelineRuntimeInputResources)(unsafe.Pointer(in.Resources))
 	out.AdditionalMetrics = *(*[]string)(unsafe.Pointer(&in.AdditionalMetrics))
+	out.CollectionInterval = (*v1.Duration)(unsafe.Pointer(in.CollectionInterval))
 	return nil
 }
 
@@ -1257,6 +1617,13 @@ func autoConvert_v1alpha1_MetricPipelineRuntimeInputResources_To_v1beta1_MetricP
 	out.Deployment = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Deployment))
 	out.StatefulSet = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.StatefulSet))
 	out.Job = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Job))
+	out.ReplicaSet = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.ReplicaSet))
+	out.CronJob = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.CronJob))
+	out.HorizontalPodAutoscaler = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.HorizontalPodAutoscaler))
+	out.PersistentVolumeClaim = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	out.ResourceQuota = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.ResourceQuota))
+	out.Namespace = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Namespace))
+	out.Host = (*v1beta1.MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Host))
 	return nil
 }
 
@@ -1274,6 +1641,13 @@ func autoConvert_v1beta1_MetricPipelineRuntimeInputResources_To_v1alpha1_MetricP
 	out.Deployment = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Deployment))
 	out.StatefulSet = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.StatefulSet))
 	out.Job = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Job))
+	out.ReplicaSet = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.ReplicaSet))
+	out.CronJob = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.CronJob))
+	out.HorizontalPodAutoscaler = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.HorizontalPodAutoscaler))
+	out.PersistentVolumeClaim = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	out.ResourceQuota = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.ResourceQuota))
+	out.Namespace = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Namespace))
+	out.Host = (*MetricPipelineRuntimeInputResource)(unsafe.Pointer(in.Host))
 	return nil
 }
 
@@ -1289,8 +1663,24 @@ func autoConvert_v1alpha1_MetricPipelineSpec_To_v1beta1_MetricPipelineSpec(in *M
 	if err := Convert_v1alpha1_MetricPipelineOutput_To_v1beta1_MetricPipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Redaction = (*v1beta1.Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]v1beta1.TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]v1beta1.FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]v1beta1.OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Aggregation = (*v1beta1.MetricPipelineAggregation)(unsafe.Pointer(in.Aggregation))
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*v1beta1.Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*v1beta1.Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -1306,8 +1696,24 @@ func autoConvert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in *v
 	if err := Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Redaction = (*Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Aggregation = (*MetricPipelineAggregation)(unsafe.Pointer(in.Aggregation))
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -1318,6 +1724,8 @@ func Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in *v1bet
 
 func autoConvert_v1alpha1_MetricPipelineStatus_To_v1beta1_MetricPipelineStatus(in *MetricPipelineStatus, out *v1beta1.MetricPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ScrapeURL = in.ScrapeURL
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -1328,6 +1736,8 @@ func Convert_v1alpha1_MetricPipelineStatus_To_v1beta1_MetricPipelineStatus(in *M
 
 func autoConvert_v1beta1_MetricPipelineStatus_To_v1alpha1_MetricPipelineStatus(in *v1beta1.MetricPipelineStatus, out *MetricPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ScrapeURL = in.ScrapeURL
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -1419,6 +1829,8 @@ func autoConvert_v1alpha1_OTLPOutput_To_v1beta1_OTLPOutput(in *OTLPOutput, out *
 	out.Authentication = (*v1beta1.AuthenticationOptions)(unsafe.Pointer(in.Authentication))
 	out.Headers = *(*[]v1beta1.Header)(unsafe.Pointer(&in.Headers))
 	out.TLS = (*v1beta1.OutputTLS)(unsafe.Pointer(in.TLS))
+	out.ProxyURL = (*v1beta1.ValueType)(unsafe.Pointer(in.ProxyURL))
+	out.ProxyAuthentication = (*v1beta1.ProxyAuthenticationOptions)(unsafe.Pointer(in.ProxyAuthentication))
 	return nil
 }
 
@@ -1437,9 +1849,121 @@ func autoConvert_v1beta1_OTLPOutput_To_v1alpha1_OTLPOutput(in *v1beta1.OTLPOutpu
 	out.Headers = *(*[]Header)(unsafe.Pointer(&in.Headers))
 	out.TLS = (*OTLPTLS)(unsafe.Pointer(in.TLS))
 	// WARNING: in.Compression requires manual conversion: does not exist in peer-type
+	out.ProxyURL = (*ValueType)(unsafe.Pointer(in.ProxyURL))
+	out.ProxyAuthentication = (*ProxyAuthenticationOptions)(unsafe.Pointer(in.ProxyAuthentication))
+	return nil
+}
+
+func autoConvert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(in *OutputRoute, out *v1beta1.OutputRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Conditions = *(*[]string)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput(&in.Output, &out.Output, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute is an autogenerated conversion function.
+func Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(in *OutputRoute, out *v1beta1.OutputRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(in *v1beta1.OutputRoute, out *OutputRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Conditions = *(*[]string)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput(&in.Output, &out.Output, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute is an autogenerated conversion function.
+func Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(in *v1beta1.OutputRoute, out *OutputRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput(in *OutputRouteOutput, out *v1beta1.OutputRouteOutput, s conversion.Scope) error {
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(v1beta1.OTLPOutput)
+		if err := Convert_v1alpha1_OTLPOutput_To_v1beta1_OTLPOutput(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OTLP = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput is an autogenerated conversion function.
+func Convert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput(in *OutputRouteOutput, out *v1beta1.OutputRouteOutput, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutputRouteOutput_To_v1beta1_OutputRouteOutput(in, out, s)
+}
+
+func autoConvert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput(in *v1beta1.OutputRouteOutput, out *OutputRouteOutput, s conversion.Scope) error {
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPOutput)
+		if err := Convert_v1beta1_OTLPOutput_To_v1alpha1_OTLPOutput(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OTLP = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput is an autogenerated conversion function.
+func Convert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput(in *v1beta1.OutputRouteOutput, out *OutputRouteOutput, s conversion.Scope) error {
+	return autoConvert_v1beta1_OutputRouteOutput_To_v1alpha1_OutputRouteOutput(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProxyAuthenticationOptions_To_v1beta1_ProxyAuthenticationOptions(in *ProxyAuthenticationOptions, out *v1beta1.ProxyAuthenticationOptions, s conversion.Scope) error {
+	out.Basic = (*v1beta1.BasicAuthOptions)(unsafe.Pointer(in.Basic))
+	return nil
+}
+
+// Convert_v1alpha1_ProxyAuthenticationOptions_To_v1beta1_ProxyAuthenticationOptions is an autogenerated conversion function.
+func Convert_v1alpha1_ProxyAuthenticationOptions_To_v1beta1_ProxyAuthenticationOptions(in *ProxyAuthenticationOptions, out *v1beta1.ProxyAuthenticationOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProxyAuthenticationOptions_To_v1beta1_ProxyAuthenticationOptions(in, out, s)
+}
+
+func autoConvert_v1beta1_ProxyAuthenticationOptions_To_v1alpha1_ProxyAuthenticationOptions(in *v1beta1.ProxyAuthenticationOptions, out *ProxyAuthenticationOptions, s conversion.Scope) error {
+	out.Basic = (*BasicAuthOptions)(unsafe.Pointer(in.Basic))
+	return nil
+}
+
+// Convert_v1beta1_ProxyAuthenticationOptions_To_v1alpha1_ProxyAuthenticationOptions is an autogenerated conversion function.
+func Convert_v1beta1_ProxyAuthenticationOptions_To_v1alpha1_ProxyAuthenticationOptions(in *v1beta1.ProxyAuthenticationOptions, out *ProxyAuthenticationOptions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProxyAuthenticationOptions_To_v1alpha1_ProxyAuthenticationOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_Redaction_To_v1beta1_Redaction(in *Redaction, out *v1beta1.Redaction, s conversion.Scope) error {
+	out.Presets = *(*[]v1beta1.RedactionPreset)(unsafe.Pointer(&in.Presets))
+	out.Patterns = *(*[]string)(unsafe.Pointer(&in.Patterns))
+	out.AllowedAttributes = *(*[]string)(unsafe.Pointer(&in.AllowedAttributes))
+	out.DeniedAttributes = *(*[]string)(unsafe.Pointer(&in.DeniedAttributes))
+	return nil
+}
+
+// Convert_v1alpha1_Redaction_To_v1beta1_Redaction is an autogenerated conversion function.
+func Convert_v1alpha1_Redaction_To_v1beta1_Redaction(in *Redaction, out *v1beta1.Redaction, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Redaction_To_v1beta1_Redaction(in, out, s)
+}
+
+func autoConvert_v1beta1_Redaction_To_v1alpha1_Redaction(in *v1beta1.Redaction, out *Redaction, s conversion.Scope) error {
+	out.Presets = *(*[]RedactionPreset)(unsafe.Pointer(&in.Presets))
+	out.Patterns = *(*[]string)(unsafe.Pointer(&in.Patterns))
+	out.AllowedAttributes = *(*[]string)(unsafe.Pointer(&in.AllowedAttributes))
+	out.DeniedAttributes = *(*[]string)(unsafe.Pointer(&in.DeniedAttributes))
 	return nil
 }
 
+// Convert_v1beta1_Redaction_To_v1alpha1_Redaction is an autogenerated conversion function.
+func Convert_v1beta1_Redaction_To_v1alpha1_Redaction(in *v1beta1.Redaction, out *Redaction, s conversion.Scope) error {
+	return autoConvert_v1beta1_Redaction_To_v1alpha1_Redaction(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(in *SecretKeyRef, out *v1beta1.SecretKeyRef, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -1464,6 +1988,46 @@ func Convert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(in *v1beta1.SecretKey
 	return autoConvert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(in, out, s)
 }
 
+func autoConvert_v1alpha1_ServiceAccountTokenAuthOptions_To_v1beta1_ServiceAccountTokenAuthOptions(in *ServiceAccountTokenAuthOptions, out *v1beta1.ServiceAccountTokenAuthOptions, s conversion.Scope) error {
+	out.Audience = in.Audience
+	return nil
+}
+
+// Convert_v1alpha1_ServiceAccountTokenAuthOptions_To_v1beta1_ServiceAccountTokenAuthOptions is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceAccountTokenAuthOptions_To_v1beta1_ServiceAccountTokenAuthOptions(in *ServiceAccountTokenAuthOptions, out *v1beta1.ServiceAccountTokenAuthOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceAccountTokenAuthOptions_To_v1beta1_ServiceAccountTokenAuthOptions(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceAccountTokenAuthOptions_To_v1alpha1_ServiceAccountTokenAuthOptions(in *v1beta1.ServiceAccountTokenAuthOptions, out *ServiceAccountTokenAuthOptions, s conversion.Scope) error {
+	out.Audience = in.Audience
+	return nil
+}
+
+// Convert_v1beta1_ServiceAccountTokenAuthOptions_To_v1alpha1_ServiceAccountTokenAuthOptions is an autogenerated conversion function.
+func Convert_v1beta1_ServiceAccountTokenAuthOptions_To_v1alpha1_ServiceAccountTokenAuthOptions(in *v1beta1.ServiceAccountTokenAuthOptions, out *ServiceAccountTokenAuthOptions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceAccountTokenAuthOptions_To_v1alpha1_ServiceAccountTokenAuthOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_Tap_To_v1beta1_Tap(in *Tap, out *v1beta1.Tap, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_Tap_To_v1beta1_Tap is an autogenerated conversion function.
+func Convert_v1alpha1_Tap_To_v1beta1_Tap(in *Tap, out *v1beta1.Tap, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Tap_To_v1beta1_Tap(in, out, s)
+}
+
+func autoConvert_v1beta1_Tap_To_v1alpha1_Tap(in *v1beta1.Tap, out *Tap, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_Tap_To_v1alpha1_Tap is an autogenerated conversion function.
+func Convert_v1beta1_Tap_To_v1alpha1_Tap(in *v1beta1.Tap, out *Tap, s conversion.Scope) error {
+	return autoConvert_v1beta1_Tap_To_v1alpha1_Tap(in, out, s)
+}
+
 func autoConvert_v1alpha1_TracePipeline_To_v1beta1_TracePipeline(in *TracePipeline, out *v1beta1.TracePipeline, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TracePipelineSpec_To_v1beta1_TracePipelineSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1578,8 +2142,23 @@ func autoConvert_v1alpha1_TracePipelineSpec_To_v1beta1_TracePipelineSpec(in *Tra
 	if err := Convert_v1alpha1_TracePipelineOutput_To_v1beta1_TracePipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Redaction = (*v1beta1.Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]v1beta1.TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]v1beta1.FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]v1beta1.OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*v1beta1.Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*v1beta1.Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -1592,8 +2171,23 @@ func autoConvert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in *v1b
 	if err := Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Redaction = (*Redaction)(unsafe.Pointer(in.Redaction))
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_OutputRoute_To_v1alpha1_OutputRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Routes = nil
+	}
+	out.Suspend = in.Suspend
+	out.Heartbeat = (*Heartbeat)(unsafe.Pointer(in.Heartbeat))
+	out.Tap = (*Tap)(unsafe.Pointer(in.Tap))
 	return nil
 }
 
@@ -1604,6 +2198,7 @@ func Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in *v1beta1
 
 func autoConvert_v1alpha1_TracePipelineStatus_To_v1beta1_TracePipelineStatus(in *TracePipelineStatus, out *v1beta1.TracePipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -1614,6 +2209,7 @@ func Convert_v1alpha1_TracePipelineStatus_To_v1beta1_TracePipelineStatus(in *Tra
 
 func autoConvert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in *v1beta1.TracePipelineStatus, out *TracePipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastHeartbeatDelivered = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatDelivered))
 	return nil
 }
 
@@ -39,6 +39,11 @@ func (in *AuthenticationOptions) DeepCopyInto(out *AuthenticationOptions) {
 		*out = new(OAuth2Options)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenAuthOptions)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationOptions.
@@ -227,6 +232,21 @@ func (in *Header) DeepCopy() *Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Heartbeat) DeepCopyInto(out *Heartbeat) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Heartbeat.
+func (in *Heartbeat) DeepCopy() *Heartbeat {
+	if in == nil {
+		return nil
+	}
+	out := new(Heartbeat)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipeline) DeepCopyInto(out *LogPipeline) {
 	*out = *in
@@ -445,6 +465,11 @@ func (in *LogPipelineSpec) DeepCopyInto(out *LogPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(Redaction)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -459,6 +484,23 @@ func (in *LogPipelineSpec) DeepCopyInto(out *LogPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Heartbeat != nil {
+		in, out := &in.Heartbeat, &out.Heartbeat
+		*out = new(Heartbeat)
+		**out = **in
+	}
+	if in.Tap != nil {
+		in, out := &in.Tap, &out.Tap
+		*out = new(Tap)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineSpec.
@@ -486,6 +528,10 @@ func (in *LogPipelineStatus) DeepCopyInto(out *LogPipelineStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastHeartbeatDelivered != nil {
+		in, out := &in.LastHeartbeatDelivered, &out.LastHeartbeatDelivered
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineStatus.
@@ -525,6 +571,106 @@ func (in *MetricPipeline) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineAggregation) DeepCopyInto(out *MetricPipelineAggregation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DropAttributes != nil {
+		in, out := &in.DropAttributes, &out.DropAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineAggregation.
+func (in *MetricPipelineAggregation) DeepCopy() *MetricPipelineAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineControlPlaneInput) DeepCopyInto(out *MetricPipelineControlPlaneInput) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(MetricPipelineControlPlaneInputComponents)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineControlPlaneInput.
+func (in *MetricPipelineControlPlaneInput) DeepCopy() *MetricPipelineControlPlaneInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineControlPlaneInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineControlPlaneInputComponent) DeepCopyInto(out *MetricPipelineControlPlaneInputComponent) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineControlPlaneInputComponent.
+func (in *MetricPipelineControlPlaneInputComponent) DeepCopy() *MetricPipelineControlPlaneInputComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineControlPlaneInputComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineControlPlaneInputComponents) DeepCopyInto(out *MetricPipelineControlPlaneInputComponents) {
+	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(MetricPipelineControlPlaneInputComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CoreDNS != nil {
+		in, out := &in.CoreDNS, &out.CoreDNS
+		*out = new(MetricPipelineControlPlaneInputComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(MetricPipelineControlPlaneInputComponent)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineControlPlaneInputComponents.
+func (in *MetricPipelineControlPlaneInputComponents) DeepCopy() *MetricPipelineControlPlaneInputComponents {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineControlPlaneInputComponents)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipelineInput) DeepCopyInto(out *MetricPipelineInput) {
 	*out = *in
@@ -548,6 +694,11 @@ func (in *MetricPipelineInput) DeepCopyInto(out *MetricPipelineInput) {
 		*out = new(OTLPInput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(MetricPipelineControlPlaneInput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineInput.
@@ -583,6 +734,11 @@ func (in *MetricPipelineIstioInput) DeepCopyInto(out *MetricPipelineIstioInput)
 		*out = new(EnvoyMetrics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CollectionInterval != nil {
+		in, out := &in.CollectionInterval, &out.CollectionInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineIstioInput.
@@ -656,6 +812,11 @@ func (in *MetricPipelineOTLPOutput) DeepCopyInto(out *MetricPipelineOTLPOutput)
 		*out = new(v1beta1.TemporalityType)
 		**out = **in
 	}
+	if in.Exemplars != nil {
+		in, out := &in.Exemplars, &out.Exemplars
+		*out = new(v1beta1.ExemplarsType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineOTLPOutput.
@@ -676,6 +837,11 @@ func (in *MetricPipelineOutput) DeepCopyInto(out *MetricPipelineOutput) {
 		*out = new(MetricPipelineOTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(MetricPipelinePrometheusOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineOutput.
@@ -706,6 +872,16 @@ func (in *MetricPipelinePrometheusInput) DeepCopyInto(out *MetricPipelinePrometh
 		*out = new(MetricPipelineIstioInputDiagnosticMetrics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CollectionInterval != nil {
+		in, out := &in.CollectionInterval, &out.CollectionInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NativeHistograms != nil {
+		in, out := &in.NativeHistograms, &out.NativeHistograms
+		*out = new(MetricPipelinePrometheusInputNativeHistograms)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusInput.
@@ -718,6 +894,76 @@ func (in *MetricPipelinePrometheusInput) DeepCopy() *MetricPipelinePrometheusInp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelinePrometheusInputNativeHistograms) DeepCopyInto(out *MetricPipelinePrometheusInputNativeHistograms) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusInputNativeHistograms.
+func (in *MetricPipelinePrometheusInputNativeHistograms) DeepCopy() *MetricPipelinePrometheusInputNativeHistograms {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelinePrometheusInputNativeHistograms)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelinePrometheusOutput) DeepCopyInto(out *MetricPipelinePrometheusOutput) {
+	*out = *in
+	if in.ResourceToLabels != nil {
+		in, out := &in.ResourceToLabels, &out.ResourceToLabels
+		*out = new(MetricPipelinePrometheusOutputResourceToLabels)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricExpiration != nil {
+		in, out := &in.MetricExpiration, &out.MetricExpiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Exemplars != nil {
+		in, out := &in.Exemplars, &out.Exemplars
+		*out = new(v1beta1.ExemplarsType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusOutput.
+func (in *MetricPipelinePrometheusOutput) DeepCopy() *MetricPipelinePrometheusOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelinePrometheusOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelinePrometheusOutputResourceToLabels) DeepCopyInto(out *MetricPipelinePrometheusOutputResourceToLabels) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusOutputResourceToLabels.
+func (in *MetricPipelinePrometheusOutputResourceToLabels) DeepCopy() *MetricPipelinePrometheusOutputResourceToLabels {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelinePrometheusOutputResourceToLabels)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipelineRuntimeInput) DeepCopyInto(out *MetricPipelineRuntimeInput) {
 	*out = *in
@@ -741,6 +987,11 @@ func (in *MetricPipelineRuntimeInput) DeepCopyInto(out *MetricPipelineRuntimeInp
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CollectionInterval != nil {
+		in, out := &in.CollectionInterval, &out.CollectionInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineRuntimeInput.
@@ -816,6 +1067,41 @@ func (in *MetricPipelineRuntimeInputResources) DeepCopyInto(out *MetricPipelineR
 		*out = new(MetricPipelineRuntimeInputResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicaSet != nil {
+		in, out := &in.ReplicaSet, &out.ReplicaSet
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronJob != nil {
+		in, out := &in.CronJob, &out.CronJob
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HorizontalPodAutoscaler != nil {
+		in, out := &in.HorizontalPodAutoscaler, &out.HorizontalPodAutoscaler
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(MetricPipelineRuntimeInputResource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineRuntimeInputResources.
@@ -833,6 +1119,11 @@ func (in *MetricPipelineSpec) DeepCopyInto(out *MetricPipelineSpec) {
 	*out = *in
 	in.Input.DeepCopyInto(&out.Input)
 	in.Output.DeepCopyInto(&out.Output)
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(Redaction)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -847,6 +1138,28 @@ func (in *MetricPipelineSpec) DeepCopyInto(out *MetricPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(MetricPipelineAggregation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Heartbeat != nil {
+		in, out := &in.Heartbeat, &out.Heartbeat
+		*out = new(Heartbeat)
+		**out = **in
+	}
+	if in.Tap != nil {
+		in, out := &in.Tap, &out.Tap
+		*out = new(Tap)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineSpec.
@@ -869,6 +1182,10 @@ func (in *MetricPipelineStatus) DeepCopyInto(out *MetricPipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastHeartbeatDelivered != nil {
+		in, out := &in.LastHeartbeatDelivered, &out.LastHeartbeatDelivered
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineStatus.
@@ -977,6 +1294,16 @@ func (in *OTLPOutput) DeepCopyInto(out *OTLPOutput) {
 		*out = new(OTLPTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyAuthentication != nil {
+		in, out := &in.ProxyAuthentication, &out.ProxyAuthentication
+		*out = new(ProxyAuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPOutput.
@@ -1019,6 +1346,102 @@ func (in *OTLPTLS) DeepCopy() *OTLPTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputRoute) DeepCopyInto(out *OutputRoute) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Output.DeepCopyInto(&out.Output)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputRoute.
+func (in *OutputRoute) DeepCopy() *OutputRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputRouteOutput) DeepCopyInto(out *OutputRouteOutput) {
+	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputRouteOutput.
+func (in *OutputRouteOutput) DeepCopy() *OutputRouteOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputRouteOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAuthenticationOptions) DeepCopyInto(out *ProxyAuthenticationOptions) {
+	*out = *in
+	if in.Basic != nil {
+		in, out := &in.Basic, &out.Basic
+		*out = new(BasicAuthOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAuthenticationOptions.
+func (in *ProxyAuthenticationOptions) DeepCopy() *ProxyAuthenticationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAuthenticationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Redaction) DeepCopyInto(out *Redaction) {
+	*out = *in
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]RedactionPreset, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAttributes != nil {
+		in, out := &in.AllowedAttributes, &out.AllowedAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedAttributes != nil {
+		in, out := &in.DeniedAttributes, &out.DeniedAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redaction.
+func (in *Redaction) DeepCopy() *Redaction {
+	if in == nil {
+		return nil
+	}
+	out := new(Redaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -1034,6 +1457,36 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenAuthOptions) DeepCopyInto(out *ServiceAccountTokenAuthOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenAuthOptions.
+func (in *ServiceAccountTokenAuthOptions) DeepCopy() *ServiceAccountTokenAuthOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenAuthOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tap) DeepCopyInto(out *Tap) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tap.
+func (in *Tap) DeepCopy() *Tap {
+	if in == nil {
+		return nil
+	}
+	out := new(Tap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipeline) DeepCopyInto(out *TracePipeline) {
 	*out = *in
@@ -1117,6 +1570,11 @@ func (in *TracePipelineOutput) DeepCopy() *TracePipelineOutput {
 func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 	*out = *in
 	in.Output.DeepCopyInto(&out.Output)
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(Redaction)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -1131,6 +1589,23 @@ func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OutputRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Heartbeat != nil {
+		in, out := &in.Heartbeat, &out.Heartbeat
+		*out = new(Heartbeat)
+		**out = **in
+	}
+	if in.Tap != nil {
+		in, out := &in.Tap, &out.Tap
+		*out = new(Tap)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineSpec.
@@ -1153,6 +1628,10 @@ func (in *TracePipelineStatus) DeepCopyInto(out *TracePipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastHeartbeatDelivered != nil {
+		in, out := &in.LastHeartbeatDelivered, &out.LastHeartbeatDelivered
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineStatus.
@@ -54,6 +54,8 @@ const (
 // +kubebuilder:validation:XValidation:rule="(has(self.path) && size(self.path) > 0) ? self.protocol == 'http' : true",message="Path is only available with HTTP protocol"
 // +kubebuilder:validation:XValidation:rule="(has(self.authentication) && has(self.authentication.oauth2) && self.protocol == 'grpc' && has(self.tls)) ? !(has(self.tls.insecure) && self.tls.insecure == true) : true",message="OAuth2 authentication requires TLS when using gRPC protocol"
 // +kubebuilder:validation:XValidation:rule="has(self.endpoint.value) || has(self.endpoint.valueFrom)",message="'endpoint' must have 'value' or 'valueFrom' set"
+// +kubebuilder:validation:XValidation:rule="has(self.proxyURL) ? self.protocol == 'http' : true",message="Proxy is only available with HTTP protocol"
+// +kubebuilder:validation:XValidation:rule="has(self.proxyAuthentication) ? has(self.proxyURL) : true",message="Proxy authentication requires 'proxyURL' to be set"
 type OTLPOutput struct {
 	// Protocol defines the OTLP protocol (`http` or `grpc`). Default is `grpc`.
 	// +kubebuilder:validation:Optional
@@ -78,10 +80,18 @@ type OTLPOutput struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=none;gzip;snappy;zstd
 	Compression OTLPCompressionEncoding `json:"compression,omitempty"`
+	// ProxyURL defines the URL (`http://<host>:<port>` or `https://<host>:<port>`) of an HTTP(S) proxy, through which the data is sent to the OTLP endpoint. Only available with the HTTP protocol.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="has(self.value) || has(self.valueFrom)",message="'proxyURL' must have 'value' or 'valueFrom' set"
+	// +kubebuilder:validation:XValidation:rule="has(self.value) ? isURL(self.value) : true",message="'proxyURL' must be a valid URL"
+	ProxyURL *ValueType `json:"proxyURL,omitempty"`
+	// ProxyAuthentication defines authentication options for the proxy. Only available if `proxyURL` is set.
+	// +kubebuilder:validation:Optional
+	ProxyAuthentication *ProxyAuthenticationOptions `json:"proxyAuthentication,omitempty"`
 }
 
 // AuthenticationOptions OTLP output authentication options
-// +kubebuilder:validation:XValidation:rule="!(has(self.basic) && has(self.oauth2))",message="Only one authentication method can be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.basic) ? 1 : 0) + (has(self.oauth2) ? 1 : 0) + (has(self.serviceAccountToken) ? 1 : 0) <= 1",message="Only one authentication method can be specified"
 type AuthenticationOptions struct {
 	// Basic activates `Basic` authentication for the destination providing relevant Secrets.
 	// +kubebuilder:validation:Optional
@@ -89,6 +99,10 @@ type AuthenticationOptions struct {
 	// OAuth2 activates `OAuth2` authentication for the destination providing relevant Secrets.
 	// +kubebuilder:validation:Optional
 	OAuth2 *OAuth2Options `json:"oauth2,omitempty"`
+	// ServiceAccountToken activates authentication with a Kubernetes service account token of the collector, which is sent as `Bearer` token. No Secret is needed.
+	// The token is sent as issued; exchanging it for a token of the backend (OAuth2 token exchange, RFC 8693) is not supported. Not available for a TelemetryRoute, because the token identifies the OTLP Gateway and not the owner of the route.
+	// +kubebuilder:validation:Optional
+	ServiceAccountToken *ServiceAccountTokenAuthOptions `json:"serviceAccountToken,omitempty"`
 }
 
 // BasicAuthOptions contains options for `Basic` authentication.
@@ -103,6 +117,22 @@ type BasicAuthOptions struct {
 	Password ValueType `json:"password"`
 }
 
+// ServiceAccountTokenAuthOptions contains options for the authentication with a projected Kubernetes service account token.
+// +kubebuilder:validation:XValidation:rule="self.audience != 'telemetry-manager-tap-sink'",message="The audience 'telemetry-manager-tap-sink' is reserved for the OTLP Gateway"
+type ServiceAccountTokenAuthOptions struct {
+	// Audience defines the intended audience of the token. The backend must accept tokens with this audience, which are issued by the service account issuer of the cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Audience string `json:"audience"`
+}
+
+// ProxyAuthenticationOptions contains options for the authentication at an HTTP(S) proxy.
+type ProxyAuthenticationOptions struct {
+	// Basic activates `Basic` authentication at the proxy providing relevant Secrets.
+	// +kubebuilder:validation:Required
+	Basic *BasicAuthOptions `json:"basic"`
+}
+
 // OAuth2Options contains options for `OAuth2` authentication.
 // +kubebuilder:validation:XValidation:rule="has(self.tokenURL.value) || has(self.tokenURL.valueFrom)",message="'tokenURL' must have 'value' or 'valueFrom' set"
 // +kubebuilder:validation:XValidation:rule="has(self.clientID.value) || has(self.clientID.valueFrom)",message="'clientID' must have 'value' or 'valueFrom' set"
@@ -202,3 +232,71 @@ type FilterSpec struct {
 	// +kubebuilder:validation:Optional
 	Conditions []string `json:"conditions,omitempty"`
 }
+
+// Heartbeat configures synthetic heartbeat telemetry for a pipeline.
+type Heartbeat struct {
+	// Enabled specifies that a tagged heartbeat record is sent into the pipeline at the OTLP Gateway input every minute, and that its delivery to the backend is reported in the pipeline status. Pipelines without enabled heartbeat drop the heartbeat records. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Tap configures the live tap of a pipeline.
+type Tap struct {
+	// Enabled specifies that the pipeline can be tapped with the tap API of the manager. While a tap is open, the OTLP Gateway sends a sample of the data passing the tapped stage of the pipeline to the manager. The tap is removed once the requested number of records is captured. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+type RedactionPreset string
+
+const (
+	RedactionPresetEmail       RedactionPreset = "email"
+	RedactionPresetIBAN        RedactionPreset = "iban"
+	RedactionPresetCreditCard  RedactionPreset = "creditCard"
+	RedactionPresetJWT         RedactionPreset = "jwt"
+	RedactionPresetBearerToken RedactionPreset = "bearerToken"
+)
+
+// Redaction configures the masking of sensitive data. It is applied before the transforms of the pipeline, so that neither the transforms nor the backend see the original values.
+// +kubebuilder:validation:XValidation:rule="!(has(self.allowedAttributes) && has(self.deniedAttributes))",message="Only one of 'allowedAttributes' or 'deniedAttributes' can be defined"
+type Redaction struct {
+	// Presets specify built-in patterns for common sensitive data (`email`, `iban`, `creditCard`, `jwt`, or `bearerToken`). Matches in the values of resource and record attributes, and in the log body, are replaced with `***`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum=email;iban;creditCard;jwt;bearerToken
+	// +listType=set
+	Presets []RedactionPreset `json:"presets,omitempty"`
+	// Patterns specify custom regular expressions in RE2 syntax. Matches are replaced with `***` like the matches of the presets.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:MinLength=1
+	Patterns []string `json:"patterns,omitempty"`
+	// AllowedAttributes specify the only attribute keys that are kept on spans, span events, log records, and metric data points. All other attributes of these records are removed; resource attributes are not affected. You cannot specify an allow list together with a deny list.
+	// +kubebuilder:validation:Optional
+	AllowedAttributes []string `json:"allowedAttributes,omitempty"`
+	// DeniedAttributes specify attribute keys that are removed from the resource, spans, span events, log records, and metric data points. You cannot specify a deny list together with an allow list.
+	// +kubebuilder:validation:Optional
+	DeniedAttributes []string `json:"deniedAttributes,omitempty"`
+}
+
+// OutputRoute sends the telemetry data that matches its conditions to a secondary output instead of the default output of the pipeline.
+type OutputRoute struct {
+	// Name identifies the route within the pipeline.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// Conditions specify a list of OTTL conditions, which are ORed together. Telemetry data for which at least one condition evaluates to true is sent to the output of the route.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Conditions []string `json:"conditions"`
+	// Output configures the backend to which the matching telemetry data is sent.
+	// +kubebuilder:validation:Required
+	Output OutputRouteOutput `json:"output"`
+}
+
+// OutputRouteOutput defines the output configuration section of an OutputRoute.
+type OutputRouteOutput struct {
+	// OTLP output defines an output using the OpenTelemetry protocol.
+	// +kubebuilder:validation:Required
+	OTLP *OTLPOutput `json:"otlp"`
+}
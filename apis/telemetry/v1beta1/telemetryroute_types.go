@@ -0,0 +1,85 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//nolint:gochecknoinits // SchemeBuilder's registration is required.
+func init() {
+	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypes(GroupVersion, &TelemetryRoute{}, &TelemetryRouteList{})
+		return nil
+	})
+}
+
+// TelemetryRouteList contains a list of TelemetryRoute
+// +kubebuilder:object:root=true
+type TelemetryRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TelemetryRoute `json:"items"`
+}
+
+// TelemetryRoute is the Schema for the telemetryroutes API.
+// A TelemetryRoute is a namespaced pipeline that ships the telemetry data originating from its own namespace to a tenant-specific backend.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={kyma-telemetry,kyma-telemetry-pipelines}
+// +kubebuilder:metadata:labels={app.kubernetes.io/component=controller,app.kubernetes.io/managed-by=kyma,app.kubernetes.io/name=telemetry-manager,app.kubernetes.io/part-of=telemetry,kyma-project.io/module=telemetry}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Signals",type=string,JSONPath=`.spec.signals`
+// +kubebuilder:printcolumn:name="Configuration Generated",type=string,JSONPath=`.status.conditions[?(@.type=="ConfigurationGenerated")].status`
+// +kubebuilder:printcolumn:name="Gateway Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="GatewayHealthy")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:storageversion
+type TelemetryRoute struct {
+	metav1.TypeMeta `json:",inline"`
+	// +kubebuilder:validation:Optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the desired state of TelemetryRoute
+	// +kubebuilder:validation:Optional
+	Spec TelemetryRouteSpec `json:"spec"`
+	// Status shows the observed state of the TelemetryRoute
+	// +kubebuilder:validation:Optional
+	Status TelemetryRouteStatus `json:"status"`
+}
+
+// TelemetryRouteSignal is a telemetry signal type that a TelemetryRoute can ship.
+// +kubebuilder:validation:Enum=logs;metrics;traces
+type TelemetryRouteSignal string
+
+const (
+	TelemetryRouteSignalLogs    TelemetryRouteSignal = "logs"
+	TelemetryRouteSignalMetrics TelemetryRouteSignal = "metrics"
+	TelemetryRouteSignalTraces  TelemetryRouteSignal = "traces"
+)
+
+// TelemetryRouteSpec defines the desired state of TelemetryRoute
+type TelemetryRouteSpec struct {
+	// Signals specifies the telemetry signals that are shipped by the route. Only data pushed to the OTLP Gateway by workloads in the namespace of the TelemetryRoute is shipped.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Signals []TelemetryRouteSignal `json:"signals"`
+
+	// Output configures the backend to which the telemetry data is sent. All Secrets referenced by the output must be located in the namespace of the TelemetryRoute.
+	// +kubebuilder:validation:Required
+	Output TelemetryRouteOutput `json:"output"`
+}
+
+// TelemetryRouteOutput defines the output configuration section.
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp.authentication) || !has(self.otlp.authentication.serviceAccountToken)",message="Service account token authentication is not available for a TelemetryRoute"
+type TelemetryRouteOutput struct {
+	// OTLP output defines an output using the OpenTelemetry protocol.
+	// +kubebuilder:validation:Required
+	OTLP *OTLPOutput `json:"otlp"`
+}
+
+// TelemetryRouteStatus defines the observed state of TelemetryRoute.
+type TelemetryRouteStatus struct {
+	// An array of conditions describing the status of the route.
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
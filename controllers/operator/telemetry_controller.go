@@ -29,14 +29,17 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/config"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/telemetry"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/selfmonitor"
@@ -47,8 +50,10 @@ import (
 type TelemetryController struct {
 	client.Client
 
-	config     TelemetryControllerConfig
-	reconciler *telemetry.Reconciler
+	config TelemetryControllerConfig
+	// reconcileTriggerChan receives events for changes of the Secrets referenced by the Telemetry CR
+	reconcileTriggerChan <-chan event.GenericEvent
+	reconciler           *telemetry.Reconciler
 }
 
 type TelemetryControllerConfig struct {
@@ -58,9 +63,12 @@ type TelemetryControllerConfig struct {
 	SelfMonitorImage                  string
 	SelfMonitorPriorityClassName      string
 	WebhookCert                       webhookcert.Config
+
+	// EventRecorder emits Kubernetes Events for status condition transitions.
+	EventRecorder commonstatus.EventRecorder
 }
 
-func NewTelemetryController(config TelemetryControllerConfig, client client.Client, scheme *runtime.Scheme) *TelemetryController {
+func NewTelemetryController(config TelemetryControllerConfig, client client.Client, scheme *runtime.Scheme, reconcileTriggerChan <-chan event.GenericEvent) *TelemetryController {
 	reconciler := telemetry.New(
 		telemetry.Config{
 			Global:                            config.Global,
@@ -77,12 +85,14 @@ func NewTelemetryController(config TelemetryControllerConfig, client client.Clie
 				PriorityClassName: config.SelfMonitorPriorityClassName,
 			},
 		},
+		telemetry.WithEventRecorder(config.EventRecorder),
 	)
 
 	return &TelemetryController{
-		Client:     client,
-		config:     config,
-		reconciler: reconciler,
+		Client:               client,
+		config:               config,
+		reconcileTriggerChan: reconcileTriggerChan,
+		reconciler:           reconciler,
 	}
 }
 
@@ -146,6 +156,9 @@ func (r *TelemetryController) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&telemetryv1beta1.MetricPipeline{},
 			handler.EnqueueRequestsFromMapFunc(r.mapMetricPipeline),
+		).
+		WatchesRawSource(
+			source.Channel(r.reconcileTriggerChan, &handler.EnqueueRequestForObject{}),
 		)
 
 	return b.Complete(r)
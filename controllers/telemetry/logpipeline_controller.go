@@ -49,6 +49,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/logagent"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline"
 	logpipelinefluentbit "github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/fluentbit"
 	logpipelineotel "github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/otel"
@@ -59,6 +60,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
@@ -90,6 +92,10 @@ type LogPipelineControllerConfig struct {
 	FluentBitPriorityClassName string
 	LogAgentPriorityClassName  string
 	RestConfig                 *rest.Config
+	// EventRecorder emits Kubernetes Events for status condition transitions.
+	EventRecorder commonstatus.EventRecorder
+	// HeartbeatTracker reports whether the synthetic heartbeat of a pipeline is delivered to the backend.
+	HeartbeatTracker commonstatus.HeartbeatTracker
 }
 
 func NewLogPipelineController(config LogPipelineControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker) (*LogPipelineController, error) {
@@ -395,6 +401,7 @@ func configureFluentBitReconciler(config LogPipelineControllerConfig, client cli
 		logpipelinefluentbit.WithAgentProber(&workloadstatus.DaemonSetProber{Client: client}),
 
 		logpipelinefluentbit.WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		logpipelinefluentbit.WithEventRecorder(config.EventRecorder),
 		logpipelinefluentbit.WithFlowHealthProber(flowHealthProber),
 		logpipelinefluentbit.WithIstioStatusChecker(istiostatus.NewChecker(discoveryClient)),
 		logpipelinefluentbit.WithPipelineLock(pipelineLock),
@@ -423,6 +430,7 @@ func configureOTelReconciler(config LogPipelineControllerConfig, client client.C
 		logpipelineotel.WithSecretRefValidator(&secretref.Validator{Client: client}),
 		logpipelineotel.WithTransformSpecValidator(transformSpecValidator),
 		logpipelineotel.WithFilterSpecValidator(filterSpecValidator),
+		logpipelineotel.WithCollectorConfigValidator(collectorconfig.NewPipelineValidator(client)),
 	)
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
@@ -445,12 +453,15 @@ func configureOTelReconciler(config LogPipelineControllerConfig, client client.C
 
 		logpipelineotel.WithAgentApplierDeleter(agentApplierDeleter),
 		logpipelineotel.WithAgentConfigBuilder(agentConfigBuilder),
+		logpipelineotel.WithAgentConfigValidator(collectorconfig.NewValidator()),
 		logpipelineotel.WithAgentFlowHealthProber(agentFlowHealthProber),
 		logpipelineotel.WithGatewayFlowHealthProber(gatewayFlowHealthProber),
 		logpipelineotel.WithGatewayProber(&workloadstatus.DaemonSetProber{Client: client}),
 		logpipelineotel.WithAgentProber(&workloadstatus.DaemonSetProber{Client: client}),
 
 		logpipelineotel.WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		logpipelineotel.WithEventRecorder(config.EventRecorder),
+		logpipelineotel.WithHeartbeatTracker(config.HeartbeatTracker),
 
 		logpipelineotel.WithIstioStatusChecker(istiostatus.NewChecker(discoveryClient)),
 		logpipelineotel.WithVpaStatusChecker(vpastatus.NewChecker(config.RestConfig)),
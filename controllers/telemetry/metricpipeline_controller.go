@@ -48,6 +48,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metricagent"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
@@ -55,8 +56,10 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/prometheusoutput"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -83,6 +86,10 @@ type MetricPipelineControllerConfig struct {
 	MetricAgentPriorityClassName string
 	OTelCollectorImage           string
 	RestConfig                   *rest.Config
+	// EventRecorder emits Kubernetes Events for status condition transitions.
+	EventRecorder commonstatus.EventRecorder
+	// HeartbeatTracker reports whether the synthetic heartbeat of a pipeline is delivered to the backend.
+	HeartbeatTracker commonstatus.HeartbeatTracker
 }
 
 func NewMetricPipelineController(config MetricPipelineControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker) (*MetricPipelineController, error) {
@@ -137,6 +144,8 @@ func NewMetricPipelineController(config MetricPipelineControllerConfig, client c
 		metricpipeline.WithTransformSpecValidator(transformSpecValidator),
 		metricpipeline.WithFilterSpecValidator(filterSpecValidator),
 		metricpipeline.WithRuntimeAdditionalMetricsValidator(&runtimemetrics.Validator{}),
+		metricpipeline.WithPrometheusOutputValidator(&prometheusoutput.Validator{Client: client}),
+		metricpipeline.WithCollectorConfigValidator(collectorconfig.NewPipelineValidator(client)),
 	)
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
@@ -152,6 +161,7 @@ func NewMetricPipelineController(config MetricPipelineControllerConfig, client c
 
 		metricpipeline.WithAgentApplierDeleter(otelcollector.NewMetricAgentApplierDeleter(config.Global, config.OTelCollectorImage, config.MetricAgentPriorityClassName)),
 		metricpipeline.WithAgentConfigBuilder(agentConfigBuilder),
+		metricpipeline.WithAgentConfigValidator(collectorconfig.NewValidator()),
 		metricpipeline.WithAgentFlowHealthProber(agentFlowHealthProber),
 		metricpipeline.WithAgentProber(&workloadstatus.DaemonSetProber{Client: client}),
 
@@ -159,6 +169,8 @@ func NewMetricPipelineController(config MetricPipelineControllerConfig, client c
 		metricpipeline.WithGatewayProber(&workloadstatus.DaemonSetProber{Client: client}),
 
 		metricpipeline.WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		metricpipeline.WithEventRecorder(config.EventRecorder),
+		metricpipeline.WithHeartbeatTracker(config.HeartbeatTracker),
 		metricpipeline.WithIstioStatusChecker(istiostatus.NewChecker(discoveryClient)),
 		metricpipeline.WithVpaStatusChecker(vpastatus.NewChecker(config.RestConfig)),
 		metricpipeline.WithNodeSizeTracker(nodeSizeTracker),
@@ -49,8 +49,11 @@ import (
 	otlpgatewayreconciler "github.com/kyma-project/telemetry-manager/internal/reconciler/otlpgateway" //nolint:importas // needed to disambiguate from config package
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/vpastatus"
+	"github.com/kyma-project/telemetry-manager/internal/workloadstatus"
 )
 
 // OTLPGatewayController reconciles the OTLP Gateway DaemonSet based on pipeline references
@@ -60,6 +63,7 @@ type OTLPGatewayController struct {
 	reconcileTriggerChan <-chan event.GenericEvent
 	reconciler           *otlpgatewayreconciler.Reconciler
 	nodeSizeTracker      *nodesize.Tracker
+	tapSinkCASecret      types.NamespacedName
 }
 
 type OTLPGatewayControllerConfig struct {
@@ -68,9 +72,13 @@ type OTLPGatewayControllerConfig struct {
 	RestConfig                   *rest.Config
 	OTelCollectorImage           string
 	OTLPGatewayPriorityClassName string
+	// TapRegistry provides the taps opened in the manager, which are attached to the gateway configuration
+	TapRegistry otlpgatewayreconciler.TapRegistry
+	// TapSinkCASecret is the Secret holding the CA certificate of the serving certificate of the tap sink
+	TapSinkCASecret types.NamespacedName
 }
 
-func NewOTLPGatewayController(config OTLPGatewayControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, nodeSizeTracker *nodesize.Tracker) (*OTLPGatewayController, error) {
+func NewOTLPGatewayController(config OTLPGatewayControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker) (*OTLPGatewayController, error) {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create discovery client: %w", err)
@@ -91,6 +99,10 @@ func NewOTLPGatewayController(config OTLPGatewayControllerConfig, client client.
 		otlpgatewayreconciler.WithIstioStatusChecker(istiostatus.NewChecker(discoveryClient)),
 		otlpgatewayreconciler.WithVpaStatusChecker(vpastatus.NewChecker(config.RestConfig)),
 		otlpgatewayreconciler.WithNodeSizeTracker(nodeSizeTracker),
+		otlpgatewayreconciler.WithSecretWatcher(secretWatchClient),
+		otlpgatewayreconciler.WithGatewayProber(&workloadstatus.DaemonSetProber{Client: client}),
+		otlpgatewayreconciler.WithConfigValidator(collectorconfig.NewValidator()),
+		otlpgatewayreconciler.WithTapRegistry(config.TapRegistry, config.TapSinkCASecret),
 	)
 
 	return &OTLPGatewayController{
@@ -98,6 +110,7 @@ func NewOTLPGatewayController(config OTLPGatewayControllerConfig, client client.
 		reconcileTriggerChan: reconcileTriggerChan,
 		reconciler:           reconciler,
 		nodeSizeTracker:      nodeSizeTracker,
+		tapSinkCASecret:      config.TapSinkCASecret,
 	}, nil
 }
 
@@ -191,6 +204,15 @@ func (r *OTLPGatewayController) SetupWithManager(mgr ctrl.Manager) error {
 		ctrlbuilder.WithPredicates(ctrlpredicate.Or(ctrlpredicate.GenerationChangedPredicate{}, ctrlpredicate.AnnotationChangedPredicate{})),
 	)
 
+	// Watch the CA certificate of the tap sink, which is part of the gateway configuration while a tap is open
+	b.Watches(
+		&corev1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(r.mapOwnedResourceChanges),
+		ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetName() == r.tapSinkCASecret.Name && obj.GetNamespace() == r.tapSinkCASecret.Namespace
+		})),
+	)
+
 	// Watch for changes in Nodes to track the smallest node memory and trigger reconciliation if it changes
 	b.Watches(
 		&corev1.Node{},
@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/config"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/telemetryroute"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
+	"github.com/kyma-project/telemetry-manager/internal/workloadstatus"
+)
+
+// TelemetryRouteController reconciles a TelemetryRoute object
+type TelemetryRouteController struct {
+	client.Client
+
+	reconcileTriggerChan <-chan event.GenericEvent
+	reconciler           *telemetryroute.Reconciler
+	targetNamespace      string
+}
+
+type TelemetryRouteControllerConfig struct {
+	config.Global
+	// EventRecorder emits Kubernetes Events for status condition transitions.
+	EventRecorder commonstatus.EventRecorder
+}
+
+func NewTelemetryRouteController(config TelemetryRouteControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client) (*TelemetryRouteController, error) {
+	flowHealthProber, err := prober.NewOTelTelemetryRouteGatewayProber(types.NamespacedName{Name: names.SelfMonitor, Namespace: config.TargetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+
+	routeValidator := telemetryroute.NewValidator(
+		telemetryroute.WithEndpointValidator(&endpoint.Validator{Client: client}),
+		telemetryroute.WithTLSCertValidator(tlscert.New(client)),
+		telemetryroute.WithSecretRefValidator(&secretref.Validator{Client: client}),
+	)
+
+	reconciler := telemetryroute.New(
+		telemetryroute.WithClient(client),
+		telemetryroute.WithGlobals(config.Global),
+
+		telemetryroute.WithGatewayProber(&workloadstatus.DaemonSetProber{Client: client}),
+		telemetryroute.WithFlowHealthProber(flowHealthProber),
+		telemetryroute.WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		telemetryroute.WithEventRecorder(config.EventRecorder),
+
+		telemetryroute.WithRouteValidator(routeValidator),
+		telemetryroute.WithSecretWatcher(secretWatchClient),
+	)
+
+	return &TelemetryRouteController{
+		Client:               client,
+		reconcileTriggerChan: reconcileTriggerChan,
+		reconciler:           reconciler,
+		targetNamespace:      config.TargetNamespace(),
+	}, nil
+}
+
+func (r *TelemetryRouteController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+func (r *TelemetryRouteController) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).For(&telemetryv1beta1.TelemetryRoute{})
+
+	b.WatchesRawSource(
+		source.Channel(r.reconcileTriggerChan, &handler.EnqueueRequestForObject{}),
+	)
+
+	// Watch OTLP Gateway DaemonSet to update GatewayHealthy condition when gateway status changes
+	b.Watches(
+		&appsv1.DaemonSet{}, // OTLP Gateway DaemonSet
+		handler.EnqueueRequestsFromMapFunc(r.mapOTLPGatewayChanges),
+		ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetName() == names.OTLPGateway &&
+				object.GetNamespace() == r.targetNamespace
+		})),
+	)
+
+	return b.Complete(r)
+}
+
+// mapOTLPGatewayChanges enqueues reconciliation requests for all TelemetryRoutes when the OTLP Gateway
+// DaemonSet changes. This ensures that the GatewayHealthy status condition is updated to reflect the
+// current gateway state.
+func (r *TelemetryRouteController) mapOTLPGatewayChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	logf.FromContext(ctx).V(1).Info("OTLP Gateway DaemonSet changed, triggering reconciliation of all TelemetryRoutes")
+
+	var routeList telemetryv1beta1.TelemetryRouteList
+	if err := r.List(ctx, &routeList); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list TelemetryRoutes")
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(routeList.Items))
+	for i := range routeList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      routeList.Items[i].Name,
+				Namespace: routeList.Items[i].Namespace,
+			},
+		}
+	}
+
+	return requests
+}
@@ -38,12 +38,14 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/config"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
@@ -66,6 +68,10 @@ type TracePipelineControllerConfig struct {
 
 	RestConfig         *rest.Config
 	OTelCollectorImage string
+	// EventRecorder emits Kubernetes Events for status condition transitions.
+	EventRecorder commonstatus.EventRecorder
+	// HeartbeatTracker reports whether the synthetic heartbeat of a pipeline is delivered to the backend.
+	HeartbeatTracker commonstatus.HeartbeatTracker
 }
 
 func NewTracePipelineController(config TracePipelineControllerConfig, client client.Client, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client) (*TracePipelineController, error) {
@@ -114,6 +120,7 @@ func NewTracePipelineController(config TracePipelineControllerConfig, client cli
 		tracepipeline.WithValidatorPipelineLock(pipelineLock),
 		tracepipeline.WithTransformSpecValidator(transformSpecValidator),
 		tracepipeline.WithFilterSpecValidator(filterSpecValidator),
+		tracepipeline.WithCollectorConfigValidator(collectorconfig.NewPipelineValidator(client)),
 	)
 
 	reconciler := tracepipeline.New(
@@ -124,6 +131,8 @@ func NewTracePipelineController(config TracePipelineControllerConfig, client cli
 		tracepipeline.WithGatewayProber(&workloadstatus.DaemonSetProber{Client: client}),
 		tracepipeline.WithOverridesHandler(overrides.New(config.Global, client)),
 		tracepipeline.WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		tracepipeline.WithEventRecorder(config.EventRecorder),
+		tracepipeline.WithHeartbeatTracker(config.HeartbeatTracker),
 
 		tracepipeline.WithPipelineLock(pipelineLock),
 		tracepipeline.WithPipelineSyncer(pipelineSync),
@@ -21,6 +21,7 @@ export default [
       { text: 'Collect Prometheus Metrics', link: './collecting-metrics/prometheus-input' },
       { text: 'Collect Istio Metrics', link: './collecting-metrics/istio-input' },
       { text: 'Collect Runtime Metrics', link: './collecting-metrics/runtime-input' },
+      { text: 'Collect Control Plane Metrics', link: './collecting-metrics/control-plane-input' },
     ]
   },
   {
@@ -32,6 +33,8 @@ export default [
         { text: 'Transform with OTTL', link: './filter-and-process/ottl-transform-and-filter/ottl-transform' },
         { text: 'Filter with OTTL', link: './filter-and-process/ottl-transform-and-filter/ottl-filter' },
       ]},
+      { text: 'Redact Sensitive Data', link: './filter-and-process/redact-sensitive-data' },
+      { text: 'Route Data to Multiple Outputs', link: './filter-and-process/route-to-outputs' },
       { text: 'Transformation to OTLP Logs', link: './filter-and-process/transformation-to-otlp-logs' },
       { text: 'Automatic Data Enrichment', link: './filter-and-process/automatic-data-enrichment' }
     ]
@@ -79,6 +82,7 @@ export default [
       { text: 'LogPipeline', link: './resources/02-logpipeline' },
       { text: 'TracePipeline', link: './resources/04-tracepipeline' },
       { text: 'MetricPipeline', link: './resources/05-metricpipeline' },
+      { text: 'TelemetryRoute', link: './resources/06-telemetryroute' },
     ]
   },
   { text: 'Logs (Fluent Bit)', link: './02-logs' }
@@ -7,6 +7,10 @@ const (
 	LinkNotAllDataArriveAtBackend = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#not-all-data-arrive-at-the-backend"
 	LinkGatewayThrottling         = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#gateway-throttling"
 	LinkOTTLSpecInvalid           = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#ottl-spec-invalid-with-unspecific-error-message"
+	LinkConfigGenerationFailed    = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#configuration-generation-failed"
+	LinkHeartbeatFailing          = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#heartbeat-failing"
+	LinkScrapeTargetsFailing      = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#scrape-targets-failing"
+	LinkLogBufferFillingUp        = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#logpipeline-log-buffer-filling-up"
 
 	LinkFluentBitNoLogsArriveAtBackend     = "https://kyma-project.io/#/telemetry-manager/user/02-logs?id=no-logs-arrive-at-the-backend"
 	LinkFluentBitNotAllLogsArriveAtBackend = "https://kyma-project.io/#/telemetry-manager/user/02-logs?id=not-all-logs-arrive-at-the-backend"
@@ -16,6 +20,7 @@ const (
 const (
 	TypeAgentHealthy            = "AgentHealthy"
 	TypeConfigurationGenerated  = "ConfigurationGenerated"
+	TypeExternalIngestion       = "ExternalIngestionConfigured"
 	TypeFlowHealthy             = "TelemetryFlowHealthy"
 	TypeGatewayHealthy          = "GatewayHealthy"
 	TypeLogComponentsHealthy    = "LogComponentsHealthy"
@@ -44,6 +49,8 @@ const (
 	ReasonSelfMonAgentProbingFailed        = "AgentProbingFailed"
 	ReasonSelfMonGatewayThrottling         = "GatewayThrottling"
 	ReasonSelfMonConfigNotGenerated        = "ConfigurationNotGenerated"
+	ReasonSelfMonHeartbeatFailing          = "HeartbeatFailing"
+	ReasonSelfMonScrapeTargetsFailing      = "ScrapeTargetsFailing"
 	ReasonGatewayConfigurationNotGenerated = "GatewayConfigurationNotGenerated"
 	ReasonTLSCertificateAboutToExpire      = "TLSCertificateAboutToExpire"
 	ReasonTLSCertificateExpired            = "TLSCertificateExpired"
@@ -52,10 +59,14 @@ const (
 	ReasonRolloutInProgress                = "RolloutInProgress"
 	ReasonOTTLSpecInvalid                  = "OTTLSpecInvalid"
 	ReasonRuntimeAdditionalMetricInvalid   = "RuntimeAdditionalMetricInvalid"
+	ReasonPrometheusOutputInUse            = "PrometheusOutputInUse"
+	ReasonSuspended                        = "Suspended"
+	ReasonConfigGenerationFailed           = "ConfigGenerationFailed"
 
 	// Telemetry reasons
 
 	ReasonComponentsRunning      = "ComponentsRunning"
+	ReasonExternalIngestionReady = "ExternalIngestionReady"
 	ReasonNoPipelineDeployed     = "NoPipelineDeployed"
 	ReasonResourceBlocksDeletion = "ResourceBlocksDeletion"
 
@@ -69,6 +80,11 @@ const (
 	// MetricPipeline reasons
 
 	ReasonMetricAgentNotRequired = "AgentNotRequired"
+
+	// TelemetryRoute reasons
+
+	ReasonSecretRefNamespaceNotAllowed = "SecretRefNamespaceNotAllowed"
+	ReasonAuthenticationNotAllowed     = "AuthenticationNotAllowed"
 )
 
 // Error messages
@@ -83,10 +99,13 @@ var commonMessages = map[string]string{
 	ReasonTLSConfigurationInvalid: "TLS configuration invalid: %s",
 	ReasonValidationFailed:        "Pipeline validation failed due to an error from the Kubernetes API server",
 	ReasonOTTLSpecInvalid:         "OTTL specification is invalid, %s. Fix the syntax error indicated by the message or see troubleshooting: " + LinkOTTLSpecInvalid,
+	ReasonConfigGenerationFailed:  "Generated collector configuration is invalid: %s. The pipeline is excluded from the collector configuration until it is fixed. See troubleshooting: " + LinkConfigGenerationFailed,
 
-	ReasonGatewayNotReady:    "OTLP Gateway DaemonSet is not ready",
-	ReasonGatewayReady:       "OTLP Gateway DaemonSet is ready",
-	ReasonNoPipelineDeployed: "No pipelines have been deployed",
+	ReasonGatewayNotReady:        "OTLP Gateway DaemonSet is not ready",
+	ReasonGatewayReady:           "OTLP Gateway DaemonSet is ready",
+	ReasonNoPipelineDeployed:     "No pipelines have been deployed",
+	ReasonExternalIngestionReady: "External ingestion is enabled on the OTLP Gateway",
+	ReasonSuspended:              "Pipeline is suspended and not part of the collector configuration. Remove the 'spec.suspend' field to resume it",
 
 	ReasonSelfMonFlowHealthy:          "No problems detected in the telemetry flow",
 	ReasonSelfMonGatewayProbingFailed: "Could not determine the health of the telemetry flow because the self monitor probing of gateway failed",
@@ -119,9 +138,12 @@ var otelLogPipelineMessages = map[string]string{
 
 	ReasonSelfMonAgentAllDataDropped:    "Backend is not reachable or rejecting logs. All logs are dropped in Log Agent. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonAgentSomeDataDropped:   "Backend is reachable, but rejecting logs. Some logs are dropped in Log Agent. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonAgentBufferFillingUp:   "Buffer nearing capacity. Incoming log rate exceeds export rate. Logs are dropped in Log Agent once the buffer is full. See troubleshooting: " + LinkLogBufferFillingUp,
+	ReasonSelfMonAgentNoLogsDelivered:   "Backend is not reachable or rejecting logs. Logs are read by Log Agent but not delivered, and are buffered until the buffer is full. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonConfigNotGenerated:     "No logs delivered to backend because LogPipeline specification is not applied to the configuration of Log Agent and OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
 	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting logs. All logs are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting logs. Some logs are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonHeartbeatFailing:       "Heartbeat logs are not delivered to the backend. The pipeline does not deliver any data end to end. See troubleshooting: " + LinkHeartbeatFailing,
 	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive logs at current rate. See troubleshooting: " + LinkGatewayThrottling,
 }
 
@@ -135,6 +157,7 @@ var tracePipelineMessages = map[string]string{
 	ReasonSelfMonConfigNotGenerated:     "No spans delivered to backend because TracePipeline specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
 	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting spans. All spans are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting spans. Some spans are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonHeartbeatFailing:       "Heartbeat spans are not delivered to the backend. The pipeline does not deliver any data end to end. See troubleshooting: " + LinkHeartbeatFailing,
 	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive spans at current rate. See troubleshooting: " + LinkGatewayThrottling,
 }
 
@@ -152,7 +175,22 @@ var metricPipelineMessages = map[string]string{
 	ReasonSelfMonConfigNotGenerated:     "No metrics delivered to backend because MetricPipeline specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
 	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting metrics. All metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting metrics. Some metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonHeartbeatFailing:       "Heartbeat metrics are not delivered to the backend. The pipeline does not deliver any data end to end. See troubleshooting: " + LinkHeartbeatFailing,
 	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive metrics at current rate. See troubleshooting: " + LinkGatewayThrottling,
+	ReasonSelfMonScrapeTargetsFailing:   "Prometheus scrape targets failing: workloads with the most failing targets are %s. No metrics are collected from failing targets. See troubleshooting: " + LinkScrapeTargetsFailing,
+}
+
+var telemetryRouteMessages = map[string]string{
+	ReasonEndpointInvalid:          "OTLP output endpoint invalid: %s",
+	ReasonAuthenticationNotAllowed: "Service account token authentication is not available for a TelemetryRoute, because the token identifies the OTLP Gateway. Use basic or OAuth2 authentication instead",
+
+	ReasonGatewayConfigured:                "TelemetryRoute specification is successfully applied to the configuration of OTLP Gateway",
+	ReasonGatewayConfigurationNotGenerated: "This TelemetryRoute's specification is not applied to the configuration of the OTLP gateway. Check the 'ConfigurationGenerated' condition for more details",
+
+	ReasonSelfMonConfigNotGenerated:     "No telemetry data delivered to backend because TelemetryRoute specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
+	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting telemetry data. All telemetry data is dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
+	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting telemetry data. Some telemetry data is dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive telemetry data at current rate. See troubleshooting: " + LinkGatewayThrottling,
 }
 
 func MessageForOtelLogPipeline(reason string) string {
@@ -187,6 +225,14 @@ func MessageForMetricPipeline(reason string) string {
 	return message(reason, metricPipelineMessages)
 }
 
+func MessageForTelemetryRoute(reason string) string {
+	return message(reason, telemetryRouteMessages)
+}
+
+func MessageForTelemetry(reason string) string {
+	return message(reason, nil)
+}
+
 func message(reason string, specializedMessages map[string]string) string {
 	if condMessage, found := commonMessages[reason]; found {
 		return condMessage
@@ -19,6 +19,9 @@ func TestMessageFor(t *testing.T) {
 	t.Run("should return correct message which is unique to each pipeline", func(t *testing.T) {
 		logsDaemonSetNotReadyMessage := MessageForFluentBitLogPipeline(ReasonEndpointInvalid)
 		require.Equal(t, fluentBitLogPipelineMessages[ReasonEndpointInvalid], logsDaemonSetNotReadyMessage)
+
+		routeConfiguredMessage := MessageForTelemetryRoute(ReasonGatewayConfigured)
+		require.Equal(t, telemetryRouteMessages[ReasonGatewayConfigured], routeConfiguredMessage)
 	})
 
 	t.Run("should return empty message for reasons which do not have a specialized message", func(t *testing.T) {
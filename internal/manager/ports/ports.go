@@ -5,4 +5,5 @@ const (
 	Metrics     = 8080
 	HealthProbe = 8081
 	Pprof       = 6060
+	TapSink     = 9444
 )
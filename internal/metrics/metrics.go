@@ -37,6 +37,7 @@ const (
 
 	FeatureInputPrometheus        = "input-prometheus"
 	FeatureInputIstio             = "input-istio"
+	FeatureInputControlPlane      = "input-control-plane"
 	FeatureOutputDeltaTemporality = "output-delta-temporality"
 
 	// FluentBit features
@@ -60,6 +61,7 @@ var (
 		FeatureInputRuntime,
 		FeatureInputPrometheus,
 		FeatureInputIstio,
+		FeatureInputControlPlane,
 		FeatureOutputDeltaTemporality,
 	}
 
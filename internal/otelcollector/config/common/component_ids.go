@@ -14,12 +14,15 @@ type ComponentID = string
 // ================================================================================
 
 const ComponentIDOTLPReceiver ComponentID = "otlp"
+const ComponentIDOTLPExternalReceiver ComponentID = "otlp/external"
 const ComponentIDKymaStatsReceiver ComponentID = "kymastats"
 const ComponentIDK8sClusterReceiver ComponentID = "k8s_cluster"
 const ComponentIDKubeletStatsReceiver ComponentID = "kubelet_stats"
+const ComponentIDHostMetricsReceiver ComponentID = "host_metrics"
 const ComponentIDPrometheusAppPodsReceiver ComponentID = "prometheus/app-pods"
 const ComponentIDPrometheusAppServicesReceiver ComponentID = "prometheus/app-services"
 const ComponentIDPrometheusIstioReceiver ComponentID = "prometheus/istio"
+const ComponentIDPrometheusControlPlaneReceiver ComponentID = "prometheus/control-plane"
 
 // ComponentIDFileLogReceiver generates a component ID for the file_log receiver specific to a log pipeline.
 // Pipeline name is included in the component ID to keep it unique across pipelines.
@@ -39,20 +42,26 @@ const ComponentIDBatchProcessor ComponentID = "batch"
 const ComponentIDMemoryLimiterProcessor ComponentID = "memory_limiter"
 const ComponentIDK8sAttributesProcessor ComponentID = "k8s_attributes"
 const ComponentIDCumulativeToDeltaProcessor ComponentID = "cumulativetodelta"
+const ComponentIDDropExemplarsProcessor ComponentID = "transform/drop-exemplars"
 const ComponentIDServiceEnrichmentProcessor ComponentID = "service_enrichment"
 const ComponentIDIstioNoiseFilterProcessor ComponentID = "istio_noise_filter"
 const ComponentIDSetInstrumentationScopeKymaProcessor ComponentID = "transform/set-instrumentation-scope-kyma"
 const ComponentIDSetInstrumentationScopeRuntimeProcessor ComponentID = "transform/set-instrumentation-scope-runtime"
 const ComponentIDInsertClusterAttributesProcessor ComponentID = "transform/insert-cluster-attributes"
 const ComponentIDDropKymaAttributesProcessor ComponentID = "transform/drop-kyma-attributes"
+const ComponentIDDropSenderPodIdentityProcessor ComponentID = "transform/drop-sender-pod-identity"
 const ComponentIDDropUnknownServiceNameProcessor ComponentID = "transform/drop-unknown-service-name"
 const ComponentIDRestoreOtelServiceAttrsProcessor ComponentID = "transform/restore-otel-service-attrs"
+const ComponentIDInsertTenantAttributeProcessor ComponentID = "transform/insert-tenant-attribute"
+const ComponentIDDropHeartbeatProcessor ComponentID = "filter/drop-heartbeat"
 
 const ComponentIDSetKymaInputNameRuntimeProcessor ComponentID = "transform/set-kyma-input-name-runtime"
 const ComponentIDSetKymaInputNameIstioProcessor ComponentID = "transform/set-kyma-input-name-istio"
 const ComponentIDSetKymaInputNamePrometheusProcessor ComponentID = "transform/set-kyma-input-name-prometheus"
 const ComponentIDSetKymaInputNameKymaProcessor ComponentID = "transform/set-kyma-input-name-kyma"
 const ComponentIDSetKymaInputNameOTLPProcessor ComponentID = "transform/set-kyma-input-name-otlp"
+const ComponentIDSetKymaInputNameControlPlaneProcessor ComponentID = "transform/set-kyma-input-name-control-plane"
+const ComponentIDSetKymaInputScrapeGroupProcessor ComponentID = "transform/set-kyma-input-scrape-group"
 
 // ComponentIDUserDefinedFilterProcessor generates a component ID for the user-defined filter processor.
 // Pipeline type and name are included in the component ID to keep it unique across pipelines.
@@ -70,8 +79,34 @@ func ComponentIDUserDefinedTransformProcessor(pipelineRef pipelines.PipelineRef)
 	return fmt.Sprintf("transform/%s-user-defined-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
 }
 
+// ComponentIDRedactionProcessor generates a component ID for the processor that applies the redaction of a pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: transform/tracepipeline-redaction-mypipeline
+func ComponentIDRedactionProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/%s-redaction-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDOutputRoutesProcessor generates a component ID for the processor that marks the data of a pipeline with the output route it matches.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: transform/tracepipeline-output-routes-mypipeline
+func ComponentIDOutputRoutesProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/%s-output-routes-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+const ComponentIDDropOutputRouteAttributeProcessor ComponentID = "transform/drop-output-route-attribute"
+
 // LOG-SPECIFIC PROCESSORS ========================================================
 
+// ComponentIDTapSamplingProcessor generates a component ID for the filter processor that samples the data of a tapped pipeline
+// before it is sent to the manager.
+//
+// Example: filter/tap_tracepipeline-mypipeline
+func ComponentIDTapSamplingProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("filter/tap_%s", pipelineRef.QualifiedName())
+}
+
 // ComponentIDNamespaceFilterProcessor generates a component ID for the namespace filter processor specific to a log pipeline.
 // Pipeline name is included in the component ID to keep it unique across pipelines.
 //
@@ -94,6 +129,7 @@ const ComponentIDDropIfInputSourceRuntimeProcessor ComponentID = "filter/drop-if
 const ComponentIDDropIfInputSourcePrometheusProcessor ComponentID = "filter/drop-if-input-source-prometheus"
 const ComponentIDDropIfInputSourceIstioProcessor ComponentID = "filter/drop-if-input-source-istio"
 const ComponentIDDropIfInputSourceOTLPProcessor ComponentID = "filter/drop-if-input-source-otlp"
+const ComponentIDDropIfInputSourceOTLPKeepHeartbeatProcessor ComponentID = "filter/drop-if-input-source-otlp-keep-heartbeat"
 const ComponentIDDropEnvoyMetricsIfDisabledProcessor ComponentID = "filter/drop-envoy-metrics-if-disabled"
 
 // ComponentIDNamespacePerInputFilterProcessor generates a component ID for the namespace filter processor specific to a metric pipeline.
@@ -117,21 +153,75 @@ const ComponentIDDropRuntimeDeploymentMetricsProcessor ComponentID = "filter/dro
 const ComponentIDDropRuntimeDaemonSetMetricsProcessor ComponentID = "filter/drop-runtime-daemonset-metrics"
 const ComponentIDDropRuntimeStatefulSetMetricsProcessor ComponentID = "filter/drop-runtime-statefulset-metrics"
 const ComponentIDDropRuntimeJobMetricsProcessor ComponentID = "filter/drop-runtime-job-metrics"
+const ComponentIDDropRuntimeReplicaSetMetricsProcessor ComponentID = "filter/drop-runtime-replicaset-metrics"
+const ComponentIDDropRuntimeCronJobMetricsProcessor ComponentID = "filter/drop-runtime-cronjob-metrics"
+const ComponentIDDropRuntimeHPAMetricsProcessor ComponentID = "filter/drop-runtime-hpa-metrics"
+const ComponentIDDropRuntimePVCMetricsProcessor ComponentID = "filter/drop-runtime-pvc-metrics"
+const ComponentIDDropRuntimeResourceQuotaMetricsProcessor ComponentID = "filter/drop-runtime-resourcequota-metrics"
+const ComponentIDDropRuntimeNamespaceMetricsProcessor ComponentID = "filter/drop-runtime-namespace-metrics"
+const ComponentIDDropRuntimeHostMetricsProcessor ComponentID = "filter/drop-runtime-host-metrics"
 const ComponentIDDropRuntimeAdditionalMetricsProcessor ComponentID = "filter/drop-runtime-additional-metrics"
+const ComponentIDDropControlPlaneComponentMetricsProcessor ComponentID = "filter/drop-control-plane-component-metrics"
 const ComponentIDDropPrometheusDiagnosticMetricsProcessor ComponentID = "filter/drop-diagnostic-metrics-if-input-source-prometheus"
 const ComponentIDDropIstioDiagnosticMetricsProcessor ComponentID = "filter/drop-diagnostic-metrics-if-input-source-istio"
+const ComponentIDDropControlPlaneDiagnosticMetricsProcessor ComponentID = "filter/drop-diagnostic-metrics-if-input-source-control-plane"
 const ComponentIDFilterDropNonPVCVolumesMetricsProcessor ComponentID = "filter/drop-non-pvc-volumes-metrics"
 const ComponentIDFilterDropVirtualNetworkInterfacesProcessor ComponentID = "filter/drop-virtual-network-interfaces"
 const ComponentIDDropServiceNameProcessor ComponentID = "transform/drop-service-name"
 const ComponentIDDropSkipEnrichmentAttributeProcessor ComponentID = "transform/drop-skip-enrichment-attribute"
 const ComponentIDSetInstrumentationScopePrometheusProcessor ComponentID = "transform/set-instrumentation-scope-prometheus"
 const ComponentIDSetInstrumentationScopeIstioProcessor ComponentID = "transform/set-instrumentation-scope-istio"
+const ComponentIDSetInstrumentationScopeControlPlaneProcessor ComponentID = "transform/set-instrumentation-scope-control-plane"
 const ComponentIDInsertSkipEnrichmentAttributeProcessor ComponentID = "transform/insert-skip-enrichment-attribute"
+const ComponentIDInsertHostNodeNameProcessor ComponentID = "transform/insert-host-node-name"
+const ComponentIDKeepScrapeHealthMetricsProcessor ComponentID = "filter/keep-scrape-health-metrics"
+const ComponentIDSetScrapeHealthAttributesProcessor ComponentID = "transform/set-scrape-health-attributes"
+
+// ComponentIDAggregationDropAttributesProcessor generates a component ID for the transform processor that removes the attributes
+// listed in the aggregation of a metric pipeline.
+//
+// Example: transform/aggregation-drop-attributes-metricpipeline-mypipeline
+func ComponentIDAggregationDropAttributesProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/aggregation-drop-attributes-%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDAggregationGroupByAttrsProcessor generates a component ID for the groupbyattrs processor that merges the resources
+// which became identical after dropping the aggregation attributes of a metric pipeline.
+//
+// Example: groupbyattrs/aggregation-metricpipeline-mypipeline
+func ComponentIDAggregationGroupByAttrsProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("groupbyattrs/aggregation-%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDAggregationMergeSeriesProcessor generates a component ID for the transform processor that merges the series
+// which became identical after dropping the aggregation attributes of a metric pipeline.
+//
+// Example: transform/aggregation-merge-series-metricpipeline-mypipeline
+func ComponentIDAggregationMergeSeriesProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/aggregation-merge-series-%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDIntervalProcessor generates a component ID for the interval processor of a metric pipeline.
+//
+// Example: interval/metricpipeline-mypipeline
+func ComponentIDIntervalProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("interval/%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
 
 // TRACE-SPECIFIC PROCESSORS ======================================================
 
 const ComponentIDDropIstioServiceEnrichmentProcessor ComponentID = "transform/drop-istio-service-enrichment"
 
+// TELEMETRY-ROUTE-SPECIFIC PROCESSORS ============================================
+
+// ComponentIDTelemetryRouteNamespaceFilterProcessor generates a component ID for the namespace filter processor specific to a TelemetryRoute.
+// Route type, namespace and name are included in the component ID to keep it unique across routes and signal types.
+//
+// Example: filter/traceroute-myns.myroute-filter-by-namespace
+func ComponentIDTelemetryRouteNamespaceFilterProcessor(routeRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("filter/%s-filter-by-namespace", routeRef.QualifiedName())
+}
+
 // ================================================================================
 // EXPORTERS
 // ================================================================================
@@ -148,17 +238,53 @@ func ComponentIDOTLPExporter(protocol telemetryv1beta1.OTLPProtocol, pipelineRef
 	return fmt.Sprintf("otlp_grpc/%s", pipelineRef.QualifiedName())
 }
 
+const ComponentIDScrapeHealthExporter ComponentID = "prometheus/scrape-health"
+
+// ComponentIDTapExporter generates a component ID for the OTLP HTTP exporter of a tapped pipeline, which sends the tapped data to the manager.
+// The underscore cannot be part of a pipeline name, so the ID does not collide with the exporter of another pipeline.
+//
+// Example: otlp_http/tap_tracepipeline-mypipeline
+func ComponentIDTapExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("otlp_http/tap_%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDPrometheusExporter generates a component ID for the Prometheus exporter of a MetricPipeline with a prometheus output.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: prometheus/metricpipeline-mypipeline
+func ComponentIDPrometheusExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("prometheus/%s", pipelineRef.QualifiedName())
+}
+
 // ================================================================================
 // CONNECTORS
 // ================================================================================
 
 const ComponentIDEnrichmentConnector ComponentID = "forward/enrichment"
 const ComponentIDInputConnector ComponentID = "forward/input"
+const ComponentIDExternalInputConnector ComponentID = "forward/external-input"
+const ComponentIDControlPlaneInputConnector ComponentID = "forward/control-plane-input"
 const ComponentIDEnrichmentRoutingConnector ComponentID = "routing/enrichment"
 const ComponentIDRuntimeInputRoutingConnector ComponentID = "routing/runtime-input"
 const ComponentIDPrometheusInputRoutingConnector ComponentID = "routing/prometheus-input"
 const ComponentIDIstioInputRoutingConnector ComponentID = "routing/istio-input"
 
+// ComponentIDTapConnector generates a component ID for the forward connector that splits the service pipeline of a tapped pipeline
+// after the tapped stage.
+//
+// Example: forward/tap_tracepipeline-mypipeline
+func ComponentIDTapConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("forward/tap_%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDOutputRoutingConnector generates a component ID for the routing connector that distributes the data of a pipeline
+// with output routes to the service pipelines of its outputs.
+//
+// Example: routing/tracepipeline-mypipeline
+func ComponentIDOutputRoutingConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("routing/%s", pipelineRef.QualifiedName())
+}
+
 // ================================================================================
 // EXTENSIONS
 // ================================================================================
@@ -168,6 +294,8 @@ const ComponentIDFileStorageExtension ComponentID = "file_storage"
 const ComponentIDHealthCheckExtension ComponentID = "health_check"
 const ComponentIDPprofExtension ComponentID = "pprof"
 const ComponentIDCGroupRuntimeExtension ComponentID = "cgroup_runtime"
+const ComponentIDExternalIngestionBearerTokenAuthExtension ComponentID = "bearertokenauth/external-ingestion"
+const ComponentIDTapSinkAuthExtension ComponentID = "bearertokenauth/tap-sink"
 
 // ComponentIDOAuth2Extension generates a component ID for the OAuth2 client extension.
 // Pipeline name is included in the component ID to keep it unique across pipelines.
@@ -176,3 +304,11 @@ const ComponentIDCGroupRuntimeExtension ComponentID = "cgroup_runtime"
 func ComponentIDOAuth2Extension(pipelineRef pipelines.PipelineRef) ComponentID {
 	return fmt.Sprintf("oauth2client/%s", pipelineRef.QualifiedName())
 }
+
+// ComponentIDServiceAccountTokenAuthExtension generates a component ID for the bearer token extension, which sends the service account token of an output.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: bearertokenauth/logpipeline-mypipeline
+func ComponentIDServiceAccountTokenAuthExtension(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("bearertokenauth/%s", pipelineRef.QualifiedName())
+}
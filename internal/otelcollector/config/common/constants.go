@@ -7,6 +7,28 @@ const (
 	EnvVarGoDebug         = "GODEBUG"
 )
 
+const (
+	EnvVarExternalIngestionBearerToken = "OTLP_EXTERNAL_INGESTION_BEARER_TOKEN"
+	EnvVarExternalIngestionTLSCert     = "OTLP_EXTERNAL_INGESTION_TLS_CERT_PEM"
+	EnvVarExternalIngestionTLSKey      = "OTLP_EXTERNAL_INGESTION_TLS_KEY_PEM"
+	EnvVarExternalIngestionClientCA    = "OTLP_EXTERNAL_INGESTION_CLIENT_CA_PEM"
+
+	// ExternalIngestionClientCADir is the directory where the client CA for mTLS of external ingestion is mounted.
+	// The receiver only supports reading the client CA from a file, so it cannot be passed as an env var.
+	ExternalIngestionClientCADir      = "/etc/collector/external-ingestion"
+	ExternalIngestionClientCAFileName = "client-ca.pem"
+
+	TenantIDAttribute = "tenant.id"
+)
+
+const (
+	// ServiceAccountTokenDir is the directory where the projected service account tokens of the outputs with service account token authentication are mounted.
+	// The kubelet rotates the tokens before they expire, and the bearer token extension reloads them from the files.
+	ServiceAccountTokenDir = "/var/run/secrets/telemetry/serviceaccount"
+	// ServiceAccountTokenExpirationSeconds is the requested lifetime of the projected service account tokens
+	ServiceAccountTokenExpirationSeconds int64 = 3600
+)
+
 const (
 	AttributeActionInsert = "insert"
 	AttributeActionDelete = "delete"
@@ -15,49 +37,59 @@ const (
 type InputSourceType string
 
 const (
-	InputSourceRuntime    InputSourceType = "runtime"
-	InputSourcePrometheus InputSourceType = "prometheus"
-	InputSourceIstio      InputSourceType = "istio"
-	InputSourceOTLP       InputSourceType = "otlp"
-	InputSourceKyma       InputSourceType = "kyma"
-	InputSourceK8sCluster InputSourceType = "k8s_cluster"
+	InputSourceRuntime      InputSourceType = "runtime"
+	InputSourcePrometheus   InputSourceType = "prometheus"
+	InputSourceIstio        InputSourceType = "istio"
+	InputSourceOTLP         InputSourceType = "otlp"
+	InputSourceKyma         InputSourceType = "kyma"
+	InputSourceK8sCluster   InputSourceType = "k8s_cluster"
+	InputSourceControlPlane InputSourceType = "control-plane"
 )
 
 const (
-	InstrumentationScopeRuntime    = "io.kyma-project.telemetry/runtime"
-	InstrumentationScopePrometheus = "io.kyma-project.telemetry/prometheus"
-	InstrumentationScopeIstio      = "io.kyma-project.telemetry/istio"
-	InstrumentationScopeKyma       = "io.kyma-project.telemetry/kyma"
+	InstrumentationScopeRuntime      = "io.kyma-project.telemetry/runtime"
+	InstrumentationScopePrometheus   = "io.kyma-project.telemetry/prometheus"
+	InstrumentationScopeIstio        = "io.kyma-project.telemetry/istio"
+	InstrumentationScopeKyma         = "io.kyma-project.telemetry/kyma"
+	InstrumentationScopeControlPlane = "io.kyma-project.telemetry/control-plane"
+
+	// InstrumentationScopeHeartbeat tags the synthetic heartbeat telemetry that the manager sends into the OTLP Gateway
+	InstrumentationScopeHeartbeat = "io.kyma-project.telemetry/heartbeat"
 )
 
 var InstrumentationScope = map[InputSourceType]string{
-	InputSourceRuntime:    InstrumentationScopeRuntime,
-	InputSourcePrometheus: InstrumentationScopePrometheus,
-	InputSourceIstio:      InstrumentationScopeIstio,
-	InputSourceKyma:       InstrumentationScopeKyma,
-	InputSourceK8sCluster: InstrumentationScopeRuntime,
+	InputSourceRuntime:      InstrumentationScopeRuntime,
+	InputSourcePrometheus:   InstrumentationScopePrometheus,
+	InputSourceIstio:        InstrumentationScopeIstio,
+	InputSourceKyma:         InstrumentationScopeKyma,
+	InputSourceK8sCluster:   InstrumentationScopeRuntime,
+	InputSourceControlPlane: InstrumentationScopeControlPlane,
 }
 
 var InputName = map[InputSourceType]ComponentID{
-	InputSourceRuntime:    ComponentIDSetKymaInputNameRuntimeProcessor,
-	InputSourcePrometheus: ComponentIDSetKymaInputNamePrometheusProcessor,
-	InputSourceIstio:      ComponentIDSetKymaInputNameIstioProcessor,
-	InputSourceKyma:       ComponentIDSetKymaInputNameKymaProcessor,
-	InputSourceOTLP:       ComponentIDSetKymaInputNameOTLPProcessor,
+	InputSourceRuntime:      ComponentIDSetKymaInputNameRuntimeProcessor,
+	InputSourcePrometheus:   ComponentIDSetKymaInputNamePrometheusProcessor,
+	InputSourceIstio:        ComponentIDSetKymaInputNameIstioProcessor,
+	InputSourceKyma:         ComponentIDSetKymaInputNameKymaProcessor,
+	InputSourceOTLP:         ComponentIDSetKymaInputNameOTLPProcessor,
+	InputSourceControlPlane: ComponentIDSetKymaInputNameControlPlaneProcessor,
 }
 
 var upstreamInstrumentationScopeName = map[InputSourceType]string{
-	InputSourceRuntime:    "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver",
-	InputSourcePrometheus: "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver",
-	InputSourceIstio:      "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver",
-	InputSourceKyma:       "github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver",
-	InputSourceK8sCluster: "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver",
+	InputSourceRuntime:      "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver",
+	InputSourcePrometheus:   "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver",
+	InputSourceIstio:        "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver",
+	InputSourceKyma:         "github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver",
+	InputSourceK8sCluster:   "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver",
+	InputSourceControlPlane: "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver",
 }
 
 const (
-	SkipEnrichmentAttribute = "io.kyma-project.telemetry.skip_enrichment"
-	KymaInputNameAttribute  = "kyma.input.name"
-	KymaInputPrometheus     = "prometheus"
+	SkipEnrichmentAttribute       = "io.kyma-project.telemetry.skip_enrichment"
+	KymaInputNameAttribute        = "kyma.input.name"
+	KymaInputScrapeGroupAttribute = "kyma.input.scrape_group"
+	KymaInputPrometheus           = "prometheus"
+	KymaOutputRouteAttribute      = "kyma.output.route"
 )
 
 const (
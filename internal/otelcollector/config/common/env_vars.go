@@ -3,7 +3,9 @@ package common
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"path"
@@ -16,9 +18,13 @@ import (
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 )
 
+// envVarHashLength is the number of hex characters of the name hash that keeps the environment variable keys of TelemetryRoutes apart
+const envVarHashLength = 10
+
 const (
 	basicAuthHeaderVariablePrefix    = "BASIC_AUTH_HEADER"
 	otlpEndpointVariablePrefix       = "OTLP_ENDPOINT"
+	proxyURLVariablePrefix           = "PROXY_URL"
 	tlsConfigCertVariablePrefix      = "OTLP_TLS_CERT_PEM"
 	tlsConfigKeyVariablePrefix       = "OTLP_TLS_KEY_PEM"
 	tlsConfigCaVariablePrefix        = "OTLP_TLS_CA_PEM"
@@ -51,6 +57,11 @@ func makeOTLPExporterEnvVars(ctx context.Context, c client.Reader, output *telem
 		return nil, err
 	}
 
+	err = makeProxyURLEnvVar(ctx, c, secretData, output, pipelineRef)
+	if err != nil {
+		return nil, err
+	}
+
 	err = makeTLSEnvVar(ctx, c, secretData, output, pipelineRef)
 	if err != nil {
 		return nil, err
@@ -130,6 +141,59 @@ func makeHeaderEnvVar(ctx context.Context, c client.Reader, secretData map[strin
 	return nil
 }
 
+// makeProxyURLEnvVar resolves the proxy URL of the output. The credentials of the proxy authentication are embedded in the URL,
+// since the HTTP client of the exporter derives the Proxy-Authorization header from the user info of the proxy URL.
+func makeProxyURLEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, output *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef) error {
+	proxyURL, err := ResolveProxyURL(ctx, c, output)
+	if err != nil {
+		return err
+	}
+
+	if proxyURL == nil {
+		return nil
+	}
+
+	proxyURLVariable := formatEnvVarKey(proxyURLVariablePrefix, pipelineRef)
+	secretData[proxyURLVariable] = proxyURL
+
+	return nil
+}
+
+// ResolveProxyURL returns the proxy URL of the given output, including the basic authentication credentials if configured.
+// It returns nil if no proxy is configured for the output.
+func ResolveProxyURL(ctx context.Context, c client.Reader, output *telemetryv1beta1.OTLPOutput) ([]byte, error) {
+	if !isProxyEnabled(output) {
+		return nil, nil
+	}
+
+	proxyURL, err := sharedtypesutils.ResolveValue(ctx, c, *output.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if isProxyBasicAuthEnabled(output.ProxyAuthentication) {
+		username, err := sharedtypesutils.ResolveValue(ctx, c, output.ProxyAuthentication.Basic.User)
+		if err != nil {
+			return nil, err
+		}
+
+		password, err := sharedtypesutils.ResolveValue(ctx, c, output.ProxyAuthentication.Basic.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		u, err := url.Parse(string(proxyURL))
+		if err != nil {
+			return nil, err
+		}
+
+		u.User = url.UserPassword(string(username), string(password))
+		proxyURL = []byte(u.String())
+	}
+
+	return proxyURL, nil
+}
+
 func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, output *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef) error {
 	if output.TLS != nil {
 		if sharedtypesutils.IsValid(output.TLS.CA) {
@@ -256,20 +320,35 @@ func formatBasicAuthHeader(username string, password string) string {
 // Example: Type="trace" → "PREFIX_TRACEPIPELINE_PIPELINENAME"
 func formatEnvVarKey(prefix string, pipelineRef pipelines.PipelineRef) string {
 	if tp := pipelineRef.TypePrefix(); tp != "" {
-		return fmt.Sprintf("%s_%s_%s", prefix, sanitizeEnvVarName(tp), sanitizeEnvVarName(pipelineRef.Name()))
+		return fmt.Sprintf("%s_%s_%s", prefix, sanitizeEnvVarName(tp), envVarPipelineName(pipelineRef))
 	}
 
-	return fmt.Sprintf("%s_%s", prefix, sanitizeEnvVarName(pipelineRef.Name()))
+	return fmt.Sprintf("%s_%s", prefix, envVarPipelineName(pipelineRef))
 }
 
 // formatHeaderEnvVarKey builds an environment variable key for a custom header.
 // Example: signalType="trace" → "HEADER_TRACEPIPELINE_PIPELINENAME_HEADERNAME"
 func formatHeaderEnvVarKey(header telemetryv1beta1.Header, pipelineRef pipelines.PipelineRef) string {
 	if tp := pipelineRef.TypePrefix(); tp != "" {
-		return fmt.Sprintf("HEADER_%s_%s_%s", sanitizeEnvVarName(tp), sanitizeEnvVarName(pipelineRef.Name()), sanitizeEnvVarName(header.Name))
+		return fmt.Sprintf("HEADER_%s_%s_%s", sanitizeEnvVarName(tp), envVarPipelineName(pipelineRef), sanitizeEnvVarName(header.Name))
 	}
 
-	return fmt.Sprintf("HEADER_%s_%s", sanitizeEnvVarName(pipelineRef.Name()), sanitizeEnvVarName(header.Name))
+	return fmt.Sprintf("HEADER_%s_%s", envVarPipelineName(pipelineRef), sanitizeEnvVarName(header.Name))
+}
+
+// envVarPipelineName returns the pipeline name part of an environment variable key.
+// The name of a TelemetryRoute combines the namespace and the name of the route, and sanitizing maps both the dot and the hyphen to an underscore,
+// so that different routes could end up with the same key (for example, "team-a.api" and "team.a-api"). Therefore, a hash of the unsanitized name is appended for routes.
+// Example: name="team-a.my-route" → "TEAM_A_MY_ROUTE_<HASH>"
+func envVarPipelineName(pipelineRef pipelines.PipelineRef) string {
+	name := sanitizeEnvVarName(pipelineRef.Name())
+	if !pipelineRef.IsTelemetryRoute() {
+		return name
+	}
+
+	hash := sha256.Sum256([]byte(pipelineRef.Name()))
+
+	return name + "_" + strings.ToUpper(hex.EncodeToString(hash[:])[:envVarHashLength])
 }
 
 func sanitizeEnvVarName(input string) string {
@@ -115,12 +115,22 @@ func otlpExporter(otlpOutput *telemetryv1beta1.OTLPOutput, pipelineRef pipelines
 		exporter.LogsEndpoint = fmt.Sprintf("${%s}", otlpEndpointVariable)
 	}
 
+	if isProxyEnabled(otlpOutput) {
+		exporter.ProxyURL = fmt.Sprintf("${%s}", formatEnvVarKey(proxyURLVariablePrefix, pipelineRef))
+	}
+
 	if otlpOutput.Authentication != nil && otlpOutput.Authentication.OAuth2 != nil {
 		exporter.Auth = Auth{
 			Authenticator: ComponentIDOAuth2Extension(pipelineRef),
 		}
 	}
 
+	if IsServiceAccountTokenAuthEnabled(otlpOutput.Authentication) {
+		exporter.Auth = Auth{
+			Authenticator: ComponentIDServiceAccountTokenAuthExtension(pipelineRef),
+		}
+	}
+
 	return &exporter
 }
 
@@ -178,3 +188,15 @@ func isBasicAuthEnabled(authOptions *telemetryv1beta1.AuthenticationOptions) boo
 		sharedtypesutils.IsValid(&authOptions.Basic.User) &&
 		sharedtypesutils.IsValid(&authOptions.Basic.Password)
 }
+
+// isProxyEnabled returns true if the output sends the data through a proxy. Only the OTLP HTTP exporter supports proxies.
+func isProxyEnabled(output *telemetryv1beta1.OTLPOutput) bool {
+	return output.Protocol == telemetryv1beta1.OTLPProtocolHTTP && sharedtypesutils.IsValid(output.ProxyURL)
+}
+
+func isProxyBasicAuthEnabled(authOptions *telemetryv1beta1.ProxyAuthenticationOptions) bool {
+	return authOptions != nil &&
+		authOptions.Basic != nil &&
+		sharedtypesutils.IsValid(&authOptions.Basic.User) &&
+		sharedtypesutils.IsValid(&authOptions.Basic.Password)
+}
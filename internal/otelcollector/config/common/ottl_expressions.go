@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"math"
 	"strings"
 )
 
@@ -88,6 +89,16 @@ func HasAttrOnDatapoint(key, value string) string {
 	return fmt.Sprintf("HasAttrOnDatapoint(\"%s\", \"%s\")", key, value)
 }
 
+// ScopeNameEquals returns an OTel expression that checks if the instrumentation scope has the specified name
+func ScopeNameEquals(name string) string {
+	return fmt.Sprintf("scope.name == \"%s\"", name)
+}
+
+// ScopeNameNotEquals returns an OTel expression that checks if the instrumentation scope does not have the specified name
+func ScopeNameNotEquals(name string) string {
+	return fmt.Sprintf("scope.name != \"%s\"", name)
+}
+
 func KymaInputNameEquals(sourceType InputSourceType) string {
 	return ResourceAttributeEquals(KymaInputNameAttribute, string(sourceType))
 }
@@ -105,6 +116,18 @@ func DeleteResourceAttribute(key string) string {
 	return fmt.Sprintf("delete_key(resource.attributes, \"%s\")", key)
 }
 
+// NotSampled returns an OTel expression that is true for the records outside of the given sampling ratio, so that a filter processor
+// with the expression keeps the given ratio of the records. The FNV hash of the key is uniformly distributed over the int64 range,
+// so a record is sampled if its hash is below the threshold that splits the range at the ratio. Records with the same key are sampled alike.
+func NotSampled(key string, ratio float64) string {
+	// The offset of the threshold from the minimum hash is ratio * 2^64. Adding it to the minimum hash as an unsigned integer wraps around
+	// into the signed range as intended.
+	offset := uint64(ratio * math.Exp2(64))
+	threshold := int64(uint64(1<<63) + offset) //nolint:gosec // wrapping into the signed range is intended
+
+	return fmt.Sprintf("FNV(%s) >= %d", key, threshold)
+}
+
 func isWrappedInParentheses(expression string) bool {
 	return strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")")
 }
@@ -42,6 +42,16 @@ func TestOttlExprFunctions(t *testing.T) {
 			actual:   ResourceAttributeHasPrefix("key", "prefix"),
 			expected: `HasPrefix(resource.attributes["key"], "prefix")`,
 		},
+		{
+			name:     "ScopeNameEquals",
+			actual:   ScopeNameEquals("my.scope"),
+			expected: `scope.name == "my.scope"`,
+		},
+		{
+			name:     "ScopeNameNotEquals",
+			actual:   ScopeNameNotEquals("my.scope"),
+			expected: `scope.name != "my.scope"`,
+		},
 		{
 			name:     "ResourceAttribute",
 			actual:   ResourceAttribute("my.key"),
@@ -127,6 +137,16 @@ func TestOttlExprFunctions(t *testing.T) {
 			actual:   DeleteResourceAttribute("key"),
 			expected: `delete_key(resource.attributes, "key")`,
 		},
+		{
+			name:     "NotSampled",
+			actual:   NotSampled("span.trace_id.string", 0.25),
+			expected: `FNV(span.trace_id.string) >= -4611686018427387904`,
+		},
+		{
+			name:     "NotSampledNothing",
+			actual:   NotSampled("span.trace_id.string", 0),
+			expected: `FNV(span.trace_id.string) >= -9223372036854775808`,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"fmt"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+// =============================================================================
+// OUTPUT ROUTES PROCESSOR BUILDERS
+// =============================================================================
+
+// The records of a pipeline with output routes are marked with the name of the first route they match. The mark is a record attribute,
+// so that a routing connector can distribute the records to the outputs, and it is removed again before the records are exported.
+// Marking the records with a transform processor keeps the user-defined conditions in the transform processor, which infers their OTTL context.
+
+// LogOutputRoutesProcessor creates a TransformProcessorConfig that marks each log record with the name of the first route it matches
+func LogOutputRoutesProcessor(routes []telemetryv1beta1.OutputRoute) *TransformProcessorConfig {
+	return LogTransformProcessor(outputRoutesStatements("log.attributes", routes))
+}
+
+// MetricOutputRoutesProcessor creates a TransformProcessorConfig that marks each metric data point with the name of the first route it matches
+func MetricOutputRoutesProcessor(routes []telemetryv1beta1.OutputRoute) *TransformProcessorConfig {
+	return MetricTransformProcessor(outputRoutesStatements("datapoint.attributes", routes))
+}
+
+// TraceOutputRoutesProcessor creates a TransformProcessorConfig that marks each span with the name of the first route it matches
+func TraceOutputRoutesProcessor(routes []telemetryv1beta1.OutputRoute) *TransformProcessorConfig {
+	return TraceTransformProcessor(outputRoutesStatements("span.attributes", routes))
+}
+
+// DropOutputRouteAttributeProcessor creates a TransformProcessorConfig that removes the route mark from the records of all signal types.
+// It is shared by all outputs, because the static component ID is registered by each signal type.
+func DropOutputRouteAttributeProcessor() *TransformProcessorConfig {
+	dropStatements := func(attributesPath string) []TransformProcessorStatements {
+		return []TransformProcessorStatements{{
+			Statements: []string{fmt.Sprintf("delete_key(%s, \"%s\")", attributesPath, KymaOutputRouteAttribute)},
+		}}
+	}
+
+	return &TransformProcessorConfig{
+		ErrorMode:        defaultTransformProcessorErrorMode,
+		LogStatements:    dropStatements("log.attributes"),
+		MetricStatements: dropStatements("datapoint.attributes"),
+		TraceStatements:  dropStatements("span.attributes"),
+	}
+}
+
+// OutputRouteAttributeEquals returns an OTTL condition that checks if a record is marked with the given route.
+// The attribute path has no context, so the condition is evaluated in the context of the routed records.
+func OutputRouteAttributeEquals(routeName string) string {
+	return fmt.Sprintf("%s == %s", Attribute(KymaOutputRouteAttribute), ottlStringLiteral(routeName))
+}
+
+// outputRoutesStatements returns one statement group per route. The global conditions of a group are the conditions of the route,
+// and a record is only marked if no previous route has marked it, so that the first matching route wins.
+// A mark that already exists on the incoming data is removed first, so that it cannot bypass the conditions.
+func outputRoutesStatements(attributesPath string, routes []telemetryv1beta1.OutputRoute) []TransformProcessorStatements {
+	markAttribute := fmt.Sprintf("%s[\"%s\"]", attributesPath, KymaOutputRouteAttribute)
+
+	groups := make([]TransformProcessorStatements, 0, len(routes)+1)
+	groups = append(groups, TransformProcessorStatements{
+		Statements: []string{fmt.Sprintf("delete_key(%s, \"%s\")", attributesPath, KymaOutputRouteAttribute)},
+	})
+
+	for _, route := range routes {
+		groups = append(groups, TransformProcessorStatements{
+			Conditions: route.Conditions,
+			Statements: []string{JoinWithWhere(fmt.Sprintf("set(%s, %s)", markAttribute, ottlStringLiteral(route.Name)), IsNil(markAttribute))},
+		})
+	}
+
+	return groups
+}
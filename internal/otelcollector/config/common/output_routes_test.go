@@ -0,0 +1,77 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+)
+
+func TestOutputRoutesProcessors(t *testing.T) {
+	routes := []telemetryv1beta1.OutputRoute{
+		{Name: "errors", Conditions: []string{`log.severity_number >= SEVERITY_NUMBER_ERROR`}},
+		{Name: "audit", Conditions: []string{`resource.attributes["k8s.namespace.name"] == "audit"`, `log.body != nil`}},
+	}
+
+	require.Equal(t, &TransformProcessorConfig{
+		ErrorMode: "ignore",
+		LogStatements: []TransformProcessorStatements{
+			{
+				Statements: []string{`delete_key(log.attributes, "kyma.output.route")`},
+			},
+			{
+				Conditions: []string{`log.severity_number >= SEVERITY_NUMBER_ERROR`},
+				Statements: []string{`set(log.attributes["kyma.output.route"], "errors") where log.attributes["kyma.output.route"] == nil`},
+			},
+			{
+				Conditions: []string{`resource.attributes["k8s.namespace.name"] == "audit"`, `log.body != nil`},
+				Statements: []string{`set(log.attributes["kyma.output.route"], "audit") where log.attributes["kyma.output.route"] == nil`},
+			},
+		},
+	}, LogOutputRoutesProcessor(routes))
+
+	require.Equal(t, `attributes["kyma.output.route"] == "errors"`, OutputRouteAttributeEquals("errors"))
+}
+
+func TestOutputRoutesProcessorsAreValidOTTL(t *testing.T) {
+	tests := []struct {
+		signalType pipelines.SignalType
+		statements []TransformProcessorStatements
+	}{
+		{
+			signalType: pipelines.SignalTypeLog,
+			statements: append(
+				LogOutputRoutesProcessor([]telemetryv1beta1.OutputRoute{{Name: "errors", Conditions: []string{`log.severity_number >= SEVERITY_NUMBER_ERROR`}}}).LogStatements,
+				DropOutputRouteAttributeProcessor().LogStatements...,
+			),
+		},
+		{
+			signalType: pipelines.SignalTypeMetric,
+			statements: append(
+				MetricOutputRoutesProcessor([]telemetryv1beta1.OutputRoute{{Name: "cpu", Conditions: []string{`metric.name == "cpu.usage"`}}}).MetricStatements,
+				DropOutputRouteAttributeProcessor().MetricStatements...,
+			),
+		},
+		{
+			signalType: pipelines.SignalTypeTrace,
+			statements: append(
+				TraceOutputRoutesProcessor([]telemetryv1beta1.OutputRoute{{Name: "slow", Conditions: []string{`span.end_time_unix_nano - span.start_time_unix_nano > 1000000000`}}}).TraceStatements,
+				DropOutputRouteAttributeProcessor().TraceStatements...,
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.signalType), func(t *testing.T) {
+			validator, err := ottl.NewTransformSpecValidator(tt.signalType)
+			require.NoError(t, err)
+
+			for _, group := range tt.statements {
+				require.NoError(t, validator.ValidateStatementsAndConditions(group.Statements, group.Conditions))
+			}
+		})
+	}
+}
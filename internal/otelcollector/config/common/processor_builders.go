@@ -151,6 +151,28 @@ func ResolveServiceName() *ServiceEnrichmentProcessorConfig {
 	}
 }
 
+// IntervalProcessor creates an interval processor configuration from the aggregation of a MetricPipeline.
+// Returns nil if no aggregation interval is defined.
+func IntervalProcessor(aggregation *telemetryv1beta1.MetricPipelineAggregation) *IntervalProcessorConfig {
+	if aggregation == nil || aggregation.Interval == nil {
+		return nil
+	}
+
+	return &IntervalProcessorConfig{
+		Interval: aggregation.Interval.Duration,
+	}
+}
+
+// AggregationGroupByAttrsProcessor creates a groupbyattrs processor configuration without keys, which compacts resources with identical attributes.
+// Returns nil if the aggregation of a MetricPipeline does not drop any attributes.
+func AggregationGroupByAttrsProcessor(aggregation *telemetryv1beta1.MetricPipelineAggregation) *GroupByAttrsProcessorConfig {
+	if aggregation == nil || len(aggregation.DropAttributes) == 0 {
+		return nil
+	}
+
+	return &GroupByAttrsProcessorConfig{}
+}
+
 // =============================================================================
 // FILTER PROCESSOR BUILDERS
 // =============================================================================
@@ -256,6 +278,61 @@ func InsertClusterAttributesProcessorStatements(cluster ClusterOptions) []Transf
 	}}
 }
 
+// InsertTenantAttributeProcessorStatements creates processor statements for the transform processor that sets the tenant identity
+// of externally ingested data. The attribute is always overwritten so that senders cannot impersonate another tenant.
+func InsertTenantAttributeProcessorStatements(tenant string) []TransformProcessorStatements {
+	return []TransformProcessorStatements{{
+		Statements: []string{
+			fmt.Sprintf("set(%s, \"%s\")", ResourceAttribute(TenantIDAttribute), tenant),
+		},
+	}}
+}
+
+// AggregationDropAttributesProcessor creates a transform processor configuration that removes the attributes listed in the aggregation of a MetricPipeline
+// from the resources and data points. Returns nil if the aggregation does not drop any attributes.
+func AggregationDropAttributesProcessor(aggregation *telemetryv1beta1.MetricPipelineAggregation) *TransformProcessorConfig {
+	if aggregation == nil || len(aggregation.DropAttributes) == 0 {
+		return nil
+	}
+
+	// The attribute names are user-provided, so they are quoted as OTTL string literals to not break out of the statements
+	var statements []string
+	for _, attribute := range aggregation.DropAttributes {
+		statements = append(statements,
+			fmt.Sprintf("delete_key(resource.attributes, %s)", ottlStringLiteral(attribute)),
+			fmt.Sprintf("delete_key(datapoint.attributes, %s)", ottlStringLiteral(attribute)),
+		)
+	}
+
+	return MetricTransformProcessor([]TransformProcessorStatements{{
+		Statements: statements,
+	}})
+}
+
+// AggregationMergeSeriesProcessor creates a transform processor configuration that merges the data points of a metric with identical attributes
+// by summing up their values. Summaries cannot be merged and are skipped. Returns nil if the aggregation of a MetricPipeline does not drop any attributes.
+func AggregationMergeSeriesProcessor(aggregation *telemetryv1beta1.MetricPipelineAggregation) *TransformProcessorConfig {
+	if aggregation == nil || len(aggregation.DropAttributes) == 0 {
+		return nil
+	}
+
+	return MetricTransformProcessor([]TransformProcessorStatements{{
+		Statements: []string{
+			JoinWithWhere("aggregate_on_attributes(\"sum\")", "metric.type != METRIC_DATA_TYPE_SUMMARY"),
+		},
+	}})
+}
+
+// DropExemplarsProcessorStatements creates processor statements for the transform processor that removes the exemplars from all data points.
+// Summaries do not have exemplars and are skipped.
+func DropExemplarsProcessorStatements() []TransformProcessorStatements {
+	return []TransformProcessorStatements{{
+		Statements: []string{
+			JoinWithWhere("set(datapoint.exemplars, nil)", "metric.type != METRIC_DATA_TYPE_SUMMARY"),
+		},
+	}}
+}
+
 // DropKymaAttributesProcessorStatements creates processor statements for the transform processor that drops Kyma attributes
 func DropKymaAttributesProcessorStatements() []TransformProcessorStatements {
 	return []TransformProcessorStatements{{
@@ -265,6 +342,19 @@ func DropKymaAttributesProcessorStatements() []TransformProcessorStatements {
 	}}
 }
 
+// DropSenderPodIdentityProcessorStatements creates processor statements for the transform processor that drops the attributes a sender can set to claim
+// the identity of a pod and its namespace. The k8s_attributes processor never overwrites existing attributes, so that only without them, it resolves the pod
+// and the namespace from the connection of the sender.
+func DropSenderPodIdentityProcessorStatements() []TransformProcessorStatements {
+	return []TransformProcessorStatements{{
+		Statements: []string{
+			DeleteResourceAttribute(K8sNamespaceName),
+			DeleteResourceAttribute("k8s.pod.ip"),
+			DeleteResourceAttribute("k8s.pod.uid"),
+		},
+	}}
+}
+
 // DropUnknownServiceNameProcessorStatements creates processor statements for the transform processor that drops unknown service names
 func DropUnknownServiceNameProcessorStatements() []TransformProcessorStatements {
 	return []TransformProcessorStatements{{
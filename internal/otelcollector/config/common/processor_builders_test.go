@@ -3,8 +3,10 @@ package common
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -67,6 +69,22 @@ func TestDropKymaAttributesProcessorStatements(t *testing.T) {
 	require.ElementsMatch(expectedProcessorStatements, processorStatements, "Attributes should match")
 }
 
+func TestDropSenderPodIdentityProcessorStatements(t *testing.T) {
+	require := require.New(t)
+
+	expectedProcessorStatements := []TransformProcessorStatements{{
+		Statements: []string{
+			"delete_key(resource.attributes, \"k8s.namespace.name\")",
+			"delete_key(resource.attributes, \"k8s.pod.ip\")",
+			"delete_key(resource.attributes, \"k8s.pod.uid\")",
+		},
+	}}
+
+	processorStatements := DropSenderPodIdentityProcessorStatements()
+
+	require.ElementsMatch(expectedProcessorStatements, processorStatements, "Attributes should match")
+}
+
 func TestDropUnknownServiceNameProcessorStatements(t *testing.T) {
 	require := require.New(t)
 
@@ -507,6 +525,67 @@ func TestResolveServiceNameConfig(t *testing.T) {
 	require.Equal([]string{"kyma.kubernetes_io_app_name", "kyma.app_name"}, config.ResourceAttributes)
 }
 
+func TestAggregationProcessors(t *testing.T) {
+	t.Run("no aggregation", func(t *testing.T) {
+		require.Nil(t, IntervalProcessor(nil))
+		require.Nil(t, AggregationDropAttributesProcessor(nil))
+		require.Nil(t, AggregationGroupByAttrsProcessor(nil))
+		require.Nil(t, AggregationMergeSeriesProcessor(nil))
+	})
+
+	t.Run("interval only", func(t *testing.T) {
+		aggregation := &telemetryv1beta1.MetricPipelineAggregation{
+			Interval: &metav1.Duration{Duration: time.Minute},
+		}
+
+		require.Equal(t, &IntervalProcessorConfig{Interval: time.Minute}, IntervalProcessor(aggregation))
+		require.Nil(t, AggregationDropAttributesProcessor(aggregation))
+		require.Nil(t, AggregationGroupByAttrsProcessor(aggregation))
+		require.Nil(t, AggregationMergeSeriesProcessor(aggregation))
+	})
+
+	t.Run("drop attributes only", func(t *testing.T) {
+		aggregation := &telemetryv1beta1.MetricPipelineAggregation{
+			DropAttributes: []string{"k8s.container.name", "container.id"},
+		}
+
+		require.Nil(t, IntervalProcessor(aggregation))
+		require.Equal(t, &TransformProcessorConfig{
+			ErrorMode: "ignore",
+			MetricStatements: []TransformProcessorStatements{{
+				Statements: []string{
+					"delete_key(resource.attributes, \"k8s.container.name\")",
+					"delete_key(datapoint.attributes, \"k8s.container.name\")",
+					"delete_key(resource.attributes, \"container.id\")",
+					"delete_key(datapoint.attributes, \"container.id\")",
+				},
+			}},
+		}, AggregationDropAttributesProcessor(aggregation))
+		require.Equal(t, &GroupByAttrsProcessorConfig{}, AggregationGroupByAttrsProcessor(aggregation))
+		require.Equal(t, &TransformProcessorConfig{
+			ErrorMode: "ignore",
+			MetricStatements: []TransformProcessorStatements{{
+				Statements: []string{
+					"aggregate_on_attributes(\"sum\") where metric.type != METRIC_DATA_TYPE_SUMMARY",
+				},
+			}},
+		}, AggregationMergeSeriesProcessor(aggregation))
+	})
+
+	t.Run("drop attributes with special characters", func(t *testing.T) {
+		aggregation := &telemetryv1beta1.MetricPipelineAggregation{
+			DropAttributes: []string{`x") where true\n- set(resource.attributes["a"], "b`, "${env:SECRET}"},
+		}
+
+		require.Equal(t, []string{
+			`delete_key(resource.attributes, "x\") where true\\n- set(resource.attributes[\"a\"], \"b")`,
+			`delete_key(datapoint.attributes, "x\") where true\\n- set(resource.attributes[\"a\"], \"b")`,
+			`delete_key(resource.attributes, "$${env:SECRET}")`,
+			`delete_key(datapoint.attributes, "$${env:SECRET}")`,
+		}, AggregationDropAttributesProcessor(aggregation).MetricStatements[0].Statements)
+	})
+}
+
 func TestLogFilterProcessor(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+)
+
+// =============================================================================
+// PROMETHEUS EXPORTER CONFIG BUILDER
+// =============================================================================
+
+// defaultPrometheusMetricExpiration is the time after which a metric without new data points is no longer exposed, if not configured otherwise.
+const defaultPrometheusMetricExpiration = 5 * time.Minute
+
+// PrometheusExporter creates a Prometheus exporter configuration that exposes the metrics of a MetricPipeline on the Pod IP.
+// Exemplars are only part of the OpenMetrics exposition format, so it is enabled unless the exemplars are dropped.
+// Returns nil if the MetricPipeline has no prometheus output.
+func PrometheusExporter(output *telemetryv1beta1.MetricPipelinePrometheusOutput) *PrometheusExporterConfig {
+	if output == nil {
+		return nil
+	}
+
+	config := &PrometheusExporterConfig{
+		Endpoint: fmt.Sprintf("${%s}:%d", EnvVarCurrentPodIP, ports.PrometheusExporter),
+		ResourceToTelemetryConversion: ResourceToTelemetryConversion{
+			Enabled: true,
+		},
+		MetricExpiration:  defaultPrometheusMetricExpiration,
+		EnableOpenMetrics: output.Exemplars == nil || *output.Exemplars != telemetryv1beta1.ExemplarsDrop,
+	}
+
+	if output.ResourceToLabels != nil && output.ResourceToLabels.Enabled != nil {
+		config.ResourceToTelemetryConversion.Enabled = *output.ResourceToLabels.Enabled
+	}
+
+	if output.MetricExpiration != nil {
+		config.MetricExpiration = output.MetricExpiration.Duration
+	}
+
+	return config
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+// =============================================================================
+// REDACTION PROCESSOR BUILDERS
+// =============================================================================
+
+// RedactionMask replaces the parts of a value that match a redaction pattern
+const RedactionMask = "***"
+
+// redactionPresetPatterns maps the redaction presets to RE2 patterns, which are evaluated by the OTTL replace functions
+var redactionPresetPatterns = map[telemetryv1beta1.RedactionPreset]string{
+	telemetryv1beta1.RedactionPresetEmail: `[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`,
+	// Two-letter country code and two check digits, followed by up to 30 alphanumeric characters, optionally grouped by four
+	telemetryv1beta1.RedactionPresetIBAN: `\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`,
+	// 16-digit Visa, Mastercard and Discover numbers, and 15-digit American Express numbers, optionally grouped by spaces or dashes
+	telemetryv1beta1.RedactionPresetCreditCard: `\b(?:(?:4[0-9]{3}|5[1-5][0-9]{2}|2[2-7][0-9]{2}|6(?:011|5[0-9]{2}))(?:[ -]?[0-9]{4}){3}|3[47][0-9]{2}[ -]?[0-9]{6}[ -]?[0-9]{5})\b`,
+	// Header and payload of a JWT are base64url-encoded JSON objects, so both start with "eyJ"
+	telemetryv1beta1.RedactionPresetJWT: `eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+	// Tokens shorter than 8 characters are ignored, to not mask the word "bearer" in prose
+	telemetryv1beta1.RedactionPresetBearerToken: `(?i)bearer\s+[A-Za-z0-9._~+/-]{8,}=*`,
+}
+
+// RedactionPresetPattern returns the RE2 pattern of the given redaction preset
+func RedactionPresetPattern(preset telemetryv1beta1.RedactionPreset) (string, bool) {
+	pattern, found := redactionPresetPatterns[preset]
+	return pattern, found
+}
+
+// LogRedactionProcessor creates a TransformProcessorConfig that masks sensitive data in logs. Returns nil if nothing is to be redacted.
+func LogRedactionProcessor(redaction *telemetryv1beta1.Redaction) *TransformProcessorConfig {
+	if !isRedactionDefined(redaction) {
+		return nil
+	}
+
+	patterns := redactionPatterns(redaction)
+
+	bodyStatements := make([]string, 0, 2*len(patterns))
+	for _, pattern := range patterns {
+		bodyStatements = append(bodyStatements,
+			JoinWithWhere(fmt.Sprintf("replace_pattern(log.body, %s, \"%s\")", pattern, RedactionMask), "IsString(log.body)"),
+			JoinWithWhere(fmt.Sprintf("replace_all_patterns(log.body, \"value\", %s, \"%s\")", pattern, RedactionMask), "IsMap(log.body)"),
+		)
+	}
+
+	return LogTransformProcessor(nonEmptyStatementGroups(
+		redactResourceAttributesStatements(redaction, patterns),
+		append(redactRecordAttributesStatements("log.attributes", redaction, patterns), bodyStatements...),
+	))
+}
+
+// MetricRedactionProcessor creates a TransformProcessorConfig that masks sensitive data in metrics. Returns nil if nothing is to be redacted.
+func MetricRedactionProcessor(redaction *telemetryv1beta1.Redaction) *TransformProcessorConfig {
+	if !isRedactionDefined(redaction) {
+		return nil
+	}
+
+	patterns := redactionPatterns(redaction)
+
+	return MetricTransformProcessor(nonEmptyStatementGroups(
+		redactResourceAttributesStatements(redaction, patterns),
+		redactRecordAttributesStatements("datapoint.attributes", redaction, patterns),
+	))
+}
+
+// TraceRedactionProcessor creates a TransformProcessorConfig that masks sensitive data in traces. Returns nil if nothing is to be redacted.
+func TraceRedactionProcessor(redaction *telemetryv1beta1.Redaction) *TransformProcessorConfig {
+	if !isRedactionDefined(redaction) {
+		return nil
+	}
+
+	patterns := redactionPatterns(redaction)
+
+	// Span events are processed in a separate group, since a group that mixes span and span event paths is only executed for spans with events
+	return TraceTransformProcessor(nonEmptyStatementGroups(
+		redactResourceAttributesStatements(redaction, patterns),
+		redactRecordAttributesStatements("span.attributes", redaction, patterns),
+		redactRecordAttributesStatements("spanevent.attributes", redaction, patterns),
+	))
+}
+
+func isRedactionDefined(redaction *telemetryv1beta1.Redaction) bool {
+	return redaction != nil && (len(redaction.Presets) > 0 ||
+		len(redaction.Patterns) > 0 ||
+		len(redaction.AllowedAttributes) > 0 ||
+		len(redaction.DeniedAttributes) > 0)
+}
+
+// redactionPatterns returns the patterns of the presets and the custom patterns as OTTL string literals
+func redactionPatterns(redaction *telemetryv1beta1.Redaction) []string {
+	patterns := make([]string, 0, len(redaction.Presets)+len(redaction.Patterns))
+
+	for _, preset := range redaction.Presets {
+		if pattern, found := RedactionPresetPattern(preset); found {
+			patterns = append(patterns, ottlStringLiteral(pattern))
+		}
+	}
+
+	for _, pattern := range redaction.Patterns {
+		patterns = append(patterns, ottlStringLiteral(pattern))
+	}
+
+	return patterns
+}
+
+// redactResourceAttributesStatements returns statements that remove the denied resource attributes and mask the patterns in the remaining ones.
+// The allow list does not apply to resource attributes, since they carry the enrichment of the pipeline.
+func redactResourceAttributesStatements(redaction *telemetryv1beta1.Redaction, patterns []string) []string {
+	statements := make([]string, 0, len(redaction.DeniedAttributes)+len(patterns))
+
+	for _, key := range redaction.DeniedAttributes {
+		statements = append(statements, fmt.Sprintf("delete_key(resource.attributes, %s)", ottlStringLiteral(key)))
+	}
+
+	return append(statements, maskAttributesStatements("resource.attributes", patterns)...)
+}
+
+// redactRecordAttributesStatements returns statements that apply the allow list or the deny list to the given attributes, and mask the patterns in the remaining ones
+func redactRecordAttributesStatements(attributesPath string, redaction *telemetryv1beta1.Redaction, patterns []string) []string {
+	statements := make([]string, 0, len(redaction.DeniedAttributes)+len(patterns)+1)
+
+	if len(redaction.AllowedAttributes) > 0 {
+		keys := make([]string, 0, len(redaction.AllowedAttributes))
+		for _, key := range redaction.AllowedAttributes {
+			keys = append(keys, ottlStringLiteral(key))
+		}
+
+		statements = append(statements, fmt.Sprintf("keep_keys(%s, [%s])", attributesPath, strings.Join(keys, ", ")))
+	}
+
+	for _, key := range redaction.DeniedAttributes {
+		statements = append(statements, fmt.Sprintf("delete_key(%s, %s)", attributesPath, ottlStringLiteral(key)))
+	}
+
+	return append(statements, maskAttributesStatements(attributesPath, patterns)...)
+}
+
+func maskAttributesStatements(attributesPath string, patterns []string) []string {
+	statements := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		statements = append(statements, fmt.Sprintf("replace_all_patterns(%s, \"value\", %s, \"%s\")", attributesPath, pattern, RedactionMask))
+	}
+
+	return statements
+}
+
+func nonEmptyStatementGroups(groups ...[]string) []TransformProcessorStatements {
+	result := make([]TransformProcessorStatements, 0, len(groups))
+
+	for _, statements := range groups {
+		if len(statements) > 0 {
+			result = append(result, TransformProcessorStatements{Statements: statements})
+		}
+	}
+
+	return result
+}
+
+// ottlStringLiteral quotes a user-provided value as an OTTL string literal. Dollar signs are doubled, so that the collector
+// does not interpret them as references to environment variables when it loads the configuration.
+func ottlStringLiteral(value string) string {
+	return strings.ReplaceAll(strconv.Quote(value), "$", "$$")
+}
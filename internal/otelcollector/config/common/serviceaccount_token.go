@@ -0,0 +1,56 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"slices"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+// =============================================================================
+// SERVICE ACCOUNT TOKEN AUTHENTICATION
+// =============================================================================
+
+// The collector pods get one projected service account token per audience, which is mounted to ServiceAccountTokenDir.
+// The outputs with service account token authentication send the token of their audience with a bearer token extension,
+// so that no Secret is involved.
+
+// IsServiceAccountTokenAuthEnabled returns true if the output authenticates with a service account token
+func IsServiceAccountTokenAuthEnabled(authOptions *telemetryv1beta1.AuthenticationOptions) bool {
+	return authOptions != nil &&
+		authOptions.ServiceAccountToken != nil &&
+		authOptions.ServiceAccountToken.Audience != ""
+}
+
+// ServiceAccountTokenAuthExtensionConfig creates the bearer token extension that reads the service account token with the given audience
+func ServiceAccountTokenAuthExtensionConfig(authOptions *telemetryv1beta1.ServiceAccountTokenAuthOptions) BearerTokenAuthExtensionConfig {
+	return BearerTokenAuthExtensionConfig{
+		Filename: path.Join(ServiceAccountTokenDir, ServiceAccountTokenFileName(authOptions.Audience)),
+	}
+}
+
+// ServiceAccountTokenFileName returns the file name of the projected service account token with the given audience.
+// The audience is hashed, since it is usually a URL, which is not a valid file name.
+func ServiceAccountTokenFileName(audience string) string {
+	hash := sha256.Sum256([]byte(audience))
+	return "token-" + hex.EncodeToString(hash[:8])
+}
+
+// ServiceAccountTokenAudiences returns the sorted, unique audiences of the outputs with service account token authentication
+func ServiceAccountTokenAudiences(outputs ...*telemetryv1beta1.OTLPOutput) []string {
+	var audiences []string
+
+	for _, output := range outputs {
+		if output == nil || !IsServiceAccountTokenAuthEnabled(output.Authentication) {
+			continue
+		}
+
+		audiences = append(audiences, output.Authentication.ServiceAccountToken.Audience)
+	}
+
+	slices.Sort(audiences)
+
+	return slices.Compact(audiences)
+}
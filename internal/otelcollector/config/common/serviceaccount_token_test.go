@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+func TestServiceAccountTokenAuthExtensionConfig(t *testing.T) {
+	config := ServiceAccountTokenAuthExtensionConfig(&telemetryv1beta1.ServiceAccountTokenAuthOptions{Audience: "https://backend.example.com"})
+
+	require.Empty(t, config.Token)
+	require.Equal(t, "/var/run/secrets/telemetry/serviceaccount/"+ServiceAccountTokenFileName("https://backend.example.com"), config.Filename)
+	require.Regexp(t, `^token-[0-9a-f]{16}$`, ServiceAccountTokenFileName("https://backend.example.com"))
+	require.NotEqual(t, ServiceAccountTokenFileName("https://backend.example.com"), ServiceAccountTokenFileName("https://other.example.com"))
+}
+
+func TestServiceAccountTokenAudiences(t *testing.T) {
+	withAudience := func(audience string) *telemetryv1beta1.OTLPOutput {
+		return &telemetryv1beta1.OTLPOutput{
+			Authentication: &telemetryv1beta1.AuthenticationOptions{
+				ServiceAccountToken: &telemetryv1beta1.ServiceAccountTokenAuthOptions{Audience: audience},
+			},
+		}
+	}
+
+	basicAuth := &telemetryv1beta1.OTLPOutput{
+		Authentication: &telemetryv1beta1.AuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{},
+		},
+	}
+
+	require.Empty(t, ServiceAccountTokenAudiences())
+	require.Empty(t, ServiceAccountTokenAudiences(nil, basicAuth, &telemetryv1beta1.OTLPOutput{}))
+	require.Equal(t,
+		[]string{"audience-a", "audience-b"},
+		ServiceAccountTokenAudiences(withAudience("audience-b"), basicAuth, withAudience("audience-a"), withAudience("audience-b"), nil),
+	)
+}
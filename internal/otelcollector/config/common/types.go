@@ -52,6 +52,11 @@ type OAuth2ExtensionConfig struct {
 	Params       map[string]string `yaml:"endpoint_params,omitempty"`
 }
 
+type BearerTokenAuthExtensionConfig struct {
+	Token    string `yaml:"token,omitempty"` //nolint:gosec // G117: struct field for OTel config, not a credential
+	Filename string `yaml:"filename,omitempty"`
+}
+
 type CGroupRuntimeExtension struct {
 	GoMaxProcs CGroupRuntimeGoMaxProcs `yaml:"gomaxprocs"`
 	GoMemLimit CGroupRuntimeGoMemLimit `yaml:"gomemlimit"`
@@ -132,7 +137,38 @@ type ReceiverProtocols struct {
 }
 
 type Endpoint struct {
-	Endpoint string `yaml:"endpoint,omitempty"`
+	Endpoint string           `yaml:"endpoint,omitempty"`
+	TLS      *TLSServerConfig `yaml:"tls,omitempty"`
+	Auth     *Auth            `yaml:"auth,omitempty"`
+
+	// gRPC server settings
+	MaxRecvMsgSizeMiB int              `yaml:"max_recv_msg_size_mib,omitempty"`
+	Keepalive         *KeepaliveConfig `yaml:"keepalive,omitempty"`
+
+	// HTTP server settings
+	MaxRequestBodySize int64       `yaml:"max_request_body_size,omitempty"`
+	CORS               *CORSConfig `yaml:"cors,omitempty"`
+}
+
+type KeepaliveConfig struct {
+	ServerParameters KeepaliveServerParameters `yaml:"server_parameters"`
+}
+
+type KeepaliveServerParameters struct {
+	Time             time.Duration `yaml:"time,omitempty"`
+	Timeout          time.Duration `yaml:"timeout,omitempty"`
+	MaxConnectionAge time.Duration `yaml:"max_connection_age,omitempty"`
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+	AllowedHeaders []string `yaml:"allowed_headers,omitempty"`
+}
+
+type TLSServerConfig struct {
+	CertPem      string `yaml:"cert_pem,omitempty"`
+	KeyPem       string `yaml:"key_pem,omitempty"`
+	ClientCAFile string `yaml:"client_ca_file,omitempty"`
 }
 
 // =============================================================================
@@ -145,6 +181,7 @@ type OTLPExporterConfig struct {
 	LogsEndpoint    string            `yaml:"logs_endpoint,omitempty"`
 	Endpoint        string            `yaml:"endpoint,omitempty"`
 	Headers         map[string]string `yaml:"headers,omitempty"`
+	ProxyURL        string            `yaml:"proxy_url,omitempty"`
 	TLS             TLS               `yaml:"tls,omitempty"`
 	Compression     string            `yaml:"compression,omitempty"`
 	SendingQueue    SendingQueue      `yaml:"sending_queue,omitempty"`
@@ -152,6 +189,18 @@ type OTLPExporterConfig struct {
 	Auth            Auth              `yaml:"auth,omitempty"`
 }
 
+type PrometheusExporterConfig struct {
+	Endpoint                      string                        `yaml:"endpoint"`
+	Namespace                     string                        `yaml:"namespace,omitempty"`
+	ResourceToTelemetryConversion ResourceToTelemetryConversion `yaml:"resource_to_telemetry_conversion"`
+	MetricExpiration              time.Duration                 `yaml:"metric_expiration"`
+	EnableOpenMetrics             bool                          `yaml:"enable_open_metrics"`
+}
+
+type ResourceToTelemetryConversion struct {
+	Enabled bool `yaml:"enabled"`
+}
+
 type TLS struct {
 	Insecure           bool   `yaml:"insecure"`
 	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
@@ -260,6 +309,14 @@ type ServiceEnrichmentProcessorConfig struct {
 type IstioNoiseFilterProcessorConfig struct {
 }
 
+type IntervalProcessorConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+type GroupByAttrsProcessorConfig struct {
+	Keys []string `yaml:"keys,omitempty"`
+}
+
 type FilterProcessorConfig struct {
 	ErrorMode string                        `yaml:"error_mode"`
 	Metrics   []telemetryv1beta1.FilterSpec `yaml:"metric_conditions,omitempty"`
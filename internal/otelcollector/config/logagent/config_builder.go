@@ -55,6 +55,11 @@ type BuildOptions struct {
 }
 
 func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.LogPipeline, opts BuildOptions) (*common.Config, common.EnvVars, error) {
+	// Suspended pipelines are left out of the configuration without deleting them
+	pipelines = slices.DeleteFunc(slices.Clone(pipelines), func(p telemetryv1beta1.LogPipeline) bool {
+		return p.Spec.Suspend
+	})
+
 	// Sort pipelines to ensure consistent order and checksum for generated ConfigMap
 	slices.SortFunc(pipelines, func(a, b telemetryv1beta1.LogPipeline) int {
 		return strings.Compare(a.Name, b.Name)
@@ -77,6 +82,10 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.LogPip
 			}
 		}
 
+		if common.IsServiceAccountTokenAuthEnabled(pipeline.Spec.Output.OTLP.Authentication) {
+			b.addServiceAccountTokenAuthExtension(&pipeline)
+		}
+
 		if err := b.AddServicePipeline(ctx, &pipeline, pipelineID,
 			b.addFileLogReceiver(),
 			b.addMemoryLimiterProcessor(),
@@ -89,6 +98,7 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.LogPip
 			// Kyma attributes are dropped before user-defined transform and filter processors
 			// to prevent user access to internal attributes.
 			b.addDropKymaAttributesProcessor(),
+			b.addRedactionProcessor(),
 			b.addUserDefinedTransformProcessor(),
 			b.addUserDefinedFilterProcessor(),
 			b.addOTLPExporter(),
@@ -206,6 +216,20 @@ func (b *Builder) addDropKymaAttributesProcessor() buildComponentFunc {
 	)
 }
 
+func (b *Builder) addRedactionProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatRedactionProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			processor := common.LogRedactionProcessor(lp.Spec.Redaction)
+			if processor == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return processor
+		},
+	)
+}
+
 func (b *Builder) addUserDefinedTransformProcessor() buildComponentFunc {
 	return b.AddProcessor(
 		formatUserDefinedTransformProcessorID,
@@ -274,6 +298,14 @@ func (b *Builder) addOAuth2Extension(ctx context.Context, pipeline *telemetryv1b
 	return nil
 }
 
+func (b *Builder) addServiceAccountTokenAuthExtension(pipeline *telemetryv1beta1.LogPipeline) {
+	b.AddExtension(
+		common.ComponentIDServiceAccountTokenAuthExtension(pipelines.LogPipelineRef(pipeline)),
+		common.ServiceAccountTokenAuthExtensionConfig(pipeline.Spec.Output.OTLP.Authentication.ServiceAccountToken),
+		nil,
+	)
+}
+
 func shouldEnableOAuth2(tp *telemetryv1beta1.LogPipeline) bool {
 	return tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
 }
@@ -286,6 +318,10 @@ func formatFileLogReceiverID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDFileLogReceiver(lp.Name)
 }
 
+func formatRedactionProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.LogPipelineRef(lp))
+}
+
 func formatUserDefinedTransformProcessorID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.LogPipelineRef(lp))
 }
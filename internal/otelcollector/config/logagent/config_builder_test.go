@@ -36,6 +36,24 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "suspended pipeline is left out",
+			goldenFileName: "single-pipeline.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithKeepOriginalBody(true).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("suspended").
+					WithRuntimeInput(true).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					WithSuspend(true).
+					Build(),
+			},
+		},
 		{
 			name:              "single pipeline with otel service enrichment",
 			goldenFileName:    "service-enrichment-otel.yaml",
@@ -130,6 +148,24 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "pipeline with redaction",
+			goldenFileName: "redaction.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithOTLPOutput().
+					WithRedaction(telemetryv1beta1.Redaction{
+						Presets:  []telemetryv1beta1.RedactionPreset{telemetryv1beta1.RedactionPresetEmail, telemetryv1beta1.RedactionPresetCreditCard},
+						Patterns: []string{`password=\S+`},
+					}).
+					WithTransform(telemetryv1beta1.TransformSpec{
+						Statements: []string{"set(log.attributes[\"redacted\"], true)"},
+					}).
+					Build(),
+			},
+		},
 		{
 			name:           "two pipelines with user-defined filter",
 			goldenFileName: "user-defined-filters.yaml",
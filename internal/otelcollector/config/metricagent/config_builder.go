@@ -13,9 +13,11 @@ import (
 	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 	telemetryutils "github.com/kyma-project/telemetry-manager/internal/utils/telemetry"
 )
 
@@ -30,10 +32,39 @@ const (
 	daemonsetMetricPattern      = `^k8s[.]daemonset[.].*`
 	statefulsetMetricPattern    = `^k8s[.]statefulset[.].*`
 	jobMetricPattern            = `^k8s[.]job[.].*`
+	replicasetMetricPattern     = `^k8s[.]replicaset[.].*`
+	cronjobMetricPattern        = `^k8s[.]cronjob[.].*`
+	hpaMetricPattern            = `^k8s[.]hpa[.].*`
+	pvcMetricPattern            = `^k8s[.]persistentvolumeclaim[.].*`
+	resourcequotaMetricPattern  = `^k8s[.]resource_quota[.].*`
+	namespaceMetricPattern      = `^k8s[.]namespace[.].*`
+	hostMetricPattern           = `^system[.].*`
+	hostMetricsScopeNamePattern = `^github[.]com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/`
+)
+
+const (
+	scrapeHealthServicePipelineID = "metrics/scrape-health"
+	// scrapeHealthMetricsNamespace prefixes the exposed scrape health metrics, so that they do not clash with the up metric of the self-monitor scrape
+	scrapeHealthMetricsNamespace = "kyma_scrape_target"
+	k8sWorkloadName              = "k8s.workload.name"
 )
 
 var diagnosticMetricNames = []string{"up", "scrape_duration_seconds", "scrape_samples_scraped", "scrape_samples_post_metric_relabeling", "scrape_series_added"}
 
+// scrapeHealthMetricNames are the diagnostic metrics that reveal failing scrape targets: up is 0 if the scrape failed, for example because the sample or body size limit was hit,
+// and scrape_samples_post_metric_relabeling shows how close a target is to the sample limit.
+var scrapeHealthMetricNames = []string{"up", "scrape_samples_post_metric_relabeling"}
+
+// workloadNameAttributes are the resource attributes from which the workload of a scrape target is derived, in order of precedence.
+var workloadNameAttributes = []string{
+	"k8s.deployment.name",
+	"k8s.statefulset.name",
+	"k8s.daemonset.name",
+	"k8s.cronjob.name",
+	"k8s.job.name",
+	"k8s.pod.name",
+}
+
 type buildComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.MetricPipeline]
 
 type Builder struct {
@@ -46,8 +77,10 @@ type BuildOptions struct {
 	Cluster common.ClusterOptions
 
 	// IstioActive indicates whether Istio is installed in the cluster.
-	IstioActive                 bool
-	IstioCertPath               string
+	IstioActive   bool
+	IstioCertPath string
+	// HostRootPath is the path where the root filesystem of the Node is mounted in the Metric Agent container.
+	HostRootPath                string
 	InstrumentationScopeVersion string
 	AgentNamespace              string
 	Enrichments                 *operatorv1beta1.EnrichmentSpec
@@ -65,22 +98,45 @@ type inputSources struct {
 	runtimeResources runtimeResourceSources
 	prometheus       bool
 	istio            bool
-	envoy            bool
+	controlPlane     controlPlaneComponentSources
 }
 
 // runtimeResourceSources represents the resources for which runtime metrics scraping is enabled.
 type runtimeResourceSources struct {
-	pod         bool
-	container   bool
-	node        bool
-	volume      bool
-	statefulset bool
-	deployment  bool
-	daemonset   bool
-	job         bool
+	pod           bool
+	container     bool
+	node          bool
+	volume        bool
+	statefulset   bool
+	deployment    bool
+	daemonset     bool
+	job           bool
+	replicaset    bool
+	cronjob       bool
+	hpa           bool
+	pvc           bool
+	resourcequota bool
+	namespace     bool
+	host          bool
+}
+
+// controlPlaneComponentSources represents the control plane components for which metrics scraping is enabled.
+type controlPlaneComponentSources struct {
+	apiServer bool
+	coreDNS   bool
+	kubeProxy bool
+}
+
+func (c controlPlaneComponentSources) any() bool {
+	return c.apiServer || c.coreDNS || c.kubeProxy
 }
 
 func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.MetricPipeline, opts BuildOptions) (*common.Config, common.EnvVars, error) {
+	// Suspended pipelines are left out of the configuration without deleting them
+	pipelines = slices.DeleteFunc(slices.Clone(pipelines), func(p telemetryv1beta1.MetricPipeline) bool {
+		return p.Spec.Suspend
+	})
+
 	// Sort pipelines to ensure consistent order and checksum for generated ConfigMap
 	slices.SortFunc(pipelines, func(a, b telemetryv1beta1.MetricPipeline) int {
 		return strings.Compare(a.Name, b.Name)
@@ -99,102 +155,165 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 	b.EnvVars = make(common.EnvVars)
 
 	inputs := inputSources{
-		runtimeResources: runtimeResourceSources{
-			pod:         shouldEnableRuntimePodMetricsScraping(pipelines),
-			container:   shouldEnableRuntimeContainerMetricsScraping(pipelines),
-			node:        shouldEnableRuntimeNodeMetricsScraping(pipelines),
-			volume:      shouldEnableRuntimeVolumeMetricsScraping(pipelines),
-			statefulset: shouldEnableRuntimeStatefulSetMetricsScraping(pipelines),
-			deployment:  shouldEnableRuntimeDeploymentMetricsScraping(pipelines),
-			daemonset:   shouldEnableRuntimeDaemonSetMetricsScraping(pipelines),
-			job:         shouldEnableRuntimeJobMetricsScraping(pipelines),
-		},
+		runtimeResources: getRuntimeResourceSources(pipelines),
 
 		runtime:    shouldEnableRuntimeMetricsScraping(pipelines),
 		prometheus: shouldEnablePrometheusMetricsScraping(pipelines),
 		istio:      shouldEnableIstioMetricsScraping(pipelines),
-		envoy:      shouldEnableEnvoyMetricsScraping(pipelines),
+
+		controlPlane: controlPlaneComponentSources{
+			apiServer: shouldEnableControlPlaneAPIServerMetricsScraping(pipelines),
+			coreDNS:   shouldEnableControlPlaneCoreDNSMetricsScraping(pipelines),
+			kubeProxy: shouldEnableControlPlaneKubeProxyMetricsScraping(pipelines),
+		},
 	}
 
-	// Input pipelines
-	pipelinesWithRuntimeInput := getPipelinesWithRuntimeInput(pipelines)
-	pipelinesWithPrometheusInput := getPipelinesWithPrometheusInput(pipelines)
-	pipelinesWithIstioInput := getPipelinesWithIstioInput(pipelines)
+	// Pipelines can override the scrape settings of an input, so the pipelines of each input are grouped by their effective settings.
+	runtimeGroups := groupByScrapeSettings(getPipelinesWithRuntimeInput(pipelines), opts.CollectionIntervals.Runtime, metricpipelineutils.RuntimeInputCollectionInterval, nil)
+	prometheusGroups := groupByScrapeSettings(getPipelinesWithPrometheusInput(pipelines), opts.CollectionIntervals.Prometheus, metricpipelineutils.PrometheusInputCollectionInterval, metricpipelineutils.IsPrometheusNativeHistogramsEnabled)
+	istioGroups := groupByScrapeSettings(getPipelinesWithIstioInput(pipelines), opts.CollectionIntervals.Istio, metricpipelineutils.IstioInputCollectionInterval, nil)
+	maxCollectionInterval := max(opts.CollectionIntervals.Max(), runtimeGroups.maxInterval(), prometheusGroups.maxInterval(), istioGroups.maxInterval(), maxAggregationInterval(pipelines))
 
 	k8sClusterAdditionalMetrics, kubeletStatsAdditionalMetrics := getRuntimeAdditionalMetrics(pipelines)
 	runtimeAdditionalMetrics := slices.Concat(k8sClusterAdditionalMetrics, kubeletStatsAdditionalMetrics)
 
-	if inputs.runtime {
-		if err := b.AddServicePipeline(ctx, nil, "metrics/input-runtime",
-			b.addKubeletStatsReceiver(inputs.runtimeResources, kubeletStatsAdditionalMetrics, opts.CollectionIntervals.Runtime),
-			b.addK8sClusterReceiver(inputs.runtimeResources, k8sClusterAdditionalMetrics, opts.CollectionIntervals.Runtime),
+	// Input pipelines
+	// Every scrape group gets its own receivers and input pipeline, so that the targets are scraped only once per distinct setting.
+	// The processors that are shared between the groups are configured for the resources of all pipelines.
+	for _, group := range runtimeGroups {
+		groupK8sClusterAdditionalMetrics, groupKubeletStatsAdditionalMetrics := getRuntimeAdditionalMetrics(group.pipelines)
+		groupRuntimeResources := getRuntimeResourceSources(group.pipelines)
+
+		if err := b.AddServicePipeline(ctx, nil, group.formatID("metrics/input-runtime"),
+			b.addKubeletStatsReceiver(group, groupRuntimeResources, groupKubeletStatsAdditionalMetrics),
+			b.addK8sClusterReceiver(group, groupRuntimeResources, groupK8sClusterAdditionalMetrics),
+			b.addHostMetricsReceiver(group, groupRuntimeResources, opts.HostRootPath),
 			b.addMemoryLimiterProcessor(),
 			b.addFilterDropNonPVCVolumesMetricsProcessor(inputs.runtimeResources),
 			b.addFilterDropVirtualNetworkInterfacesProcessor(),
 			b.addDropServiceNameProcessor(),
-			b.addInsertSkipEnrichmentAttributeProcessor(),
-			b.addSetInstrumentationScopeToRuntimeProcessor(opts),
+			b.addInsertSkipEnrichmentAttributeProcessor(inputs.runtimeResources),
+			b.addInsertHostNodeNameProcessor(inputs.runtimeResources),
+			b.addSetInstrumentationScopeToRuntimeProcessor(opts, inputs.runtimeResources),
 			b.addSetKymaInputNameProcessor(common.InputSourceRuntime),
+			b.addSetKymaInputScrapeGroupProcessor(group),
 			// Metrics with the skip enrichment attribute are routed directly to output pipelines,
 			// while all other metrics are sent to the enrichment pipeline before output.
-			b.addExporterForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput),
+			b.addExporterForInputRouter(group.formatID(common.ComponentIDRuntimeInputRoutingConnector), group.pipelines),
 		); err != nil {
 			return nil, nil, fmt.Errorf("failed to add runtime service pipeline: %w", err)
 		}
 	}
 
-	if inputs.prometheus {
-		if err := b.AddServicePipeline(ctx, nil, "metrics/input-prometheus",
-			b.addPrometheusAppPodsReceiver(opts.CollectionIntervals.Prometheus),
-			b.addPrometheusAppServicesReceiver(opts, opts.CollectionIntervals.Prometheus),
+	for _, group := range prometheusGroups {
+		if err := b.AddServicePipeline(ctx, nil, group.formatID("metrics/input-prometheus"),
+			b.addPrometheusAppPodsReceiver(group),
+			b.addPrometheusAppServicesReceiver(opts, group),
 			b.addMemoryLimiterProcessor(),
 			b.addDropServiceNameProcessor(),
 			b.addSetInstrumentationScopeToPrometheusProcessor(opts),
 			b.addSetKymaInputNameProcessor(common.InputSourcePrometheus),
+			b.addSetKymaInputScrapeGroupProcessor(group),
 			// Metrics with the skip enrichment attribute are routed directly to output pipelines,
 			// while all other metrics are sent to the enrichment pipeline before output.
-			b.addExporterForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput),
+			b.addExporterForInputRouter(group.formatID(common.ComponentIDPrometheusInputRoutingConnector), group.pipelines),
 		); err != nil {
 			return nil, nil, fmt.Errorf("failed to add prometheus service pipeline: %w", err)
 		}
 	}
 
-	if inputs.istio {
-		if err := b.AddServicePipeline(ctx, nil, "metrics/input-istio",
-			b.addPrometheusIstioReceiver(inputs.envoy, opts.CollectionIntervals.Istio),
+	for _, group := range istioGroups {
+		if err := b.AddServicePipeline(ctx, nil, group.formatID("metrics/input-istio"),
+			b.addPrometheusIstioReceiver(group, shouldEnableEnvoyMetricsScraping(group.pipelines)),
 			b.addMemoryLimiterProcessor(),
 			b.addDropServiceNameProcessor(),
 			b.addIstioNoiseFilterProcessor(),
 			b.addSetInstrumentationScopeToIstioProcessor(opts),
 			b.addSetKymaInputNameProcessor(common.InputSourceIstio),
+			b.addSetKymaInputScrapeGroupProcessor(group),
 			// Metrics with the skip enrichment attribute are routed directly to output pipelines,
 			// while all other metrics are sent to the enrichment pipeline before output.
-			b.addExporterForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput),
+			b.addExporterForInputRouter(group.formatID(common.ComponentIDIstioInputRoutingConnector), group.pipelines),
 		); err != nil {
 			return nil, nil, fmt.Errorf("failed to add istio service pipeline: %w", err)
 		}
 	}
 
+	if inputs.controlPlane.any() {
+		if err := b.AddServicePipeline(ctx, nil, "metrics/input-control-plane",
+			b.addPrometheusControlPlaneReceiver(inputs.controlPlane, opts.CollectionIntervals.Prometheus),
+			b.addMemoryLimiterProcessor(),
+			b.addSetInstrumentationScopeToControlPlaneProcessor(opts),
+			b.addSetKymaInputNameProcessor(common.InputSourceControlPlane),
+			b.addDropControlPlaneDiagnosticMetricsProcessor(),
+			// Control plane metrics are not related to workloads on the Node of the Metric Agent, so they bypass the enrichment pipeline.
+			b.addExporterForControlPlaneInputForwarder(),
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to add control plane service pipeline: %w", err)
+		}
+	}
+
+	// Scrape health pipeline
+	// The diagnostic metrics of the application scrape targets are exposed to the self-monitor, so that failing targets are reported in the MetricPipeline status.
+	// The pipeline shares the receivers with the prometheus input pipelines, so the targets are not scraped twice.
+	if inputs.prometheus {
+		var scrapeHealthPipelineFuncs []buildComponentFunc
+		for _, group := range prometheusGroups {
+			scrapeHealthPipelineFuncs = append(scrapeHealthPipelineFuncs,
+				b.addPrometheusAppPodsReceiver(group),
+				b.addPrometheusAppServicesReceiver(opts, group),
+			)
+		}
+
+		scrapeHealthPipelineFuncs = append(scrapeHealthPipelineFuncs,
+			b.addMemoryLimiterProcessor(),
+			b.addKeepScrapeHealthMetricsProcessor(),
+			b.addK8sAttributesProcessor(opts),
+			b.addSetScrapeHealthAttributesProcessor(),
+			b.addScrapeHealthExporter(prometheusGroups.maxInterval()),
+		)
+
+		if err := b.AddServicePipeline(ctx, nil, scrapeHealthServicePipelineID, scrapeHealthPipelineFuncs...); err != nil {
+			return nil, nil, fmt.Errorf("failed to add scrape health service pipeline: %w", err)
+		}
+	}
+
 	// Enrichment pipeline
-	if err := b.AddServicePipeline(ctx, nil, enrichmentServicePipelineID,
-		b.addReceiverForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput, inputs.runtime),
-		b.addReceiverForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput, inputs.prometheus),
-		b.addReceiverForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput, inputs.istio),
-		b.addDropUnknownServiceNameProcessor(opts),
-		b.addK8sAttributesProcessor(opts),
-		b.addRestoreOtelServiceAttrsProcessor(opts),
-		b.addServiceEnrichmentProcessor(opts),
-		b.addExporterForEnrichmentRouter(pipelinesWithRuntimeInput, pipelinesWithPrometheusInput, pipelinesWithIstioInput),
-	); err != nil {
-		return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
+	// The pipeline is skipped if only the control plane input is enabled, because control plane metrics are never enriched.
+	if inputs.runtime || inputs.prometheus || inputs.istio {
+		var enrichmentPipelineFuncs []buildComponentFunc
+		for _, group := range runtimeGroups {
+			enrichmentPipelineFuncs = append(enrichmentPipelineFuncs, b.addReceiverForInputRouter(group.formatID(common.ComponentIDRuntimeInputRoutingConnector), group.pipelines, true))
+		}
+
+		for _, group := range prometheusGroups {
+			enrichmentPipelineFuncs = append(enrichmentPipelineFuncs, b.addReceiverForInputRouter(group.formatID(common.ComponentIDPrometheusInputRoutingConnector), group.pipelines, true))
+		}
+
+		for _, group := range istioGroups {
+			enrichmentPipelineFuncs = append(enrichmentPipelineFuncs, b.addReceiverForInputRouter(group.formatID(common.ComponentIDIstioInputRoutingConnector), group.pipelines, true))
+		}
+
+		enrichmentPipelineFuncs = append(enrichmentPipelineFuncs,
+			b.addDropUnknownServiceNameProcessor(opts),
+			b.addK8sAttributesProcessor(opts),
+			b.addRestoreOtelServiceAttrsProcessor(opts),
+			b.addServiceEnrichmentProcessor(opts),
+			b.addExporterForEnrichmentRouter(runtimeGroups, prometheusGroups, istioGroups),
+		)
+
+		if err := b.AddServicePipeline(ctx, nil, enrichmentServicePipelineID, enrichmentPipelineFuncs...); err != nil {
+			return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
+		}
 	}
 
 	// Output pipelines
 	for _, pipeline := range pipelines {
 		outputPipelineID := formatOutputMetricServicePipelineID(&pipeline)
-		runtimeInputEnabled := metricpipelineutils.IsRuntimeInputEnabled(pipeline.Spec.Input)
-		prometheusInputEnabled := metricpipelineutils.IsPrometheusInputEnabled(pipeline.Spec.Input)
-		istioInputEnabled := metricpipelineutils.IsIstioInputEnabled(pipeline.Spec.Input)
+		runtimeGroup, runtimeInputEnabled := runtimeGroups.groupOf(pipeline.Name)
+		prometheusGroup, prometheusInputEnabled := prometheusGroups.groupOf(pipeline.Name)
+		istioGroup, istioInputEnabled := istioGroups.groupOf(pipeline.Name)
+		controlPlaneInputEnabled := inputs.controlPlane.any() && metricpipelineutils.IsControlPlaneInputEnabled(pipeline.Spec.Input)
 		queueSize := common.BatchingMaxQueueSize / len(pipelines)
 
 		if shouldEnableOAuth2(&pipeline) {
@@ -203,14 +322,19 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			}
 		}
 
+		if pipeline.Spec.Output.OTLP != nil && common.IsServiceAccountTokenAuthEnabled(pipeline.Spec.Output.OTLP.Authentication) {
+			b.addServiceAccountTokenAuthExtension(&pipeline)
+		}
+
 		if err := b.AddServicePipeline(ctx, &pipeline, outputPipelineID,
 			// Receivers
 			// Metrics are received from either the enrichment pipeline or directly from input pipelines,
 			// depending on whether they have the skip enrichment attribute set.
-			b.addReceiverForEnrichmentRouter(pipelinesWithRuntimeInput, pipelinesWithPrometheusInput, pipelinesWithIstioInput),
-			b.addReceiverForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput, runtimeInputEnabled),
-			b.addReceiverForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput, prometheusInputEnabled),
-			b.addReceiverForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput, istioInputEnabled),
+			b.addReceiverForEnrichmentRouter(runtimeGroups, prometheusGroups, istioGroups),
+			b.addReceiverForInputRouter(runtimeGroup.formatID(common.ComponentIDRuntimeInputRoutingConnector), runtimeGroup.pipelines, runtimeInputEnabled),
+			b.addReceiverForInputRouter(prometheusGroup.formatID(common.ComponentIDPrometheusInputRoutingConnector), prometheusGroup.pipelines, prometheusInputEnabled),
+			b.addReceiverForInputRouter(istioGroup.formatID(common.ComponentIDIstioInputRoutingConnector), istioGroup.pipelines, istioInputEnabled),
+			b.addReceiverForControlPlaneInputForwarder(controlPlaneInputEnabled),
 			// Runtime resource filters
 			b.addDropRuntimePodMetricsProcessor(pipeline.Name),
 			b.addDropRuntimeContainerMetricsProcessor(pipeline.Name),
@@ -220,7 +344,16 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			b.addDropRuntimeDaemonSetMetricsProcessor(pipeline.Name),
 			b.addDropRuntimeStatefulSetMetricsProcessor(pipeline.Name),
 			b.addDropRuntimeJobMetricsProcessor(pipeline.Name),
+			b.addDropRuntimeReplicaSetMetricsProcessor(inputs.runtimeResources.replicaset, pipeline.Name),
+			b.addDropRuntimeCronJobMetricsProcessor(inputs.runtimeResources.cronjob, pipeline.Name),
+			b.addDropRuntimeHPAMetricsProcessor(inputs.runtimeResources.hpa, pipeline.Name),
+			b.addDropRuntimePVCMetricsProcessor(inputs.runtimeResources.pvc, pipeline.Name),
+			b.addDropRuntimeResourceQuotaMetricsProcessor(inputs.runtimeResources.resourcequota, pipeline.Name),
+			b.addDropRuntimeNamespaceMetricsProcessor(inputs.runtimeResources.namespace, pipeline.Name),
+			b.addDropRuntimeHostMetricsProcessor(inputs.runtimeResources.host, pipeline.Name),
 			b.addDropAdditionalRuntimeMetricsProcessor(runtimeAdditionalMetrics, pipeline.Name),
+			// Control plane component filters
+			b.addDropControlPlaneComponentMetricsProcessor(inputs.controlPlane, pipeline.Name),
 			// Diagnostic metric filters
 			b.addDropPrometheusDiagnosticMetricsProcessor(),
 			b.addDropIstioDiagnosticMetricsProcessor(),
@@ -236,12 +369,20 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			// Kyma attributes are dropped before user-defined transform and filter processors
 			// to prevent user access to internal attributes.
 			b.addDropKymaAttributesProcessor(),
+			b.addRedactionProcessor(),
 			b.addUserDefinedTransformProcessor(),
 			b.addUserDefinedFilterProcessor(),
-			b.addCumulativeToDeltaProcessor(opts),
+			b.addDropExemplarsProcessor(),
+			// Aggregation processors
+			b.addAggregationDropAttributesProcessor(),
+			b.addAggregationGroupByAttrsProcessor(),
+			b.addAggregationMergeSeriesProcessor(),
+			b.addIntervalProcessor(),
+			b.addCumulativeToDeltaProcessor(maxCollectionInterval),
 			b.addBatchProcessor(), // always last
-			// OTLP exporter
+			// Exporters
 			b.addOTLPExporter(queueSize),
+			b.addPrometheusExporter(),
 		); err != nil {
 			return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
 		}
@@ -252,47 +393,69 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 
 // Receiver builders
 
-func (b *Builder) addK8sClusterReceiver(runtimeResources runtimeResourceSources, k8sClusterAdditionalMetrics []string, collectionInterval time.Duration) buildComponentFunc {
+func (b *Builder) addK8sClusterReceiver(group scrapeGroup, runtimeResources runtimeResourceSources, k8sClusterAdditionalMetrics []string) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(group.formatID(common.ComponentIDK8sClusterReceiver)),
+		func(*telemetryv1beta1.MetricPipeline) any {
+			return k8sClusterReceiver(runtimeResources, k8sClusterAdditionalMetrics, group.interval)
+		},
+	)
+}
+
+func (b *Builder) addKubeletStatsReceiver(group scrapeGroup, runtimeResources runtimeResourceSources, kubeletStatsAdditionalMetrics []string) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(group.formatID(common.ComponentIDKubeletStatsReceiver)),
+		func(*telemetryv1beta1.MetricPipeline) any {
+			return kubeletStatsReceiver(runtimeResources, kubeletStatsAdditionalMetrics, group.interval)
+		},
+	)
+}
+
+func (b *Builder) addHostMetricsReceiver(group scrapeGroup, runtimeResources runtimeResourceSources, rootPath string) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDK8sClusterReceiver),
+		b.StaticComponentID(group.formatID(common.ComponentIDHostMetricsReceiver)),
 		func(*telemetryv1beta1.MetricPipeline) any {
-			return k8sClusterReceiver(runtimeResources, k8sClusterAdditionalMetrics, collectionInterval)
+			if !runtimeResources.host {
+				return nil
+			}
+
+			return hostMetricsReceiver(rootPath, group.interval)
 		},
 	)
 }
 
-func (b *Builder) addKubeletStatsReceiver(runtimeResources runtimeResourceSources, kubeletStatsAdditionalMetrics []string, collectionInterval time.Duration) buildComponentFunc {
+func (b *Builder) addPrometheusAppPodsReceiver(group scrapeGroup) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDKubeletStatsReceiver),
+		b.StaticComponentID(group.formatID(common.ComponentIDPrometheusAppPodsReceiver)),
 		func(*telemetryv1beta1.MetricPipeline) any {
-			return kubeletStatsReceiver(runtimeResources, kubeletStatsAdditionalMetrics, collectionInterval)
+			return prometheusPodsReceiverConfig(group.interval, group.nativeHistograms)
 		},
 	)
 }
 
-func (b *Builder) addPrometheusAppPodsReceiver(collectionInterval time.Duration) buildComponentFunc {
+func (b *Builder) addPrometheusAppServicesReceiver(opts BuildOptions, group scrapeGroup) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDPrometheusAppPodsReceiver),
+		b.StaticComponentID(group.formatID(common.ComponentIDPrometheusAppServicesReceiver)),
 		func(*telemetryv1beta1.MetricPipeline) any {
-			return prometheusPodsReceiverConfig(collectionInterval)
+			return prometheusServicesReceiverConfig(opts, group.interval, group.nativeHistograms)
 		},
 	)
 }
 
-func (b *Builder) addPrometheusAppServicesReceiver(opts BuildOptions, collectionInterval time.Duration) buildComponentFunc {
+func (b *Builder) addPrometheusIstioReceiver(group scrapeGroup, envoyMetricsEnabled bool) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDPrometheusAppServicesReceiver),
+		b.StaticComponentID(group.formatID(common.ComponentIDPrometheusIstioReceiver)),
 		func(*telemetryv1beta1.MetricPipeline) any {
-			return prometheusServicesReceiverConfig(opts, collectionInterval)
+			return prometheusIstioReceiverConfig(envoyMetricsEnabled, group.interval)
 		},
 	)
 }
 
-func (b *Builder) addPrometheusIstioReceiver(envoyMetricsEnabled bool, collectionInterval time.Duration) buildComponentFunc {
+func (b *Builder) addPrometheusControlPlaneReceiver(components controlPlaneComponentSources, collectionInterval time.Duration) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDPrometheusIstioReceiver),
+		b.StaticComponentID(common.ComponentIDPrometheusControlPlaneReceiver),
 		func(*telemetryv1beta1.MetricPipeline) any {
-			return prometheusIstioReceiverConfig(envoyMetricsEnabled, collectionInterval)
+			return prometheusControlPlaneReceiverConfig(components, collectionInterval)
 		},
 	)
 }
@@ -349,11 +512,21 @@ func (b *Builder) addDropServiceNameProcessor() buildComponentFunc {
 	)
 }
 
-func (b *Builder) addSetInstrumentationScopeToRuntimeProcessor(opts BuildOptions) buildComponentFunc {
+func (b *Builder) addSetInstrumentationScopeToRuntimeProcessor(opts BuildOptions, runtimeResources runtimeResourceSources) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDSetInstrumentationScopeRuntimeProcessor),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			return common.InstrumentationScopeProcessor(opts.InstrumentationScopeVersion, common.InputSourceRuntime, common.InputSourceK8sCluster)
+			processor := common.InstrumentationScopeProcessor(opts.InstrumentationScopeVersion, common.InputSourceRuntime, common.InputSourceK8sCluster)
+
+			// The hostmetrics receiver uses a dedicated instrumentation scope per scraper
+			if runtimeResources.host {
+				processor.MetricStatements[0].Statements = append(processor.MetricStatements[0].Statements,
+					fmt.Sprintf("set(scope.version, \"%s\") where IsMatch(scope.name, \"%s\")", opts.InstrumentationScopeVersion, hostMetricsScopeNamePattern),
+					fmt.Sprintf("set(scope.name, \"%s\") where IsMatch(scope.name, \"%s\")", common.InstrumentationScopeRuntime, hostMetricsScopeNamePattern),
+				)
+			}
+
+			return processor
 		},
 	)
 }
@@ -376,6 +549,15 @@ func (b *Builder) addSetInstrumentationScopeToIstioProcessor(opts BuildOptions)
 	)
 }
 
+func (b *Builder) addSetInstrumentationScopeToControlPlaneProcessor(opts BuildOptions) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDSetInstrumentationScopeControlPlaneProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return common.InstrumentationScopeProcessor(opts.InstrumentationScopeVersion, common.InputSourceControlPlane)
+		},
+	)
+}
+
 func (b *Builder) addSetKymaInputNameProcessor(inputSource common.InputSourceType) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.InputName[inputSource]),
@@ -386,11 +568,43 @@ func (b *Builder) addSetKymaInputNameProcessor(inputSource common.InputSourceTyp
 	)
 }
 
-func (b *Builder) addInsertSkipEnrichmentAttributeProcessor() buildComponentFunc {
+// addSetKymaInputScrapeGroupProcessor marks the metrics of a group with non-default scrape settings,
+// so that the enrichment router can send them only to the pipelines that requested the settings.
+func (b *Builder) addSetKymaInputScrapeGroupProcessor(group scrapeGroup) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(group.formatID(common.ComponentIDSetKymaInputScrapeGroupProcessor)),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if group.isDefault() {
+				return nil
+			}
+
+			return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
+				Statements: []string{fmt.Sprintf("set(resource.attributes[\"%s\"], \"%s\")", common.KymaInputScrapeGroupAttribute, group.suffix)},
+			}})
+		},
+	)
+}
+
+func (b *Builder) addInsertSkipEnrichmentAttributeProcessor(runtimeResources runtimeResourceSources) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDInsertSkipEnrichmentAttributeProcessor),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			return insertSkipEnrichmentAttributeProcessor()
+			return insertSkipEnrichmentAttributeProcessor(runtimeResources)
+		},
+	)
+}
+
+// addInsertHostNodeNameProcessor sets the k8s.node.name resource attribute on host metrics,
+// because the hostmetrics receiver is not aware of the Node it runs on.
+func (b *Builder) addInsertHostNodeNameProcessor(runtimeResources runtimeResourceSources) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDInsertHostNodeNameProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !runtimeResources.host {
+				return nil
+			}
+
+			return insertHostNodeNameProcessor()
 		},
 	)
 }
@@ -492,6 +706,20 @@ func (b *Builder) addDropKymaAttributesProcessor() buildComponentFunc {
 	)
 }
 
+func (b *Builder) addRedactionProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatRedactionProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			processor := common.MetricRedactionProcessor(mp.Spec.Redaction)
+			if processor == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return processor
+		},
+	)
+}
+
 func (b *Builder) addUserDefinedTransformProcessor() buildComponentFunc {
 	return b.AddProcessor(
 		formatUserDefinedTransformProcessorID,
@@ -529,7 +757,7 @@ func (b *Builder) addRuntimeNamespaceFilterProcessor() buildComponentFunc {
 		},
 		func(mp *telemetryv1beta1.MetricPipeline) any {
 			input := mp.Spec.Input
-			if !metricpipelineutils.IsRuntimeInputEnabled(input) || !shouldFilterByNamespace(input.Runtime.Namespaces) {
+			if !metricpipelineutils.IsRuntimeInputEnabled(input) || !sharedtypesutils.IsNamespaceFilterDefined(input.Runtime.Namespaces) {
 				return nil
 			}
 
@@ -545,7 +773,7 @@ func (b *Builder) addPrometheusNamespaceFilterProcessor() buildComponentFunc {
 		},
 		func(mp *telemetryv1beta1.MetricPipeline) any {
 			input := mp.Spec.Input
-			if !metricpipelineutils.IsPrometheusInputEnabled(input) || !shouldFilterByNamespace(input.Prometheus.Namespaces) {
+			if !metricpipelineutils.IsPrometheusInputEnabled(input) || !sharedtypesutils.IsNamespaceFilterDefined(input.Prometheus.Namespaces) {
 				return nil
 			}
 
@@ -561,7 +789,7 @@ func (b *Builder) addIstioNamespaceFilterProcessor() buildComponentFunc {
 		},
 		func(mp *telemetryv1beta1.MetricPipeline) any {
 			input := mp.Spec.Input
-			if !metricpipelineutils.IsIstioInputEnabled(input) || !shouldFilterByNamespace(input.Istio.Namespaces) {
+			if !metricpipelineutils.IsIstioInputEnabled(input) || !sharedtypesutils.IsNamespaceFilterDefined(input.Istio.Namespaces) {
 				return nil
 			}
 
@@ -855,183 +1083,538 @@ func (b *Builder) addDropRuntimeJobMetricsProcessor(pipelineName string) buildCo
 	)
 }
 
-// addDropAdditionalRuntimeMetricsProcessor adds a filter processor to drop runtime additional metrics excluding those specified in the pipeline and those related to enabled runtime resource inputs.
-// This is needed because the kubeletStats and k8sCluster receivers emit the union of additional metrics specified in ALL pipelines.
-func (b *Builder) addDropAdditionalRuntimeMetricsProcessor(allAdditionalMetrics []string, pipelineName string) buildComponentFunc {
+// addDropRuntimeReplicaSetMetricsProcessor drops replicaset metrics from the runtime input if runtime input is enabled but replicaset metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits replicaset metrics, that is, if they are enabled for any other pipeline.
+// Additional replicaset metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimeReplicaSetMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
 	return b.AddProcessor(
-		b.StaticComponentID(common.ComponentIDDropRuntimeAdditionalMetricsProcessor+"-"+pipelineName),
+		b.StaticComponentID(common.ComponentIDDropRuntimeReplicaSetMetricsProcessor+"-"+pipelineName),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || len(allAdditionalMetrics) == 0 {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeReplicaSetInputEnabled(mp.Spec.Input) {
 				return nil
 			}
 
-			additionalMetricsToDrop := additionalMetricsToDrop(allAdditionalMetrics, mp.Spec.Input.Runtime.AdditionalMetrics, mp.Spec.Input)
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", replicasetMetricPattern),
+			}
 
-			if len(additionalMetricsToDrop) == 0 {
-				return nil
+			additionalReplicaSetMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, replicasetMetricPattern)
+			if len(additionalReplicaSetMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalReplicaSetMetrics)...)))
 			}
 
 			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
 				{
-					Conditions: []string{common.JoinWithAnd(
-						common.KymaInputNameEquals(common.InputSourceRuntime),
-						common.JoinWithOr(nameConditions(additionalMetricsToDrop)...),
-					)},
+					Conditions: []string{common.JoinWithAnd(conditions...)},
 				},
 			})
 		},
 	)
 }
 
-// additionalMetricsToDrop determines which additional runtime metrics should be dropped for a given pipeline based on the additionalmetrics specified in the pipeline and the enabled runtime resource inputs.
-func additionalMetricsToDrop(allAdditionalMetrics []string, pipelineAdditionalMetrics []string, metricPipelineInput telemetryv1beta1.MetricPipelineInput) []string {
-	excludedMetrics := pipelineAdditionalMetrics
-
-	if metricpipelineutils.IsRuntimePodInputEnabled(metricPipelineInput) {
-		podMetrics := slices.Concat(k8sClusterReceiverPodMetrics, kubeletStatsReceiverPodMetrics)
-		excludedMetrics = append(excludedMetrics, podMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeContainerInputEnabled(metricPipelineInput) {
-		containerMetrics := slices.Concat(k8sClusterReceiverContainerMetrics, kubeletStatsReceiverContainerMetrics)
-		excludedMetrics = append(excludedMetrics, containerMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeNodeInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, kubeletStatsReceiverNodeMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeVolumeInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, kubeletStatsReceiverVolumeMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeDeploymentInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, k8sClusterReceiverDeploymentMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeDaemonSetInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, k8sClusterReceiverDaemonSetMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeStatefulSetInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, k8sClusterReceiverStatefulSetMetrics...)
-	}
-
-	if metricpipelineutils.IsRuntimeJobInputEnabled(metricPipelineInput) {
-		excludedMetrics = append(excludedMetrics, k8sClusterReceiverJobMetrics...)
-	}
-
-	var metricsToDrop []string
-
-	for _, m := range allAdditionalMetrics {
-		if !slices.Contains(excludedMetrics, m) {
-			metricsToDrop = append(metricsToDrop, m)
-		}
-	}
-
-	return metricsToDrop
-}
-
-func getRuntimeAdditionalResourceMetrics(additionalMetrics []string, resourceMetricPattern string) []string {
-	resourceMetricRegex := regexp.MustCompile(resourceMetricPattern)
-
-	var resourceMetrics []string
-
-	for _, m := range additionalMetrics {
-		if resourceMetricRegex.MatchString(m) {
-			resourceMetrics = append(resourceMetrics, m)
-		}
-	}
-
-	return resourceMetrics
-}
-
-// Diagnostic metric filter processors
-
-func (b *Builder) addDropPrometheusDiagnosticMetricsProcessor() buildComponentFunc {
+// addDropRuntimeCronJobMetricsProcessor drops cronjob metrics from the runtime input if runtime input is enabled but cronjob metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits cronjob metrics, that is, if they are enabled for any other pipeline.
+// Additional cronjob metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimeCronJobMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
 	return b.AddProcessor(
-		b.StaticComponentID(common.ComponentIDDropPrometheusDiagnosticMetricsProcessor),
+		b.StaticComponentID(common.ComponentIDDropRuntimeCronJobMetricsProcessor+"-"+pipelineName),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if !metricpipelineutils.IsPrometheusInputEnabled(mp.Spec.Input) || metricpipelineutils.IsPrometheusDiagnosticInputEnabled(mp.Spec.Input) {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeCronJobInputEnabled(mp.Spec.Input) {
 				return nil
 			}
 
-			return dropDiagnosticMetricsFilterProcessor(common.InputSourcePrometheus)
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", cronjobMetricPattern),
+			}
+
+			additionalCronJobMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, cronjobMetricPattern)
+			if len(additionalCronJobMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalCronJobMetrics)...)))
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(conditions...)},
+				},
+			})
 		},
 	)
 }
 
-func (b *Builder) addDropIstioDiagnosticMetricsProcessor() buildComponentFunc {
+// addDropRuntimeHPAMetricsProcessor drops horizontalpodautoscaler metrics from the runtime input if runtime input is enabled but horizontalpodautoscaler metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits horizontalpodautoscaler metrics, that is, if they are enabled for any other pipeline.
+// Additional horizontalpodautoscaler metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimeHPAMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
 	return b.AddProcessor(
-		b.StaticComponentID(common.ComponentIDDropIstioDiagnosticMetricsProcessor),
+		b.StaticComponentID(common.ComponentIDDropRuntimeHPAMetricsProcessor+"-"+pipelineName),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if !metricpipelineutils.IsIstioInputEnabled(mp.Spec.Input) || metricpipelineutils.IsIstioDiagnosticInputEnabled(mp.Spec.Input) {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeHorizontalPodAutoscalerInputEnabled(mp.Spec.Input) {
 				return nil
 			}
 
-			return dropDiagnosticMetricsFilterProcessor(common.InputSourceIstio)
-		},
-	)
-}
-
-func dropDiagnosticMetricsFilterProcessor(inputSource common.InputSourceType) *common.FilterProcessorConfig {
-	var filterExpressions []string
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", hpaMetricPattern),
+			}
 
-	inputSourceCondition := common.KymaInputNameEquals(inputSource)
-	metricNameConditions := nameConditions(diagnosticMetricNames)
-	excludeScrapeMetricsExpr := common.JoinWithAnd(inputSourceCondition, common.JoinWithOr(metricNameConditions...))
-	filterExpressions = append(filterExpressions, excludeScrapeMetricsExpr)
+			additionalHPAMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, hpaMetricPattern)
+			if len(additionalHPAMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalHPAMetrics)...)))
+			}
 
-	return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
-		{
-			Conditions: filterExpressions,
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(conditions...)},
+				},
+			})
 		},
-	})
-}
-
-func nameConditions(names []string) []string {
-	var nameConditions []string
-	for _, name := range names {
-		nameConditions = append(nameConditions, common.MetricNameAttributeEquals(name))
-	}
-
-	return nameConditions
+	)
 }
 
-// Istio envoy metrics
-
-func (b *Builder) addDropEnvoyMetricsIfDisabledProcessor() buildComponentFunc {
+// addDropRuntimePVCMetricsProcessor drops persistentvolumeclaim metrics from the runtime input if runtime input is enabled but persistentvolumeclaim metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits persistentvolumeclaim metrics, that is, if they are enabled for any other pipeline.
+// Additional persistentvolumeclaim metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimePVCMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
 	return b.AddProcessor(
-		b.StaticComponentID(common.ComponentIDDropEnvoyMetricsIfDisabledProcessor),
+		b.StaticComponentID(common.ComponentIDDropRuntimePVCMetricsProcessor+"-"+pipelineName),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if metricpipelineutils.IsIstioInputEnabled(mp.Spec.Input) && metricpipelineutils.IsEnvoyMetricsEnabled(mp.Spec.Input) {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimePersistentVolumeClaimInputEnabled(mp.Spec.Input) {
 				return nil
 			}
 
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", pvcMetricPattern),
+			}
+
+			additionalPVCMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, pvcMetricPattern)
+			if len(additionalPVCMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalPVCMetrics)...)))
+			}
+
 			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
 				{
-					Conditions: []string{common.JoinWithAnd(
-						common.IsMatch("metric.name", "^envoy_.*"),
-						common.KymaInputNameEquals(common.InputSourceIstio),
-					)},
+					Conditions: []string{common.JoinWithAnd(conditions...)},
 				},
 			})
 		},
 	)
 }
 
-func (b *Builder) addCumulativeToDeltaProcessor(opts BuildOptions) buildComponentFunc {
+// addDropRuntimeResourceQuotaMetricsProcessor drops resourcequota metrics from the runtime input if runtime input is enabled but resourcequota metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits resourcequota metrics, that is, if they are enabled for any other pipeline.
+// Additional resourcequota metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimeResourceQuotaMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
 	return b.AddProcessor(
-		b.StaticComponentID(common.ComponentIDCumulativeToDeltaProcessor),
+		b.StaticComponentID(common.ComponentIDDropRuntimeResourceQuotaMetricsProcessor+"-"+pipelineName),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if metricpipelineutils.IsDeltaTemporality(mp.Spec.Output) {
-				return &common.CumulativeToDeltaProcessorConfig{
-					MaxStaleness: maxStalenessMultiplier * opts.CollectionIntervals.Max(),
-					InitialValue: "auto",
-				}
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeResourceQuotaInputEnabled(mp.Spec.Input) {
+				return nil
 			}
 
-			return nil
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", resourcequotaMetricPattern),
+			}
+
+			additionalResourceQuotaMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, resourcequotaMetricPattern)
+			if len(additionalResourceQuotaMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalResourceQuotaMetrics)...)))
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(conditions...)},
+				},
+			})
+		},
+	)
+}
+
+// addDropRuntimeNamespaceMetricsProcessor drops namespace metrics from the runtime input if runtime input is enabled but namespace metrics scraping is disabled.
+// The processor is only needed if the k8sCluster receiver emits namespace metrics, that is, if they are enabled for any other pipeline.
+// Additional namespace metrics specified in the pipeline configuration are excluded from dropping.
+//
+//nolint:dupl // Similar logic is used in other resource filter processors, but they are not identical
+func (b *Builder) addDropRuntimeNamespaceMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropRuntimeNamespaceMetricsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeNamespaceInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			conditions := []string{
+				common.KymaInputNameEquals(common.InputSourceRuntime),
+				common.IsMatch("metric.name", namespaceMetricPattern),
+			}
+
+			additionalNamespaceMetrics := getRuntimeAdditionalResourceMetrics(mp.Spec.Input.Runtime.AdditionalMetrics, namespaceMetricPattern)
+			if len(additionalNamespaceMetrics) > 0 {
+				conditions = append(conditions, common.Not(common.JoinWithOr(nameConditions(additionalNamespaceMetrics)...)))
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(conditions...)},
+				},
+			})
+		},
+	)
+}
+
+// addDropRuntimeHostMetricsProcessor drops host metrics from the runtime input if runtime input is enabled but host metrics scraping is disabled.
+// The processor is only needed if the hostmetrics receiver runs, that is, if host metrics are enabled for any other pipeline.
+func (b *Builder) addDropRuntimeHostMetricsProcessor(enabledInAnyPipeline bool, pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropRuntimeHostMetricsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !enabledInAnyPipeline || !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || metricpipelineutils.IsRuntimeHostInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(
+						common.KymaInputNameEquals(common.InputSourceRuntime),
+						common.IsMatch("metric.name", hostMetricPattern),
+					)},
+				},
+			})
+		},
+	)
+}
+
+// addDropControlPlaneComponentMetricsProcessor drops metrics of control plane components that are disabled for the pipeline.
+// The processor is only needed if the disabled components are scraped, that is, if they are enabled for any other pipeline.
+// The Prometheus receiver sets the service.name attribute to the scrape job name, which identifies the component.
+func (b *Builder) addDropControlPlaneComponentMetricsProcessor(scrapedComponents controlPlaneComponentSources, pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropControlPlaneComponentMetricsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsControlPlaneInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			var jobNameConditions []string
+
+			if scrapedComponents.apiServer && !metricpipelineutils.IsControlPlaneAPIServerInputEnabled(mp.Spec.Input) {
+				jobNameConditions = append(jobNameConditions, common.ResourceAttributeEquals("service.name", apiServerJobName))
+			}
+
+			if scrapedComponents.coreDNS && !metricpipelineutils.IsControlPlaneCoreDNSInputEnabled(mp.Spec.Input) {
+				jobNameConditions = append(jobNameConditions, common.ResourceAttributeEquals("service.name", coreDNSJobName))
+			}
+
+			if scrapedComponents.kubeProxy && !metricpipelineutils.IsControlPlaneKubeProxyInputEnabled(mp.Spec.Input) {
+				jobNameConditions = append(jobNameConditions, common.ResourceAttributeEquals("service.name", kubeProxyJobName))
+			}
+
+			if len(jobNameConditions) == 0 {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(
+						common.KymaInputNameEquals(common.InputSourceControlPlane),
+						common.JoinWithOr(jobNameConditions...),
+					)},
+				},
+			})
+		},
+	)
+}
+
+// addDropAdditionalRuntimeMetricsProcessor adds a filter processor to drop runtime additional metrics excluding those specified in the pipeline and those related to enabled runtime resource inputs.
+// This is needed because the kubeletStats and k8sCluster receivers emit the union of additional metrics specified in ALL pipelines.
+func (b *Builder) addDropAdditionalRuntimeMetricsProcessor(allAdditionalMetrics []string, pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropRuntimeAdditionalMetricsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsRuntimeInputEnabled(mp.Spec.Input) || len(allAdditionalMetrics) == 0 {
+				return nil
+			}
+
+			additionalMetricsToDrop := additionalMetricsToDrop(allAdditionalMetrics, mp.Spec.Input.Runtime.AdditionalMetrics, mp.Spec.Input)
+
+			if len(additionalMetricsToDrop) == 0 {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(
+						common.KymaInputNameEquals(common.InputSourceRuntime),
+						common.JoinWithOr(nameConditions(additionalMetricsToDrop)...),
+					)},
+				},
+			})
+		},
+	)
+}
+
+// additionalMetricsToDrop determines which additional runtime metrics should be dropped for a given pipeline based on the additionalmetrics specified in the pipeline and the enabled runtime resource inputs.
+func additionalMetricsToDrop(allAdditionalMetrics []string, pipelineAdditionalMetrics []string, metricPipelineInput telemetryv1beta1.MetricPipelineInput) []string {
+	excludedMetrics := pipelineAdditionalMetrics
+
+	if metricpipelineutils.IsRuntimePodInputEnabled(metricPipelineInput) {
+		podMetrics := slices.Concat(k8sClusterReceiverPodMetrics, kubeletStatsReceiverPodMetrics)
+		excludedMetrics = append(excludedMetrics, podMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeContainerInputEnabled(metricPipelineInput) {
+		containerMetrics := slices.Concat(k8sClusterReceiverContainerMetrics, kubeletStatsReceiverContainerMetrics)
+		excludedMetrics = append(excludedMetrics, containerMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeNodeInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, kubeletStatsReceiverNodeMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeVolumeInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, kubeletStatsReceiverVolumeMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeDeploymentInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverDeploymentMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeDaemonSetInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverDaemonSetMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeStatefulSetInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverStatefulSetMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeJobInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverJobMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeReplicaSetInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverReplicaSetMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeCronJobInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverCronJobMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeHorizontalPodAutoscalerInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverHPAMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimePersistentVolumeClaimInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverPVCMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeResourceQuotaInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverResourceQuotaMetrics...)
+	}
+
+	if metricpipelineutils.IsRuntimeNamespaceInputEnabled(metricPipelineInput) {
+		excludedMetrics = append(excludedMetrics, k8sClusterReceiverNamespaceMetrics...)
+	}
+
+	var metricsToDrop []string
+
+	for _, m := range allAdditionalMetrics {
+		if !slices.Contains(excludedMetrics, m) {
+			metricsToDrop = append(metricsToDrop, m)
+		}
+	}
+
+	return metricsToDrop
+}
+
+func getRuntimeAdditionalResourceMetrics(additionalMetrics []string, resourceMetricPattern string) []string {
+	resourceMetricRegex := regexp.MustCompile(resourceMetricPattern)
+
+	var resourceMetrics []string
+
+	for _, m := range additionalMetrics {
+		if resourceMetricRegex.MatchString(m) {
+			resourceMetrics = append(resourceMetrics, m)
+		}
+	}
+
+	return resourceMetrics
+}
+
+// Diagnostic metric filter processors
+
+func (b *Builder) addDropPrometheusDiagnosticMetricsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropPrometheusDiagnosticMetricsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsPrometheusInputEnabled(mp.Spec.Input) || metricpipelineutils.IsPrometheusDiagnosticInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			return dropDiagnosticMetricsFilterProcessor(common.InputSourcePrometheus)
+		},
+	)
+}
+
+func (b *Builder) addDropIstioDiagnosticMetricsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropIstioDiagnosticMetricsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsIstioInputEnabled(mp.Spec.Input) || metricpipelineutils.IsIstioDiagnosticInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			return dropDiagnosticMetricsFilterProcessor(common.InputSourceIstio)
+		},
+	)
+}
+
+func (b *Builder) addDropControlPlaneDiagnosticMetricsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropControlPlaneDiagnosticMetricsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return dropDiagnosticMetricsFilterProcessor(common.InputSourceControlPlane)
+		},
+	)
+}
+
+func dropDiagnosticMetricsFilterProcessor(inputSource common.InputSourceType) *common.FilterProcessorConfig {
+	var filterExpressions []string
+
+	inputSourceCondition := common.KymaInputNameEquals(inputSource)
+	metricNameConditions := nameConditions(diagnosticMetricNames)
+	excludeScrapeMetricsExpr := common.JoinWithAnd(inputSourceCondition, common.JoinWithOr(metricNameConditions...))
+	filterExpressions = append(filterExpressions, excludeScrapeMetricsExpr)
+
+	return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+		{
+			Conditions: filterExpressions,
+		},
+	})
+}
+
+func nameConditions(names []string) []string {
+	var nameConditions []string
+	for _, name := range names {
+		nameConditions = append(nameConditions, common.MetricNameAttributeEquals(name))
+	}
+
+	return nameConditions
+}
+
+// Istio envoy metrics
+
+func (b *Builder) addDropEnvoyMetricsIfDisabledProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropEnvoyMetricsIfDisabledProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if metricpipelineutils.IsIstioInputEnabled(mp.Spec.Input) && metricpipelineutils.IsEnvoyMetricsEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.JoinWithAnd(
+						common.IsMatch("metric.name", "^envoy_.*"),
+						common.KymaInputNameEquals(common.InputSourceIstio),
+					)},
+				},
+			})
+		},
+	)
+}
+
+func (b *Builder) addAggregationDropAttributesProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatAggregationDropAttributesProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationDropAttributesProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addAggregationGroupByAttrsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatAggregationGroupByAttrsProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationGroupByAttrsProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addAggregationMergeSeriesProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatAggregationMergeSeriesProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationMergeSeriesProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addIntervalProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatIntervalProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || mp.Spec.Aggregation.Interval == nil {
+				return nil
+			}
+
+			return common.IntervalProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addDropExemplarsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropExemplarsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsExemplarsDropped(mp.Spec.Output) {
+				return nil
+			}
+
+			return common.MetricTransformProcessor(common.DropExemplarsProcessorStatements())
+		},
+	)
+}
+
+// addCumulativeToDeltaProcessor adds the cumulativetodelta processor, which is shared by all output pipelines.
+// The staleness is derived from the longest collection or aggregation interval, so that no series expires between two data points.
+func (b *Builder) addCumulativeToDeltaProcessor(maxCollectionInterval time.Duration) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDCumulativeToDeltaProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if metricpipelineutils.IsDeltaTemporality(mp.Spec.Output) {
+				return &common.CumulativeToDeltaProcessorConfig{
+					MaxStaleness: maxStalenessMultiplier * maxCollectionInterval,
+					InitialValue: "auto",
+				}
+			}
+
+			return nil
 		},
 	)
 }
@@ -1057,6 +1640,10 @@ func (b *Builder) addOTLPExporter(queueSize int) buildComponentFunc {
 	return b.AddExporter(
 		formatOTLPExporterID,
 		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			if mp.Spec.Output.OTLP == nil {
+				return nil, nil, nil
+			}
+
 			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
 				b.Reader,
 				&mp.Spec.Output.OTLP.OTLPOutput,
@@ -1069,6 +1656,80 @@ func (b *Builder) addOTLPExporter(queueSize int) buildComponentFunc {
 	)
 }
 
+func (b *Builder) addPrometheusExporter() buildComponentFunc {
+	return b.AddExporter(
+		formatPrometheusExporterID,
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			if mp.Spec.Output.Prometheus == nil {
+				return nil, nil, nil
+			}
+
+			return common.PrometheusExporter(mp.Spec.Output.Prometheus), nil, nil
+		},
+	)
+}
+
+// Scrape health builders
+
+func (b *Builder) addKeepScrapeHealthMetricsProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDKeepScrapeHealthMetricsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.Not(common.JoinWithOr(nameConditions(scrapeHealthMetricNames)...))},
+				},
+			})
+		},
+	)
+}
+
+func (b *Builder) addSetScrapeHealthAttributesProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDSetScrapeHealthAttributesProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return setScrapeHealthAttributesProcessor()
+		},
+	)
+}
+
+// setScrapeHealthAttributesProcessor sets the workload of the scrape target and removes all other resource attributes,
+// so that the scrape health metrics have a low cardinality and can be aggregated by namespace and workload.
+// The instance of the target is kept to distinguish the targets of the same workload.
+func setScrapeHealthAttributesProcessor() *common.TransformProcessorConfig {
+	var statements []string
+
+	for _, attr := range workloadNameAttributes {
+		statements = append(statements, common.JoinWithWhere(
+			fmt.Sprintf("set(%s, %s)", common.ResourceAttribute(k8sWorkloadName), common.ResourceAttribute(attr)),
+			common.IsNil(common.ResourceAttribute(k8sWorkloadName)),
+		))
+	}
+
+	statements = append(statements, fmt.Sprintf("keep_keys(resource.attributes, [\"%s\", \"%s\", \"service.instance.id\"])", common.K8sNamespaceName, k8sWorkloadName))
+
+	return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
+		Statements: statements,
+	}})
+}
+
+// addScrapeHealthExporter exposes the scrape health metrics to the self-monitor. The metrics of vanished targets expire after a few missed scrapes.
+func (b *Builder) addScrapeHealthExporter(maxCollectionInterval time.Duration) buildComponentFunc {
+	return b.AddExporter(
+		b.StaticComponentID(common.ComponentIDScrapeHealthExporter),
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			return &common.PrometheusExporterConfig{
+				Endpoint:  fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, ports.ScrapeHealth),
+				Namespace: scrapeHealthMetricsNamespace,
+				ResourceToTelemetryConversion: common.ResourceToTelemetryConversion{
+					Enabled: true,
+				},
+				MetricExpiration: maxStalenessMultiplier * maxCollectionInterval,
+			}, nil, nil
+		},
+	)
+}
+
 // Connector builders
 
 func (b *Builder) addExporterForInputRouter(componentID string, outputPipelines []telemetryv1beta1.MetricPipeline) buildComponentFunc {
@@ -1093,42 +1754,56 @@ func (b *Builder) addReceiverForInputRouter(componentID string, outputPipelines
 	)
 }
 
-func (b *Builder) addExporterForEnrichmentRouter(runtimePipelines, prometheusPipelines, istioPipelines []telemetryv1beta1.MetricPipeline) buildComponentFunc {
+// The forward connector sends the control plane metrics to all output pipelines that receive from it.
+func (b *Builder) addExporterForControlPlaneInputForwarder() buildComponentFunc {
 	return b.AddExporter(
-		b.StaticComponentID(common.ComponentIDEnrichmentRoutingConnector),
+		b.StaticComponentID(common.ComponentIDControlPlaneInputConnector),
 		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
-			return enrichmentRoutingConnector(runtimePipelines, prometheusPipelines, istioPipelines), nil, nil
+			return &common.ForwardConnectorConfig{}, nil, nil
 		},
 	)
 }
 
-func (b *Builder) addReceiverForEnrichmentRouter(runtimePipelines, prometheusPipelines, istioPipelines []telemetryv1beta1.MetricPipeline) buildComponentFunc {
+func (b *Builder) addReceiverForControlPlaneInputForwarder(inputEnabled bool) buildComponentFunc {
 	return b.AddReceiver(
-		b.StaticComponentID(common.ComponentIDEnrichmentRoutingConnector),
+		b.StaticComponentID(common.ComponentIDControlPlaneInputConnector),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			if len(runtimePipelines) == 0 && len(prometheusPipelines) == 0 && len(istioPipelines) == 0 {
+			if !inputEnabled {
 				return nil
 			}
 
-			return enrichmentRoutingConnector(runtimePipelines, prometheusPipelines, istioPipelines)
+			return &common.ForwardConnectorConfig{}
 		},
 	)
 }
 
-func enrichmentRoutingConnector(runtimePipelines, prometheusPipelines, istioPipelines []telemetryv1beta1.MetricPipeline) common.RoutingConnectorConfig {
-	tableEntries := []common.RoutingConnectorTableEntry{}
+func (b *Builder) addExporterForEnrichmentRouter(runtimeGroups, prometheusGroups, istioGroups scrapeGroups) buildComponentFunc {
+	return b.AddExporter(
+		b.StaticComponentID(common.ComponentIDEnrichmentRoutingConnector),
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			return enrichmentRoutingConnector(runtimeGroups, prometheusGroups, istioGroups), nil, nil
+		},
+	)
+}
 
-	if len(runtimePipelines) > 0 {
-		tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntry(runtimePipelines, common.KymaInputNameEquals(common.InputSourceRuntime)))
-	}
+func (b *Builder) addReceiverForEnrichmentRouter(runtimeGroups, prometheusGroups, istioGroups scrapeGroups) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(common.ComponentIDEnrichmentRoutingConnector),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if len(runtimeGroups) == 0 && len(prometheusGroups) == 0 && len(istioGroups) == 0 {
+				return nil
+			}
 
-	if len(prometheusPipelines) > 0 {
-		tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntry(prometheusPipelines, common.KymaInputNameEquals(common.InputSourcePrometheus)))
-	}
+			return enrichmentRoutingConnector(runtimeGroups, prometheusGroups, istioGroups)
+		},
+	)
+}
 
-	if len(istioPipelines) > 0 {
-		tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntry(istioPipelines, common.KymaInputNameEquals(common.InputSourceIstio)))
-	}
+func enrichmentRoutingConnector(runtimeGroups, prometheusGroups, istioGroups scrapeGroups) common.RoutingConnectorConfig {
+	tableEntries := []common.RoutingConnectorTableEntry{}
+	tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntries(runtimeGroups, common.InputSourceRuntime)...)
+	tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntries(prometheusGroups, common.InputSourcePrometheus)...)
+	tableEntries = append(tableEntries, enrichmentRoutingConnectorTableEntries(istioGroups, common.InputSourceIstio)...)
 
 	return common.RoutingConnectorConfig{
 		ErrorMode: "ignore",
@@ -1136,12 +1811,30 @@ func enrichmentRoutingConnector(runtimePipelines, prometheusPipelines, istioPipe
 	}
 }
 
-func enrichmentRoutingConnectorTableEntry(pipelines []telemetryv1beta1.MetricPipeline, routingCondition string) common.RoutingConnectorTableEntry {
-	return common.RoutingConnectorTableEntry{
-		Context:   "metric",
-		Statement: fmt.Sprintf("route() where %s", routingCondition),
-		Pipelines: formatOutputPipelineIDs(pipelines),
+// enrichmentRoutingConnectorTableEntries creates one routing table entry per scrape group of an input.
+// If an input has multiple groups, the groups are distinguished by the scrape group attribute, which is set for all but the default group.
+func enrichmentRoutingConnectorTableEntries(groups scrapeGroups, inputSource common.InputSourceType) []common.RoutingConnectorTableEntry {
+	var tableEntries []common.RoutingConnectorTableEntry
+
+	for _, group := range groups {
+		routingCondition := common.KymaInputNameEquals(inputSource)
+
+		if len(groups) > 1 {
+			if group.isDefault() {
+				routingCondition = common.JoinWithAnd(routingCondition, common.IsNil(common.ResourceAttribute(common.KymaInputScrapeGroupAttribute)))
+			} else {
+				routingCondition = common.JoinWithAnd(routingCondition, common.ResourceAttributeEquals(common.KymaInputScrapeGroupAttribute, group.suffix))
+			}
+		}
+
+		tableEntries = append(tableEntries, common.RoutingConnectorTableEntry{
+			Context:   "metric",
+			Statement: fmt.Sprintf("route() where %s", routingCondition),
+			Pipelines: formatOutputPipelineIDs(group.pipelines),
+		})
 	}
+
+	return tableEntries
 }
 
 func inputRoutingConnector(outputPipelineIDs []string) common.RoutingConnectorConfig {
@@ -1195,6 +1888,10 @@ func formatOTLPExporterID(pipeline *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDOTLPExporter(pipeline.Spec.Output.OTLP.Protocol, pipelines.MetricPipelineRef(pipeline))
 }
 
+func formatPrometheusExporterID(pipeline *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDPrometheusExporter(pipelines.MetricPipelineRef(pipeline))
+}
+
 func formatNamespaceFilterID(pipelineName string, inputSourceType common.InputSourceType) string {
 	return common.ComponentIDNamespacePerInputFilterProcessor(pipelineName, inputSourceType)
 }
@@ -1242,6 +1939,26 @@ func getPipelinesWithIstioInput(pipelines []telemetryv1beta1.MetricPipeline) []t
 
 // Helper functions for determining what should be enabled
 
+func getRuntimeResourceSources(pipelines []telemetryv1beta1.MetricPipeline) runtimeResourceSources {
+	return runtimeResourceSources{
+		pod:           shouldEnableRuntimePodMetricsScraping(pipelines),
+		container:     shouldEnableRuntimeContainerMetricsScraping(pipelines),
+		node:          shouldEnableRuntimeNodeMetricsScraping(pipelines),
+		volume:        shouldEnableRuntimeVolumeMetricsScraping(pipelines),
+		statefulset:   shouldEnableRuntimeStatefulSetMetricsScraping(pipelines),
+		deployment:    shouldEnableRuntimeDeploymentMetricsScraping(pipelines),
+		daemonset:     shouldEnableRuntimeDaemonSetMetricsScraping(pipelines),
+		job:           shouldEnableRuntimeJobMetricsScraping(pipelines),
+		replicaset:    shouldEnableRuntimeReplicaSetMetricsScraping(pipelines),
+		cronjob:       shouldEnableRuntimeCronJobMetricsScraping(pipelines),
+		hpa:           shouldEnableRuntimeHorizontalPodAutoscalerMetricsScraping(pipelines),
+		pvc:           shouldEnableRuntimePersistentVolumeClaimMetricsScraping(pipelines),
+		resourcequota: shouldEnableRuntimeResourceQuotaMetricsScraping(pipelines),
+		namespace:     shouldEnableRuntimeNamespaceMetricsScraping(pipelines),
+		host:          shouldEnableRuntimeHostMetricsScraping(pipelines),
+	}
+}
+
 func shouldEnableRuntimeMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
 	for i := range pipelines {
 		input := pipelines[i].Spec.Input
@@ -1341,6 +2058,83 @@ func shouldEnableRuntimeJobMetricsScraping(pipelines []telemetryv1beta1.MetricPi
 	return false
 }
 
+func shouldEnableRuntimeReplicaSetMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeReplicaSetInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimeCronJobMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeCronJobInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimeHorizontalPodAutoscalerMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeHorizontalPodAutoscalerInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimePersistentVolumeClaimMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimePersistentVolumeClaimInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimeResourceQuotaMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeResourceQuotaInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimeNamespaceMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeNamespaceInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableRuntimeHostMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeHostInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func shouldEnablePrometheusMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
 	for i := range pipelines {
 		input := pipelines[i].Spec.Input
@@ -1374,12 +2168,49 @@ func shouldEnableEnvoyMetricsScraping(pipelines []telemetryv1beta1.MetricPipelin
 	return false
 }
 
-func shouldFilterByNamespace(namespaceSelector *telemetryv1beta1.NamespaceSelector) bool {
-	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
+func shouldEnableControlPlaneAPIServerMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsControlPlaneInputEnabled(input) && metricpipelineutils.IsControlPlaneAPIServerInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableControlPlaneCoreDNSMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsControlPlaneInputEnabled(input) && metricpipelineutils.IsControlPlaneCoreDNSInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func shouldEnableControlPlaneKubeProxyMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsControlPlaneInputEnabled(input) && metricpipelineutils.IsControlPlaneKubeProxyInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Builder) addServiceAccountTokenAuthExtension(pipeline *telemetryv1beta1.MetricPipeline) {
+	b.AddExtension(
+		common.ComponentIDServiceAccountTokenAuthExtension(pipelines.MetricPipelineRef(pipeline)),
+		common.ServiceAccountTokenAuthExtensionConfig(pipeline.Spec.Output.OTLP.Authentication.ServiceAccountToken),
+		nil,
+	)
 }
 
 func shouldEnableOAuth2(tp *telemetryv1beta1.MetricPipeline) bool {
-	return tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+	return tp.Spec.Output.OTLP != nil && tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
 }
 
 func getRuntimeAdditionalMetrics(pipelines []telemetryv1beta1.MetricPipeline) ([]string, []string) {
@@ -1414,6 +2245,18 @@ func getRuntimeAdditionalMetrics(pipelines []telemetryv1beta1.MetricPipeline) ([
 	return k8sClusterAdditionalMetrics, kubeletStatsAdditionalMetrics
 }
 
+func maxAggregationInterval(pipelines []telemetryv1beta1.MetricPipeline) time.Duration {
+	var result time.Duration
+
+	for i := range pipelines {
+		if aggregation := pipelines[i].Spec.Aggregation; aggregation != nil && aggregation.Interval != nil {
+			result = max(result, aggregation.Interval.Duration)
+		}
+	}
+
+	return result
+}
+
 // Processor configuration functions (merged from processors.go)
 
 func dropServiceNameProcessor() *common.TransformProcessorConfig {
@@ -1426,21 +2269,41 @@ func dropServiceNameProcessor() *common.TransformProcessorConfig {
 	)
 }
 
-func insertSkipEnrichmentAttributeProcessor() *common.TransformProcessorConfig {
+func insertSkipEnrichmentAttributeProcessor(runtimeResources runtimeResourceSources) *common.TransformProcessorConfig {
 	metricsToSkipEnrichment := []string{
 		"node",
 		"statefulset",
 		"daemonset",
 		"deployment",
 		"job",
+		"replicaset",
+		"cronjob",
+		"hpa",
+		"persistentvolumeclaim",
+		"resource_quota",
+		"namespace",
+	}
+
+	conditions := metricNameConditionsWithIsMatch(metricsToSkipEnrichment)
+
+	// Host metrics describe the Node and must not be enriched with Pod metadata
+	if runtimeResources.host {
+		conditions = append(conditions, common.IsMatch("metric.name", hostMetricPattern))
 	}
 
 	return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
-		Conditions: metricNameConditionsWithIsMatch(metricsToSkipEnrichment),
+		Conditions: conditions,
 		Statements: []string{fmt.Sprintf("set(resource.attributes[\"%s\"], \"true\")", common.SkipEnrichmentAttribute)},
 	}})
 }
 
+func insertHostNodeNameProcessor() *common.TransformProcessorConfig {
+	return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
+		Conditions: []string{common.IsMatch("metric.name", hostMetricPattern)},
+		Statements: []string{fmt.Sprintf("set(resource.attributes[\"k8s.node.name\"], \"${%s}\")", common.EnvVarCurrentNodeName)},
+	}})
+}
+
 func dropNonPVCVolumesMetricsProcessor() *common.FilterProcessorConfig {
 	return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
 		{
@@ -1479,6 +2342,10 @@ func metricNameConditionsWithIsMatch(metrics []string) []string {
 	return conditions
 }
 
+func formatRedactionProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.MetricPipelineRef(mp))
+}
+
 func formatUserDefinedTransformProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.MetricPipelineRef(mp))
 }
@@ -1486,3 +2353,19 @@ func formatUserDefinedTransformProcessorID(mp *telemetryv1beta1.MetricPipeline)
 func formatUserDefinedFilterProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.MetricPipelineRef(mp))
 }
+
+func formatAggregationDropAttributesProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationDropAttributesProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatAggregationGroupByAttrsProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationGroupByAttrsProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatAggregationMergeSeriesProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationMergeSeriesProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatIntervalProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDIntervalProcessor(pipelines.MetricPipelineRef(mp))
+}
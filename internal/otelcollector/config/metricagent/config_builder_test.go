@@ -4,9 +4,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -45,6 +47,28 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:              "suspended pipeline is left out",
+			goldenFileName:    "service-enrichment-otel.yaml",
+			serviceEnrichment: commonresources.AnnotationValueTelemetryServiceEnrichmentOtel,
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithPrometheusInput(false).
+					WithIstioInput(false).
+					WithMetricPipelineOTLPOutput().
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("suspended").
+					WithRuntimeInput(true).
+					WithPrometheusInput(true).
+					WithIstioInput(true).
+					WithMetricPipelineOTLPOutput().
+					WithSuspend(true).
+					Build(),
+			},
+		},
 		{
 			name:           "pipeline with runtime input only and VPA is active",
 			goldenFileName: "vpa-active.yaml",
@@ -201,6 +225,12 @@ func TestBuildConfig(t *testing.T) {
 					WithRuntimeInputDeploymentMetrics(true).
 					WithRuntimeInputDaemonSetMetrics(true).
 					WithRuntimeInputJobMetrics(true).
+					WithRuntimeInputReplicaSetMetrics(true).
+					WithRuntimeInputCronJobMetrics(true).
+					WithRuntimeInputHorizontalPodAutoscalerMetrics(true).
+					WithRuntimeInputPersistentVolumeClaimMetrics(true).
+					WithRuntimeInputResourceQuotaMetrics(true).
+					WithRuntimeInputNamespaceMetrics(true).
 					WithPrometheusInput(false).
 					WithIstioInput(false).
 					Build(),
@@ -244,6 +274,103 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "pipelines with extended runtime input resources enabled in one pipeline",
+			goldenFileName: "runtime-resources-extended.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithRuntimeInputReplicaSetMetrics(true).
+					WithRuntimeInputCronJobMetrics(true).
+					WithRuntimeInputHorizontalPodAutoscalerMetrics(true).
+					WithRuntimeInputPersistentVolumeClaimMetrics(true).
+					WithRuntimeInputResourceQuotaMetrics(true).
+					WithRuntimeInputNamespaceMetrics(true).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(true).
+					WithRuntimeInputAdditionalMetrics(
+						// a k8scluster replicaset metric
+						"k8s.replicaset.available",
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with host metrics enabled in one pipeline",
+			goldenFileName: "runtime-host-metrics.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithRuntimeInputHostMetrics(true).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(true).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with collection interval overrides",
+			goldenFileName: "collection-interval-per-pipeline.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("alerting").
+					WithRuntimeInput(true).
+					WithRuntimeInputCollectionInterval(15 * time.Second).
+					WithPrometheusInput(true).
+					WithPrometheusInputCollectionInterval(15 * time.Second).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("cost-sensitive").
+					WithRuntimeInput(true).
+					WithRuntimeInputCollectionInterval(2 * time.Minute).
+					WithIstioInput(true).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("default").
+					WithRuntimeInput(true).
+					WithPrometheusInput(true).
+					WithIstioInput(true).
+					WithIstioInputCollectionInterval(30 * time.Second).
+					Build(),
+			},
+		},
+		{
+			name:           "pipeline with control plane input only",
+			goldenFileName: "control-plane-only.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test").
+					WithControlPlaneInput(true).
+					WithMetricPipelineOTLPOutput().
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with control plane components disabled in one pipeline",
+			goldenFileName: "control-plane-components.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithControlPlaneInput(true).
+					WithControlPlaneInputCoreDNSMetrics(false).
+					WithControlPlaneInputKubeProxyMetrics(false).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithControlPlaneInput(true).
+					WithControlPlaneInputKubeProxyMetrics(false).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test3").
+					WithRuntimeInput(true).
+					Build(),
+			},
+		},
 		{
 			name:           "pipelines with runtime additional metrics",
 			goldenFileName: "runtime-additional-metrics.yaml",
@@ -431,6 +558,21 @@ func TestBuildConfig(t *testing.T) {
 					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://bar")).Build(),
 			},
 		},
+		{
+			name:           "pipeline with redaction",
+			goldenFileName: "redaction.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithRedaction(telemetryv1beta1.Redaction{
+						Presets:           []telemetryv1beta1.RedactionPreset{telemetryv1beta1.RedactionPresetIBAN},
+						AllowedAttributes: []string{"k8s.pod.phase"},
+					}).
+					Build(),
+			},
+		},
 		{
 			name:           "two pipelines with user-defined transforms",
 			goldenFileName: "user-defined-transforms.yaml",
@@ -533,6 +675,86 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "pipeline with aggregation",
+			goldenFileName: "aggregation.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithPrometheusInput(false).
+					WithIstioInput(false).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithTemporality(telemetryv1beta1.TemporalityDelta).
+					WithAggregation(telemetryv1beta1.MetricPipelineAggregation{
+						Interval:       &metav1.Duration{Duration: 2 * time.Minute},
+						DropAttributes: []string{"k8s.container.name", "container.id"},
+					}).
+					Build(),
+			},
+		},
+		{
+			name:           "pipeline with prometheus output",
+			goldenFileName: "prometheus-output.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithPrometheusInput(false).
+					WithIstioInput(false).
+					WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{
+						ResourceToLabels: &telemetryv1beta1.MetricPipelinePrometheusOutputResourceToLabels{Enabled: new(false)},
+						MetricExpiration: &metav1.Duration{Duration: 10 * time.Minute},
+					}).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with native histogram scraping",
+			goldenFileName: "native-histograms.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(false).
+					WithPrometheusInput(true).
+					WithPrometheusInputNativeHistograms(true).
+					WithIstioInput(false).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://backend1.example.com")).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(false).
+					WithPrometheusInput(true).
+					WithIstioInput(false).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://backend2.example.com")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with exemplars dropped and preserved",
+			goldenFileName: "exemplars.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(false).
+					WithPrometheusInput(true).
+					WithIstioInput(false).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://backend1.example.com")).
+					WithExemplars(telemetryv1beta1.ExemplarsDrop).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(false).
+					WithPrometheusInput(true).
+					WithIstioInput(false).
+					WithTransform(telemetryv1beta1.TransformSpec{
+						Statements: []string{"set(datapoint.attributes[\"test\"], \"passed\")"},
+					}).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://backend2.example.com")).
+					WithExemplars(telemetryv1beta1.ExemplarsPreserve).
+					Build(),
+			},
+		},
 		{
 			name:           "multiple pipelines with delta temporality",
 			goldenFileName: "delta-temporality-multiple-pipelines.yaml",
@@ -583,6 +805,7 @@ func TestBuildConfig(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			buildOptions := BuildOptions{
 				IstioCertPath:               "/etc/istio-output-certs",
+				HostRootPath:                "/hostfs",
 				InstrumentationScopeVersion: "main",
 				IstioActive:                 tt.istioActive,
 				ServiceEnrichment:           tt.serviceEnrichment,
@@ -0,0 +1,236 @@
+package metricagent
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	apiServerJobName = "kube-apiserver"
+	coreDNSJobName   = "coredns"
+	kubeProxyJobName = "kube-proxy"
+
+	kubeSystemNamespace = "kube-system"
+	kubeProxyMetricPort = "10249"
+	coreDNSMetricPort   = "9153"
+
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec // path to the mounted token, not a credential
+
+	// apiServerAdvertiseAddressLabel is the annotation kubeadm.kubernetes.io/kube-apiserver.advertise-address.endpoint of the API server Pods, which holds the address the API server serves on.
+	apiServerAdvertiseAddressLabel = "__meta_kubernetes_pod_annotation_kubeadm_kubernetes_io_kube_apiserver_advertise_address_endpoint"
+	serviceAccountCAFile           = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// The control plane components expose several thousand series each. Only a curated set of metrics is kept to keep the volume low.
+// Histograms are reduced to their sum and count series, because the buckets make up most of the series.
+var (
+	processMetrics = []string{
+		"process_cpu_seconds_total",
+		"process_resident_memory_bytes",
+	}
+
+	apiServerMetrics = []string{
+		"apiserver_request_total",
+		"apiserver_request_duration_seconds_sum",
+		"apiserver_request_duration_seconds_count",
+		"apiserver_current_inflight_requests",
+		"apiserver_longrunning_requests",
+		"apiserver_storage_objects",
+		"apiserver_admission_webhook_rejection_count",
+		"apiserver_admission_webhook_admission_duration_seconds_sum",
+		"apiserver_admission_webhook_admission_duration_seconds_count",
+	}
+
+	coreDNSMetrics = []string{
+		"coredns_dns_requests_total",
+		"coredns_dns_responses_total",
+		"coredns_dns_request_duration_seconds_sum",
+		"coredns_dns_request_duration_seconds_count",
+		"coredns_cache_entries",
+		"coredns_cache_hits_total",
+		"coredns_cache_misses_total",
+		"coredns_forward_requests_total",
+		"coredns_forward_responses_total",
+		"coredns_panics_total",
+	}
+
+	kubeProxyMetrics = []string{
+		"kubeproxy_sync_proxy_rules_duration_seconds_sum",
+		"kubeproxy_sync_proxy_rules_duration_seconds_count",
+		"kubeproxy_sync_proxy_rules_last_timestamp_seconds",
+		"kubeproxy_sync_proxy_rules_endpoint_changes_total",
+		"kubeproxy_sync_proxy_rules_service_changes_total",
+		"kubeproxy_network_programming_duration_seconds_sum",
+		"kubeproxy_network_programming_duration_seconds_count",
+	}
+)
+
+// prometheusControlPlaneReceiverConfig creates a Prometheus configuration for scraping the Kubernetes API server, CoreDNS, and kube-proxy.
+// The Prometheus receiver does not support leader election, so every Metric Agent instance scrapes only the Pods on its own Node.
+// This way, each component instance is scraped exactly once.
+func prometheusControlPlaneReceiverConfig(components controlPlaneComponentSources, collectionInterval time.Duration) *PrometheusReceiverConfig {
+	var config PrometheusReceiverConfig
+
+	if components.apiServer {
+		config.Prometheus.ScrapeConfigs = append(config.Prometheus.ScrapeConfigs, apiServerScrapeConfig(collectionInterval))
+	}
+
+	if components.coreDNS {
+		config.Prometheus.ScrapeConfigs = append(config.Prometheus.ScrapeConfigs, coreDNSScrapeConfig(collectionInterval))
+	}
+
+	if components.kubeProxy {
+		config.Prometheus.ScrapeConfigs = append(config.Prometheus.ScrapeConfigs, kubeProxyScrapeConfig(collectionInterval))
+	}
+
+	return &config
+}
+
+// apiServerScrapeConfig scrapes the API server Pods in the kube-system namespace, which are set up by kubeadm as static Pods.
+// The address is taken from the advertise address annotation, because the API server Pods run in the host network and do not declare their port.
+// The Metric Agent authenticates with its service account token, which is authorized for the /metrics non-resource URL.
+func apiServerScrapeConfig(collectionInterval time.Duration) Scrape {
+	return Scrape{
+		JobName:                    apiServerJobName,
+		SampleLimit:                SampleLimit,
+		BodySizeLimit:              bodySizeLimit,
+		ScrapeInterval:             collectionInterval,
+		Scheme:                     "https",
+		KubernetesDiscoveryConfigs: kubeSystemDiscoveryConfigWithNodeSelector(),
+		RelabelConfigs: []Relabel{
+			keepIfRunningOnSameNode(NodeAffiliatedPod),
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_label_component"},
+				Regex:        apiServerJobName,
+				Action:       Keep,
+			},
+			{
+				SourceLabels: []string{apiServerAdvertiseAddressLabel},
+				Regex:        "(.+)",
+				Action:       Keep,
+			},
+			dropIfPodNotRunning(),
+			{
+				SourceLabels: []string{apiServerAdvertiseAddressLabel},
+				Regex:        "(.+)",
+				Replacement:  "$$1",
+				TargetLabel:  "__address__",
+				Action:       Replace,
+			},
+			inferPodFromMetaLabel(),
+			inferNodeFromMetaLabel(),
+		},
+		MetricRelabelConfigs: []Relabel{
+			keepMetricNames(apiServerMetrics),
+		},
+		TLS: &TLS{
+			CAFile: serviceAccountCAFile,
+		},
+		Authorization: &Authorization{
+			CredentialsFile: serviceAccountTokenFile,
+		},
+	}
+}
+
+// coreDNSScrapeConfig scrapes the CoreDNS Pods in the kube-system namespace on the metrics port of the prometheus plugin.
+func coreDNSScrapeConfig(collectionInterval time.Duration) Scrape {
+	return Scrape{
+		JobName:                    coreDNSJobName,
+		SampleLimit:                SampleLimit,
+		BodySizeLimit:              bodySizeLimit,
+		ScrapeInterval:             collectionInterval,
+		KubernetesDiscoveryConfigs: kubeSystemDiscoveryConfigWithNodeSelector(),
+		RelabelConfigs: []Relabel{
+			keepIfRunningOnSameNode(NodeAffiliatedPod),
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_label_k8s_app"},
+				Regex:        "kube-dns",
+				Action:       Keep,
+			},
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_container_port_number"},
+				Regex:        coreDNSMetricPort,
+				Action:       Keep,
+			},
+			dropIfPodNotRunning(),
+			inferPodFromMetaLabel(),
+			inferNodeFromMetaLabel(),
+		},
+		MetricRelabelConfigs: []Relabel{
+			keepMetricNames(coreDNSMetrics),
+		},
+	}
+}
+
+// kubeProxyScrapeConfig scrapes the kube-proxy Pods in the kube-system namespace.
+// kube-proxy runs in the host network and does not declare its metrics port, so the address is derived from the Pod IP.
+// Both the upstream (k8s-app=kube-proxy) and the Gardener (role=proxy) labeling of the kube-proxy Pods are supported.
+func kubeProxyScrapeConfig(collectionInterval time.Duration) Scrape {
+	return Scrape{
+		JobName:                    kubeProxyJobName,
+		SampleLimit:                SampleLimit,
+		BodySizeLimit:              bodySizeLimit,
+		ScrapeInterval:             collectionInterval,
+		KubernetesDiscoveryConfigs: kubeSystemDiscoveryConfigWithNodeSelector(),
+		RelabelConfigs: []Relabel{
+			keepIfRunningOnSameNode(NodeAffiliatedPod),
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_label_k8s_app", "__meta_kubernetes_pod_label_role"},
+				Regex:        "kube-proxy;.*|.*;proxy",
+				Action:       Keep,
+			},
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_container_name"},
+				Regex:        "kube-proxy",
+				Action:       Keep,
+			},
+			dropIfPodNotRunning(),
+			dropIfInitContainer(),
+			{
+				SourceLabels: []string{"__meta_kubernetes_pod_ip"},
+				Regex:        "(.+)",
+				Replacement:  "$$1:" + kubeProxyMetricPort,
+				TargetLabel:  "__address__",
+				Action:       Replace,
+			},
+			inferPodFromMetaLabel(),
+			inferNodeFromMetaLabel(),
+		},
+		MetricRelabelConfigs: []Relabel{
+			keepMetricNames(kubeProxyMetrics),
+		},
+	}
+}
+
+// kubeSystemDiscoveryConfigWithNodeSelector discovers the Pods in the kube-system namespace, which run on the Node of the Metric Agent instance.
+func kubeSystemDiscoveryConfigWithNodeSelector() []KubernetesDiscovery {
+	discovery := discoveryConfigWithNodeSelector(RolePod)
+	discovery[0].Namespaces = &K8SDiscoveryNamespaces{Names: []string{kubeSystemNamespace}}
+
+	return discovery
+}
+
+func keepMetricNames(componentMetrics []string) Relabel {
+	return Relabel{
+		SourceLabels: []string{"__name__"},
+		Regex:        strings.Join(slices.Concat(componentMetrics, processMetrics), "|"),
+		Action:       Keep,
+	}
+}
+
+func inferPodFromMetaLabel() Relabel {
+	return Relabel{
+		SourceLabels: []string{"__meta_kubernetes_pod_name"},
+		Action:       Replace,
+		TargetLabel:  "pod",
+	}
+}
+
+func inferNodeFromMetaLabel() Relabel {
+	return Relabel{
+		SourceLabels: []string{"__meta_kubernetes_pod_node_name"},
+		Action:       Replace,
+		TargetLabel:  "node",
+	}
+}
@@ -0,0 +1,70 @@
+package metricagent
+
+import "time"
+
+// hostMetricsExcludedFSTypes contains virtual and container filesystem types that do not reflect the disk usage of the Node.
+var hostMetricsExcludedFSTypes = []string{
+	"autofs",
+	"binfmt_misc",
+	"bpf",
+	"cgroup",
+	"cgroup2",
+	"configfs",
+	"debugfs",
+	"devpts",
+	"devtmpfs",
+	"fusectl",
+	"hugetlbfs",
+	"iso9660",
+	"mqueue",
+	"nsfs",
+	"overlay",
+	"proc",
+	"procfs",
+	"pstore",
+	"rpc_pipefs",
+	"securityfs",
+	"selinuxfs",
+	"squashfs",
+	"sysfs",
+	"tmpfs",
+	"tracefs",
+}
+
+// hostMetricsExcludedMountPoints contains mount points of pseudo filesystems and of volumes managed by the container runtime or the kubelet.
+// Volume metrics are already collected by the kubeletstats receiver.
+var hostMetricsExcludedMountPoints = []string{
+	"^/dev($|/)",
+	"^/proc($|/)",
+	"^/sys($|/)",
+	"^/run/containerd($|/)",
+	"^/var/lib/containerd($|/)",
+	"^/var/lib/kubelet($|/)",
+	"^/snap($|/)",
+}
+
+// hostMetricsReceiver configures the hostmetrics receiver to read the Node metrics from the mounted root filesystem of the Node.
+// The network scraper is not enabled, because the Metric Agent does not run in the host network namespace,
+// so it would report the network statistics of the Metric Agent Pod instead of the Node.
+func hostMetricsReceiver(rootPath string, collectionInterval time.Duration) *HostMetricsReceiverConfig {
+	return &HostMetricsReceiverConfig{
+		CollectionInterval: collectionInterval.String(),
+		RootPath:           rootPath,
+		Scrapers: HostMetricsScrapers{
+			CPU:    &HostMetricsScraper{},
+			Memory: &HostMetricsScraper{},
+			Load:   &HostMetricsScraper{},
+			Disk:   &HostMetricsScraper{},
+			Filesystem: &HostMetricsFilesystemScraper{
+				ExcludeFSTypes: HostMetricsFilter{
+					FSTypes:   hostMetricsExcludedFSTypes,
+					MatchType: "strict",
+				},
+				ExcludeMountPoints: HostMetricsFilter{
+					MountPoints: hostMetricsExcludedMountPoints,
+					MatchType:   "regexp",
+				},
+			},
+		},
+	}
+}
@@ -103,6 +103,43 @@ var k8sClusterReceiverDaemonSetMetrics = []string{
 	metricK8sDaemonSetReadyNodes,
 }
 
+// k8sClusterReceiverReplicaSetMetrics contains metrics related to replicaset resources.
+var k8sClusterReceiverReplicaSetMetrics = []string{
+	metricK8sReplicaSetAvailable,
+	metricK8sReplicaSetDesired,
+}
+
+// k8sClusterReceiverCronJobMetrics contains metrics related to cronjob resources.
+var k8sClusterReceiverCronJobMetrics = []string{
+	metricK8sCronJobActiveJobs,
+}
+
+// k8sClusterReceiverHPAMetrics contains metrics related to horizontalpodautoscaler resources.
+var k8sClusterReceiverHPAMetrics = []string{
+	metricK8sHPACurrentReplicas,
+	metricK8sHPADesiredReplicas,
+	metricK8sHPAMinReplicas,
+	metricK8sHPAMaxReplicas,
+}
+
+// k8sClusterReceiverPVCMetrics contains metrics related to persistentvolumeclaim resources.
+var k8sClusterReceiverPVCMetrics = []string{
+	metricK8sPersistentVolumeClaimStatusPhase,
+	metricK8sPersistentVolumeClaimStorageCapacity,
+	metricK8sPersistentVolumeClaimStorageRequest,
+}
+
+// k8sClusterReceiverResourceQuotaMetrics contains metrics related to resourcequota resources.
+var k8sClusterReceiverResourceQuotaMetrics = []string{
+	metricK8sResourceQuotaHardLimit,
+	metricK8sResourceQuotaUsed,
+}
+
+// k8sClusterReceiverNamespaceMetrics contains metrics related to namespace resources.
+var k8sClusterReceiverNamespaceMetrics = []string{
+	metricK8sNamespacePhase,
+}
+
 // k8sClusterReceiverExtraMetrics contains metrics that are disabled by default and optional metrics.
 var k8sClusterReceiverExtraMetrics = []string{
 	// Upstream default metrics that are disabled by default in the k8sCluster receiver
@@ -111,18 +148,8 @@ var k8sClusterReceiverExtraMetrics = []string{
 	metricK8sContainerEphemeralStorageRequest,
 	metricK8sContainerEphemeralStorageLimit,
 	metricK8sContainerReady,
-	metricK8sNamespacePhase,
-	metricK8sHPACurrentReplicas,
-	metricK8sHPADesiredReplicas,
-	metricK8sHPAMinReplicas,
-	metricK8sHPAMaxReplicas,
-	metricK8sReplicaSetAvailable,
-	metricK8sReplicaSetDesired,
 	metricK8sReplicationControllerAvailable,
 	metricK8sReplicationControllerDesired,
-	metricK8sResourceQuotaHardLimit,
-	metricK8sResourceQuotaUsed,
-	metricK8sCronJobActiveJobs,
 
 	// Upstream optional metrics
 	metricK8sContainerStatusReason,
@@ -130,9 +157,6 @@ var k8sClusterReceiverExtraMetrics = []string{
 	metricK8sNodeCondition,
 	metricK8sPersistentVolumeStatusPhase,
 	metricK8sPersistentVolumeStorageCapacity,
-	metricK8sPersistentVolumeClaimStatusPhase,
-	metricK8sPersistentVolumeClaimStorageCapacity,
-	metricK8sPersistentVolumeClaimStorageRequest,
 	metricK8sPodStatusReason,
 	metricK8sServiceEndpointCount,
 	metricK8sServiceLBIngressCount,
@@ -146,5 +170,11 @@ var K8sClusterReceiverMetrics = slices.Concat(
 	k8sClusterReceiverJobMetrics,
 	k8sClusterReceiverDeploymentMetrics,
 	k8sClusterReceiverDaemonSetMetrics,
+	k8sClusterReceiverReplicaSetMetrics,
+	k8sClusterReceiverCronJobMetrics,
+	k8sClusterReceiverHPAMetrics,
+	k8sClusterReceiverPVCMetrics,
+	k8sClusterReceiverResourceQuotaMetrics,
+	k8sClusterReceiverNamespaceMetrics,
 	k8sClusterReceiverExtraMetrics,
 )
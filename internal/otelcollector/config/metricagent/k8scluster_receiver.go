@@ -31,18 +31,8 @@ func disableK8sClusterMetrics(metrics *K8sClusterMetrics, runtimeResources runti
 		K8sContainerEphemeralStorageRequest: &Metric{Enabled: false},
 		K8sContainerEphemeralStorageLimit:   &Metric{Enabled: false},
 		K8sContainerReady:                   &Metric{Enabled: false},
-		K8sNamespacePhase:                   &Metric{Enabled: false},
-		K8sHPACurrentReplicas:               &Metric{Enabled: false},
-		K8sHPADesiredReplicas:               &Metric{Enabled: false},
-		K8sHPAMinReplicas:                   &Metric{Enabled: false},
-		K8sHPAMaxReplicas:                   &Metric{Enabled: false},
-		K8sReplicaSetAvailable:              &Metric{Enabled: false},
-		K8sReplicaSetDesired:                &Metric{Enabled: false},
 		K8sReplicationControllerAvailable:   &Metric{Enabled: false},
 		K8sReplicationControllerDesired:     &Metric{Enabled: false},
-		K8sResourceQuotaHardLimit:           &Metric{Enabled: false},
-		K8sResourceQuotaUsed:                &Metric{Enabled: false},
-		K8sCronJobActiveJobs:                &Metric{Enabled: false},
 	}
 
 	// The following metrics are enabled by default in the K8sClusterReceiver.
@@ -98,6 +88,52 @@ func disableK8sClusterMetrics(metrics *K8sClusterMetrics, runtimeResources runti
 			K8sDaemonSetReadyNodes:            &Metric{Enabled: false},
 		}
 	}
+
+	if !runtimeResources.replicaset {
+		metrics.K8sClusterReplicaSetMetrics = &K8sClusterReplicaSetMetrics{
+			K8sReplicaSetAvailable: &Metric{Enabled: false},
+			K8sReplicaSetDesired:   &Metric{Enabled: false},
+		}
+	}
+
+	if !runtimeResources.cronjob {
+		metrics.K8sClusterCronJobMetrics = &K8sClusterCronJobMetrics{
+			K8sCronJobActiveJobs: &Metric{Enabled: false},
+		}
+	}
+
+	if !runtimeResources.hpa {
+		metrics.K8sClusterHPAMetrics = &K8sClusterHPAMetrics{
+			K8sHPACurrentReplicas: &Metric{Enabled: false},
+			K8sHPADesiredReplicas: &Metric{Enabled: false},
+			K8sHPAMinReplicas:     &Metric{Enabled: false},
+			K8sHPAMaxReplicas:     &Metric{Enabled: false},
+		}
+	}
+
+	if !runtimeResources.resourcequota {
+		metrics.K8sClusterResourceQuotaMetrics = &K8sClusterResourceQuotaMetrics{
+			K8sResourceQuotaHardLimit: &Metric{Enabled: false},
+			K8sResourceQuotaUsed:      &Metric{Enabled: false},
+		}
+	}
+
+	if !runtimeResources.namespace {
+		metrics.K8sClusterNamespaceMetrics = &K8sClusterNamespaceMetrics{
+			K8sNamespacePhase: &Metric{Enabled: false},
+		}
+	}
+
+	// The PersistentVolumeClaim metrics are optional in the K8sClusterReceiver.
+	// If the resource selector is enabled, we need to enable the corresponding metrics explicitly.
+
+	if runtimeResources.pvc {
+		metrics.K8sClusterPVCMetrics = &K8sClusterPVCMetrics{
+			K8sPersistentVolumeClaimStatusPhase:     &Metric{Enabled: true},
+			K8sPersistentVolumeClaimStorageCapacity: &Metric{Enabled: true},
+			K8sPersistentVolumeClaimStorageRequest:  &Metric{Enabled: true},
+		}
+	}
 }
 
 func enableK8sClusterAdditionalMetrics(metrics *K8sClusterMetrics, additionalMetrics []string) {
@@ -125,42 +161,12 @@ var k8sClusterMetricEnablers = map[string]func(*K8sClusterMetrics){
 	metricK8sContainerReady: func(m *K8sClusterMetrics) {
 		m.K8sContainerReady = &Metric{Enabled: true}
 	},
-	metricK8sNamespacePhase: func(m *K8sClusterMetrics) {
-		m.K8sNamespacePhase = &Metric{Enabled: true}
-	},
-	metricK8sHPACurrentReplicas: func(m *K8sClusterMetrics) {
-		m.K8sHPACurrentReplicas = &Metric{Enabled: true}
-	},
-	metricK8sHPADesiredReplicas: func(m *K8sClusterMetrics) {
-		m.K8sHPADesiredReplicas = &Metric{Enabled: true}
-	},
-	metricK8sHPAMinReplicas: func(m *K8sClusterMetrics) {
-		m.K8sHPAMinReplicas = &Metric{Enabled: true}
-	},
-	metricK8sHPAMaxReplicas: func(m *K8sClusterMetrics) {
-		m.K8sHPAMaxReplicas = &Metric{Enabled: true}
-	},
-	metricK8sReplicaSetAvailable: func(m *K8sClusterMetrics) {
-		m.K8sReplicaSetAvailable = &Metric{Enabled: true}
-	},
-	metricK8sReplicaSetDesired: func(m *K8sClusterMetrics) {
-		m.K8sReplicaSetDesired = &Metric{Enabled: true}
-	},
 	metricK8sReplicationControllerAvailable: func(m *K8sClusterMetrics) {
 		m.K8sReplicationControllerAvailable = &Metric{Enabled: true}
 	},
 	metricK8sReplicationControllerDesired: func(m *K8sClusterMetrics) {
 		m.K8sReplicationControllerDesired = &Metric{Enabled: true}
 	},
-	metricK8sResourceQuotaHardLimit: func(m *K8sClusterMetrics) {
-		m.K8sResourceQuotaHardLimit = &Metric{Enabled: true}
-	},
-	metricK8sResourceQuotaUsed: func(m *K8sClusterMetrics) {
-		m.K8sResourceQuotaUsed = &Metric{Enabled: true}
-	},
-	metricK8sCronJobActiveJobs: func(m *K8sClusterMetrics) {
-		m.K8sCronJobActiveJobs = &Metric{Enabled: true}
-	},
 
 	// K8sClusterPodMetrics
 	metricK8sPodPhase: func(m *K8sClusterMetrics) {
@@ -258,6 +264,70 @@ var k8sClusterMetricEnablers = map[string]func(*K8sClusterMetrics){
 		m.K8sDaemonSetReadyNodes = &Metric{Enabled: true}
 	},
 
+	// K8sClusterReplicaSetMetrics
+	metricK8sReplicaSetAvailable: func(m *K8sClusterMetrics) {
+		initReplicaSetMetrics(m)
+		m.K8sReplicaSetAvailable = &Metric{Enabled: true}
+	},
+	metricK8sReplicaSetDesired: func(m *K8sClusterMetrics) {
+		initReplicaSetMetrics(m)
+		m.K8sReplicaSetDesired = &Metric{Enabled: true}
+	},
+
+	// K8sClusterCronJobMetrics
+	metricK8sCronJobActiveJobs: func(m *K8sClusterMetrics) {
+		initCronJobMetrics(m)
+		m.K8sCronJobActiveJobs = &Metric{Enabled: true}
+	},
+
+	// K8sClusterHPAMetrics
+	metricK8sHPACurrentReplicas: func(m *K8sClusterMetrics) {
+		initHPAMetrics(m)
+		m.K8sHPACurrentReplicas = &Metric{Enabled: true}
+	},
+	metricK8sHPADesiredReplicas: func(m *K8sClusterMetrics) {
+		initHPAMetrics(m)
+		m.K8sHPADesiredReplicas = &Metric{Enabled: true}
+	},
+	metricK8sHPAMinReplicas: func(m *K8sClusterMetrics) {
+		initHPAMetrics(m)
+		m.K8sHPAMinReplicas = &Metric{Enabled: true}
+	},
+	metricK8sHPAMaxReplicas: func(m *K8sClusterMetrics) {
+		initHPAMetrics(m)
+		m.K8sHPAMaxReplicas = &Metric{Enabled: true}
+	},
+
+	// K8sClusterPVCMetrics
+	metricK8sPersistentVolumeClaimStatusPhase: func(m *K8sClusterMetrics) {
+		initPVCMetrics(m)
+		m.K8sPersistentVolumeClaimStatusPhase = &Metric{Enabled: true}
+	},
+	metricK8sPersistentVolumeClaimStorageCapacity: func(m *K8sClusterMetrics) {
+		initPVCMetrics(m)
+		m.K8sPersistentVolumeClaimStorageCapacity = &Metric{Enabled: true}
+	},
+	metricK8sPersistentVolumeClaimStorageRequest: func(m *K8sClusterMetrics) {
+		initPVCMetrics(m)
+		m.K8sPersistentVolumeClaimStorageRequest = &Metric{Enabled: true}
+	},
+
+	// K8sClusterResourceQuotaMetrics
+	metricK8sResourceQuotaHardLimit: func(m *K8sClusterMetrics) {
+		initResourceQuotaMetrics(m)
+		m.K8sResourceQuotaHardLimit = &Metric{Enabled: true}
+	},
+	metricK8sResourceQuotaUsed: func(m *K8sClusterMetrics) {
+		initResourceQuotaMetrics(m)
+		m.K8sResourceQuotaUsed = &Metric{Enabled: true}
+	},
+
+	// K8sClusterNamespaceMetrics
+	metricK8sNamespacePhase: func(m *K8sClusterMetrics) {
+		initNamespaceMetrics(m)
+		m.K8sNamespacePhase = &Metric{Enabled: true}
+	},
+
 	// K8sClusterOptionalMetrics
 	metricK8sContainerStatusReason: func(m *K8sClusterMetrics) {
 		initOptionalMetrics(m)
@@ -279,18 +349,6 @@ var k8sClusterMetricEnablers = map[string]func(*K8sClusterMetrics){
 		initOptionalMetrics(m)
 		m.K8sPersistentVolumeStorageCapacity = &Metric{Enabled: true}
 	},
-	metricK8sPersistentVolumeClaimStatusPhase: func(m *K8sClusterMetrics) {
-		initOptionalMetrics(m)
-		m.K8sPersistentVolumeClaimStatusPhase = &Metric{Enabled: true}
-	},
-	metricK8sPersistentVolumeClaimStorageCapacity: func(m *K8sClusterMetrics) {
-		initOptionalMetrics(m)
-		m.K8sPersistentVolumeClaimStorageCapacity = &Metric{Enabled: true}
-	},
-	metricK8sPersistentVolumeClaimStorageRequest: func(m *K8sClusterMetrics) {
-		initOptionalMetrics(m)
-		m.K8sPersistentVolumeClaimStorageRequest = &Metric{Enabled: true}
-	},
 	metricK8sPodStatusReason: func(m *K8sClusterMetrics) {
 		initOptionalMetrics(m)
 		m.K8sPodStatusReason = &Metric{Enabled: true}
@@ -341,6 +399,42 @@ func initDaemonSetMetrics(metrics *K8sClusterMetrics) {
 	}
 }
 
+func initReplicaSetMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterReplicaSetMetrics == nil {
+		metrics.K8sClusterReplicaSetMetrics = &K8sClusterReplicaSetMetrics{}
+	}
+}
+
+func initCronJobMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterCronJobMetrics == nil {
+		metrics.K8sClusterCronJobMetrics = &K8sClusterCronJobMetrics{}
+	}
+}
+
+func initHPAMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterHPAMetrics == nil {
+		metrics.K8sClusterHPAMetrics = &K8sClusterHPAMetrics{}
+	}
+}
+
+func initPVCMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterPVCMetrics == nil {
+		metrics.K8sClusterPVCMetrics = &K8sClusterPVCMetrics{}
+	}
+}
+
+func initResourceQuotaMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterResourceQuotaMetrics == nil {
+		metrics.K8sClusterResourceQuotaMetrics = &K8sClusterResourceQuotaMetrics{}
+	}
+}
+
+func initNamespaceMetrics(metrics *K8sClusterMetrics) {
+	if metrics.K8sClusterNamespaceMetrics == nil {
+		metrics.K8sClusterNamespaceMetrics = &K8sClusterNamespaceMetrics{}
+	}
+}
+
 func initOptionalMetrics(metrics *K8sClusterMetrics) {
 	if metrics.K8sClusterOptionalMetrics == nil {
 		metrics.K8sClusterOptionalMetrics = &K8sClusterOptionalMetrics{}
@@ -32,6 +32,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 			},
 		},
 		{
@@ -42,6 +47,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterPodMetrics: &K8sClusterPodMetrics{
 					K8sPodPhase: &Metric{false},
 				},
@@ -55,6 +65,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterContainerMetrics: &K8sClusterContainerMetrics{
 					K8sContainerCPURequest:    &Metric{false},
 					K8sContainerCPULimit:      &Metric{false},
@@ -72,6 +87,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterStatefulSetMetrics: &K8sClusterStatefulSetMetrics{
 					K8sStatefulSetCurrentPods: &Metric{false},
 					K8sStatefulSetDesiredPods: &Metric{false},
@@ -87,6 +107,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterJobMetrics: &K8sClusterJobMetrics{
 					K8sJobActivePods:            &Metric{false},
 					K8sJobDesiredSuccessfulPods: &Metric{false},
@@ -103,6 +128,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterDeploymentMetrics: &K8sClusterDeploymentMetrics{
 					K8sDeploymentAvailable: &Metric{false},
 					K8sDeploymentDesired:   &Metric{false},
@@ -116,6 +146,11 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterDaemonSetMetrics: &K8sClusterDaemonSetMetrics{
 					K8sDaemonSetCurrentScheduledNodes: &Metric{false},
 					K8sDaemonSetDesiredScheduledNodes: &Metric{false},
@@ -124,6 +159,40 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "replicaset, cronjob, hpa, pvc, resourcequota and namespace metrics enabled",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithRuntimeInput(true).
+				WithRuntimeInputReplicaSetMetrics(true).
+				WithRuntimeInputCronJobMetrics(true).
+				WithRuntimeInputHorizontalPodAutoscalerMetrics(true).
+				WithRuntimeInputPersistentVolumeClaimMetrics(true).
+				WithRuntimeInputResourceQuotaMetrics(true).
+				WithRuntimeInputNamespaceMetrics(true).
+				Build(),
+			expectedMetrics: K8sClusterMetrics{
+				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterPVCMetrics: &K8sClusterPVCMetrics{
+					K8sPersistentVolumeClaimStatusPhase:     &Metric{true},
+					K8sPersistentVolumeClaimStorageCapacity: &Metric{true},
+					K8sPersistentVolumeClaimStorageRequest:  &Metric{true},
+				},
+			},
+		},
+		{
+			name: "only hpa metrics enabled",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithRuntimeInput(true).
+				WithRuntimeInputHorizontalPodAutoscalerMetrics(true).
+				Build(),
+			expectedMetrics: K8sClusterMetrics{
+				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics:    getExpectedK8sClusterReplicaSetMetricsToDrop(),
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
+			},
+		},
 		{
 			name: "Additional metrics overrule resource selectors",
 			pipeline: testutils.NewMetricPipelineBuilder().
@@ -147,10 +216,20 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 					"k8s.deployment.available",
 					// a default daemonset metric
 					"k8s.daemonset.current_scheduled_nodes",
+					// a replicaset metric
+					"k8s.replicaset.available",
 				).
 				Build(),
 			expectedMetrics: K8sClusterMetrics{
 				K8sClusterDefaultMetricsToDrop: getExpectedK8sClusterDefaultMetricsToDrop(),
+				K8sClusterReplicaSetMetrics: &K8sClusterReplicaSetMetrics{
+					K8sReplicaSetAvailable: &Metric{true},
+					K8sReplicaSetDesired:   &Metric{false},
+				},
+				K8sClusterCronJobMetrics:       getExpectedK8sClusterCronJobMetricsToDrop(),
+				K8sClusterHPAMetrics:           getExpectedK8sClusterHPAMetricsToDrop(),
+				K8sClusterResourceQuotaMetrics: getExpectedK8sClusterResourceQuotaMetricsToDrop(),
+				K8sClusterNamespaceMetrics:     getExpectedK8sClusterNamespaceMetricsToDrop(),
 				K8sClusterPodMetrics: &K8sClusterPodMetrics{
 					K8sPodPhase: &Metric{true},
 				},
@@ -199,18 +278,8 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 					K8sContainerEphemeralStorageRequest: &Metric{Enabled: true},
 					K8sContainerEphemeralStorageLimit:   &Metric{Enabled: true},
 					K8sContainerReady:                   &Metric{Enabled: true},
-					K8sNamespacePhase:                   &Metric{Enabled: true},
-					K8sHPACurrentReplicas:               &Metric{Enabled: true},
-					K8sHPADesiredReplicas:               &Metric{Enabled: true},
-					K8sHPAMinReplicas:                   &Metric{Enabled: true},
-					K8sHPAMaxReplicas:                   &Metric{Enabled: true},
-					K8sReplicaSetAvailable:              &Metric{Enabled: true},
-					K8sReplicaSetDesired:                &Metric{Enabled: true},
 					K8sReplicationControllerAvailable:   &Metric{Enabled: true},
 					K8sReplicationControllerDesired:     &Metric{Enabled: true},
-					K8sResourceQuotaHardLimit:           &Metric{Enabled: true},
-					K8sResourceQuotaUsed:                &Metric{Enabled: true},
-					K8sCronJobActiveJobs:                &Metric{Enabled: true},
 				},
 				K8sClusterPodMetrics: &K8sClusterPodMetrics{
 					K8sPodPhase: &Metric{true},
@@ -245,18 +314,40 @@ func TestK8sClusterReceiverConfig(t *testing.T) {
 					K8sDaemonSetMisscheduledNodes:     &Metric{true},
 					K8sDaemonSetReadyNodes:            &Metric{true},
 				},
+				K8sClusterReplicaSetMetrics: &K8sClusterReplicaSetMetrics{
+					K8sReplicaSetAvailable: &Metric{true},
+					K8sReplicaSetDesired:   &Metric{true},
+				},
+				K8sClusterCronJobMetrics: &K8sClusterCronJobMetrics{
+					K8sCronJobActiveJobs: &Metric{true},
+				},
+				K8sClusterHPAMetrics: &K8sClusterHPAMetrics{
+					K8sHPACurrentReplicas: &Metric{true},
+					K8sHPADesiredReplicas: &Metric{true},
+					K8sHPAMinReplicas:     &Metric{true},
+					K8sHPAMaxReplicas:     &Metric{true},
+				},
+				K8sClusterPVCMetrics: &K8sClusterPVCMetrics{
+					K8sPersistentVolumeClaimStatusPhase:     &Metric{true},
+					K8sPersistentVolumeClaimStorageCapacity: &Metric{true},
+					K8sPersistentVolumeClaimStorageRequest:  &Metric{true},
+				},
+				K8sClusterResourceQuotaMetrics: &K8sClusterResourceQuotaMetrics{
+					K8sResourceQuotaHardLimit: &Metric{true},
+					K8sResourceQuotaUsed:      &Metric{true},
+				},
+				K8sClusterNamespaceMetrics: &K8sClusterNamespaceMetrics{
+					K8sNamespacePhase: &Metric{true},
+				},
 				K8sClusterOptionalMetrics: &K8sClusterOptionalMetrics{
-					K8sContainerStatusReason:                &Metric{Enabled: true},
-					K8sContainerStatusState:                 &Metric{Enabled: true},
-					K8sNodeCondition:                        &Metric{Enabled: true},
-					K8sPersistentVolumeStatusPhase:          &Metric{Enabled: true},
-					K8sPersistentVolumeStorageCapacity:      &Metric{Enabled: true},
-					K8sPersistentVolumeClaimStatusPhase:     &Metric{Enabled: true},
-					K8sPersistentVolumeClaimStorageCapacity: &Metric{Enabled: true},
-					K8sPersistentVolumeClaimStorageRequest:  &Metric{Enabled: true},
-					K8sPodStatusReason:                      &Metric{Enabled: true},
-					K8sServiceEndpointCount:                 &Metric{Enabled: true},
-					K8sServiceLoadBalancerIngressCount:      &Metric{Enabled: true},
+					K8sContainerStatusReason:           &Metric{Enabled: true},
+					K8sContainerStatusState:            &Metric{Enabled: true},
+					K8sNodeCondition:                   &Metric{Enabled: true},
+					K8sPersistentVolumeStatusPhase:     &Metric{Enabled: true},
+					K8sPersistentVolumeStorageCapacity: &Metric{Enabled: true},
+					K8sPodStatusReason:                 &Metric{Enabled: true},
+					K8sServiceEndpointCount:            &Metric{Enabled: true},
+					K8sServiceLoadBalancerIngressCount: &Metric{Enabled: true},
 				},
 			},
 		},
@@ -293,17 +384,42 @@ func getExpectedK8sClusterDefaultMetricsToDrop() *K8sClusterDefaultMetricsToDrop
 		K8sContainerEphemeralStorageRequest: &Metric{Enabled: false},
 		K8sContainerEphemeralStorageLimit:   &Metric{Enabled: false},
 		K8sContainerReady:                   &Metric{Enabled: false},
-		K8sNamespacePhase:                   &Metric{Enabled: false},
-		K8sHPACurrentReplicas:               &Metric{Enabled: false},
-		K8sHPADesiredReplicas:               &Metric{Enabled: false},
-		K8sHPAMinReplicas:                   &Metric{Enabled: false},
-		K8sHPAMaxReplicas:                   &Metric{Enabled: false},
-		K8sReplicaSetAvailable:              &Metric{Enabled: false},
-		K8sReplicaSetDesired:                &Metric{Enabled: false},
 		K8sReplicationControllerAvailable:   &Metric{Enabled: false},
 		K8sReplicationControllerDesired:     &Metric{Enabled: false},
-		K8sResourceQuotaHardLimit:           &Metric{Enabled: false},
-		K8sResourceQuotaUsed:                &Metric{Enabled: false},
-		K8sCronJobActiveJobs:                &Metric{Enabled: false},
+	}
+}
+
+func getExpectedK8sClusterReplicaSetMetricsToDrop() *K8sClusterReplicaSetMetrics {
+	return &K8sClusterReplicaSetMetrics{
+		K8sReplicaSetAvailable: &Metric{Enabled: false},
+		K8sReplicaSetDesired:   &Metric{Enabled: false},
+	}
+}
+
+func getExpectedK8sClusterCronJobMetricsToDrop() *K8sClusterCronJobMetrics {
+	return &K8sClusterCronJobMetrics{
+		K8sCronJobActiveJobs: &Metric{Enabled: false},
+	}
+}
+
+func getExpectedK8sClusterHPAMetricsToDrop() *K8sClusterHPAMetrics {
+	return &K8sClusterHPAMetrics{
+		K8sHPACurrentReplicas: &Metric{Enabled: false},
+		K8sHPADesiredReplicas: &Metric{Enabled: false},
+		K8sHPAMinReplicas:     &Metric{Enabled: false},
+		K8sHPAMaxReplicas:     &Metric{Enabled: false},
+	}
+}
+
+func getExpectedK8sClusterResourceQuotaMetricsToDrop() *K8sClusterResourceQuotaMetrics {
+	return &K8sClusterResourceQuotaMetrics{
+		K8sResourceQuotaHardLimit: &Metric{Enabled: false},
+		K8sResourceQuotaUsed:      &Metric{Enabled: false},
+	}
+}
+
+func getExpectedK8sClusterNamespaceMetricsToDrop() *K8sClusterNamespaceMetrics {
+	return &K8sClusterNamespaceMetrics{
+		K8sNamespacePhase: &Metric{Enabled: false},
 	}
 }
@@ -24,21 +24,41 @@ const (
 	PodNodeSelectorFieldExpression string            = "spec.nodeName=${MY_NODE_NAME}"
 )
 
+// SampleLimit is the maximum number of samples per scrape of a Prometheus scrape job. A target exceeding the limit is not scraped at all.
+const SampleLimit = 50000
+
 const (
-	sampleLimit              = 50000
 	bodySizeLimit            = "20MB"
 	appPodsJobName           = "app-pods"
 	appServicesJobName       = "app-services"
 	appServicesSecureJobName = "app-services-secure"
 )
 
+// Exemplars are only part of the OpenMetrics and the protobuf exposition formats, so these formats are negotiated first when scraping applications.
+// The protobuf format is required for native histograms and is only preferred if they are scraped.
+var (
+	exemplarScrapeProtocols        = []string{"OpenMetricsText1.0.0", "OpenMetricsText0.0.1", "PrometheusText1.0.0", "PrometheusText0.0.4"}
+	nativeHistogramScrapeProtocols = append([]string{"PrometheusProto"}, exemplarScrapeProtocols...)
+)
+
+func appScrapeProtocols(nativeHistograms bool) []string {
+	if nativeHistograms {
+		return nativeHistogramScrapeProtocols
+	}
+
+	return exemplarScrapeProtocols
+}
+
 // prometheusPodsReceiverConfig creates a Prometheus configuration for scraping Pods that are annotated with prometheus.io annotations.
-func prometheusPodsReceiverConfig(collectionInterval time.Duration) *PrometheusReceiverConfig {
+// If nativeHistograms is set, native histograms are scraped from the Pods that expose them.
+func prometheusPodsReceiverConfig(collectionInterval time.Duration, nativeHistograms bool) *PrometheusReceiverConfig {
 	var config PrometheusReceiverConfig
 
 	scrapeConfig := Scrape{
 		ScrapeInterval:             collectionInterval,
-		SampleLimit:                sampleLimit,
+		ScrapeNativeHistograms:     nativeHistograms,
+		ScrapeProtocols:            appScrapeProtocols(nativeHistograms),
+		SampleLimit:                SampleLimit,
 		BodySizeLimit:              bodySizeLimit,
 		KubernetesDiscoveryConfigs: discoveryConfigWithNodeSelector(RolePod),
 		JobName:                    appPodsJobName,
@@ -54,12 +74,15 @@ func prometheusPodsReceiverConfig(collectionInterval time.Duration) *PrometheusR
 // If Istio is enabled, an additional scrape job config is generated (suffixed with -secure) to scrape annotated Services over HTTPS using Istio certificate.
 // Istio certificate is expected to be mounted at the provided path using the proxy.istio.io/config annotation.
 // See more: https://istio.io/latest/docs/ops/integrations/prometheus/#tls-settings
-func prometheusServicesReceiverConfig(opts BuildOptions, collectionInterval time.Duration) *PrometheusReceiverConfig {
+// If nativeHistograms is set, native histograms are scraped from the Services that expose them.
+func prometheusServicesReceiverConfig(opts BuildOptions, collectionInterval time.Duration, nativeHistograms bool) *PrometheusReceiverConfig {
 	var config PrometheusReceiverConfig
 
 	baseScrapeConfig := Scrape{
 		ScrapeInterval:             collectionInterval,
-		SampleLimit:                sampleLimit,
+		ScrapeNativeHistograms:     nativeHistograms,
+		ScrapeProtocols:            appScrapeProtocols(nativeHistograms),
+		SampleLimit:                SampleLimit,
 		BodySizeLimit:              bodySizeLimit,
 		KubernetesDiscoveryConfigs: discoveryConfigWithNodeSelector(RoleEndpoints),
 	}
@@ -154,7 +177,7 @@ func prometheusIstioReceiverConfig(envoyMetricsEnabled bool, collectionInterval
 			ScrapeConfigs: []Scrape{
 				{
 					JobName:                    "istio-proxy",
-					SampleLimit:                sampleLimit,
+					SampleLimit:                SampleLimit,
 					BodySizeLimit:              bodySizeLimit,
 					MetricsPath:                "/stats/prometheus",
 					ScrapeInterval:             collectionInterval,
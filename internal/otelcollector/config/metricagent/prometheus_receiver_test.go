@@ -80,6 +80,35 @@ func TestPrometheusReceiverConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("prometheus input native histograms enabled", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1beta1.MetricPipeline{
+			testutils.NewMetricPipelineBuilder().WithName("native").WithPrometheusInput(true).WithPrometheusInputNativeHistograms(true).Build(),
+			testutils.NewMetricPipelineBuilder().WithName("classic").WithPrometheusInput(true).Build(),
+		}, BuildOptions{
+			IstioActive:         true,
+			CollectionIntervals: telemetryutils.ResolveMetricCollectionIntervals(nil),
+		})
+		require.NoError(t, err)
+
+		for _, receiverID := range []string{"prometheus/app-pods", "prometheus/app-services"} {
+			require.Contains(t, collectorConfig.Receivers, receiverID)
+
+			for _, scrapeConfig := range collectorConfig.Receivers[receiverID].(*PrometheusReceiverConfig).Prometheus.ScrapeConfigs {
+				require.False(t, scrapeConfig.ScrapeNativeHistograms, "job %s of receiver %s", scrapeConfig.JobName, receiverID)
+				require.Equal(t, "OpenMetricsText1.0.0", scrapeConfig.ScrapeProtocols[0], "job %s of receiver %s", scrapeConfig.JobName, receiverID)
+			}
+		}
+
+		for _, receiverID := range []string{"prometheus/app-pods-native-histograms", "prometheus/app-services-native-histograms"} {
+			require.Contains(t, collectorConfig.Receivers, receiverID)
+
+			for _, scrapeConfig := range collectorConfig.Receivers[receiverID].(*PrometheusReceiverConfig).Prometheus.ScrapeConfigs {
+				require.True(t, scrapeConfig.ScrapeNativeHistograms, "job %s of receiver %s", scrapeConfig.JobName, receiverID)
+				require.Equal(t, "PrometheusProto", scrapeConfig.ScrapeProtocols[0], "job %s of receiver %s", scrapeConfig.JobName, receiverID)
+			}
+		}
+	})
+
 	t.Run("istio input enabled", func(t *testing.T) {
 		collectorConfig, _, err := sut.Build(ctx, []telemetryv1beta1.MetricPipeline{
 			testutils.NewMetricPipelineBuilder().WithIstioInput(true).Build(),
@@ -0,0 +1,134 @@
+package metricagent
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+const nativeHistogramsSuffix = "native-histograms"
+
+// scrapeGroup contains the pipelines that collect the metrics of an input with the same scrape settings, that is, the same collection interval
+// and the same native histogram setting. Every group gets its own receivers and input service pipeline, so that the targets are scraped only once per distinct setting.
+type scrapeGroup struct {
+	interval         time.Duration
+	nativeHistograms bool
+	// suffix distinguishes the component IDs of the group. It is empty for the default group, which uses the collection interval
+	// from the Telemetry CR without native histograms and keeps the plain component IDs.
+	suffix    string
+	pipelines []telemetryv1beta1.MetricPipeline
+}
+
+func (g scrapeGroup) isDefault() bool {
+	return g.suffix == ""
+}
+
+// formatID returns the ID of a component or service pipeline of the group.
+//
+// Example: kubelet_stats/2m0s, prometheus/app-pods-2m0s-native-histograms, metrics/input-runtime-2m0s
+func (g scrapeGroup) formatID(id string) string {
+	if g.isDefault() {
+		return id
+	}
+
+	if strings.Contains(id, "/") {
+		return fmt.Sprintf("%s-%s", id, g.suffix)
+	}
+
+	return fmt.Sprintf("%s/%s", id, g.suffix)
+}
+
+type scrapeGroups []scrapeGroup
+
+// groupOf returns the group that contains the given pipeline.
+func (groups scrapeGroups) groupOf(pipelineName string) (scrapeGroup, bool) {
+	for _, group := range groups {
+		if slices.ContainsFunc(group.pipelines, func(p telemetryv1beta1.MetricPipeline) bool { return p.Name == pipelineName }) {
+			return group, true
+		}
+	}
+
+	return scrapeGroup{}, false
+}
+
+func (groups scrapeGroups) maxInterval() time.Duration {
+	var result time.Duration
+	for _, group := range groups {
+		result = max(result, group.interval)
+	}
+
+	return result
+}
+
+// groupByScrapeSettings groups the pipelines by the effective scrape settings of an input.
+// A collection interval set in the pipeline takes precedence over the default interval resolved from the Telemetry CR.
+// The nativeHistograms function is optional and only passed for inputs that support native histograms.
+// The default group comes first, followed by the remaining groups in ascending order of their interval.
+func groupByScrapeSettings(
+	pipelines []telemetryv1beta1.MetricPipeline,
+	defaultInterval time.Duration,
+	pipelineInterval func(input telemetryv1beta1.MetricPipelineInput) *metav1.Duration,
+	nativeHistograms func(input telemetryv1beta1.MetricPipelineInput) bool,
+) scrapeGroups {
+	var groups scrapeGroups
+
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+
+		interval := defaultInterval
+		if override := pipelineInterval(input); override != nil {
+			interval = override.Duration
+		}
+
+		native := nativeHistograms != nil && nativeHistograms(input)
+
+		idx := slices.IndexFunc(groups, func(g scrapeGroup) bool { return g.interval == interval && g.nativeHistograms == native })
+		if idx < 0 {
+			groups = append(groups, newScrapeGroup(interval, native, defaultInterval))
+			idx = len(groups) - 1
+		}
+
+		groups[idx].pipelines = append(groups[idx].pipelines, pipelines[i])
+	}
+
+	slices.SortStableFunc(groups, func(a, b scrapeGroup) int {
+		if a.isDefault() != b.isDefault() {
+			if a.isDefault() {
+				return -1
+			}
+
+			return 1
+		}
+
+		if c := cmp.Compare(a.interval, b.interval); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.suffix, b.suffix)
+	})
+
+	return groups
+}
+
+func newScrapeGroup(interval time.Duration, nativeHistograms bool, defaultInterval time.Duration) scrapeGroup {
+	var suffixParts []string
+	if interval != defaultInterval {
+		suffixParts = append(suffixParts, interval.String())
+	}
+
+	if nativeHistograms {
+		suffixParts = append(suffixParts, nativeHistogramsSuffix)
+	}
+
+	return scrapeGroup{
+		interval:         interval,
+		nativeHistograms: nativeHistograms,
+		suffix:           strings.Join(suffixParts, "-"),
+	}
+}
@@ -146,6 +146,12 @@ type K8sClusterMetrics struct {
 	*K8sClusterJobMetrics           `yaml:",inline,omitempty"`
 	*K8sClusterDeploymentMetrics    `yaml:",inline,omitempty"`
 	*K8sClusterDaemonSetMetrics     `yaml:",inline,omitempty"`
+	*K8sClusterReplicaSetMetrics    `yaml:",inline,omitempty"`
+	*K8sClusterCronJobMetrics       `yaml:",inline,omitempty"`
+	*K8sClusterHPAMetrics           `yaml:",inline,omitempty"`
+	*K8sClusterPVCMetrics           `yaml:",inline,omitempty"`
+	*K8sClusterResourceQuotaMetrics `yaml:",inline,omitempty"`
+	*K8sClusterNamespaceMetrics     `yaml:",inline,omitempty"`
 	*K8sClusterOptionalMetrics      `yaml:",inline,omitempty"`
 }
 
@@ -156,24 +162,9 @@ type K8sClusterDefaultMetricsToDrop struct {
 	K8sContainerEphemeralStorageRequest *Metric `yaml:"k8s.container.ephemeralstorage_request"`
 	K8sContainerEphemeralStorageLimit   *Metric `yaml:"k8s.container.ephemeralstorage_limit"`
 	K8sContainerReady                   *Metric `yaml:"k8s.container.ready"`
-	// Disable Namespace metrics by default
-	K8sNamespacePhase *Metric `yaml:"k8s.namespace.phase"`
-	// Disable HPA metrics by default
-	K8sHPACurrentReplicas *Metric `yaml:"k8s.hpa.current_replicas"`
-	K8sHPADesiredReplicas *Metric `yaml:"k8s.hpa.desired_replicas"`
-	K8sHPAMinReplicas     *Metric `yaml:"k8s.hpa.min_replicas"`
-	K8sHPAMaxReplicas     *Metric `yaml:"k8s.hpa.max_replicas"`
-	// Disable ReplicaSet metrics by default
-	K8sReplicaSetAvailable *Metric `yaml:"k8s.replicaset.available"`
-	K8sReplicaSetDesired   *Metric `yaml:"k8s.replicaset.desired"`
 	// Disable Replication Controller metrics by default
 	K8sReplicationControllerAvailable *Metric `yaml:"k8s.replication_controller.available"`
 	K8sReplicationControllerDesired   *Metric `yaml:"k8s.replication_controller.desired"`
-	// Disable Resource Quota metrics by default
-	K8sResourceQuotaHardLimit *Metric `yaml:"k8s.resource_quota.hard_limit"`
-	K8sResourceQuotaUsed      *Metric `yaml:"k8s.resource_quota.used"`
-	// Disable Cronjob metrics by default
-	K8sCronJobActiveJobs *Metric `yaml:"k8s.cronjob.active_jobs"`
 }
 
 type K8sClusterStatefulSetMetrics struct {
@@ -203,6 +194,37 @@ type K8sClusterDaemonSetMetrics struct {
 	K8sDaemonSetReadyNodes            *Metric `yaml:"k8s.daemonset.ready_nodes,omitempty"`
 }
 
+type K8sClusterReplicaSetMetrics struct {
+	K8sReplicaSetAvailable *Metric `yaml:"k8s.replicaset.available,omitempty"`
+	K8sReplicaSetDesired   *Metric `yaml:"k8s.replicaset.desired,omitempty"`
+}
+
+type K8sClusterCronJobMetrics struct {
+	K8sCronJobActiveJobs *Metric `yaml:"k8s.cronjob.active_jobs,omitempty"`
+}
+
+type K8sClusterHPAMetrics struct {
+	K8sHPACurrentReplicas *Metric `yaml:"k8s.hpa.current_replicas,omitempty"`
+	K8sHPADesiredReplicas *Metric `yaml:"k8s.hpa.desired_replicas,omitempty"`
+	K8sHPAMinReplicas     *Metric `yaml:"k8s.hpa.min_replicas,omitempty"`
+	K8sHPAMaxReplicas     *Metric `yaml:"k8s.hpa.max_replicas,omitempty"`
+}
+
+type K8sClusterPVCMetrics struct {
+	K8sPersistentVolumeClaimStatusPhase     *Metric `yaml:"k8s.persistentvolumeclaim.status.phase,omitempty"`
+	K8sPersistentVolumeClaimStorageCapacity *Metric `yaml:"k8s.persistentvolumeclaim.storage.capacity,omitempty"`
+	K8sPersistentVolumeClaimStorageRequest  *Metric `yaml:"k8s.persistentvolumeclaim.storage.request,omitempty"`
+}
+
+type K8sClusterResourceQuotaMetrics struct {
+	K8sResourceQuotaHardLimit *Metric `yaml:"k8s.resource_quota.hard_limit,omitempty"`
+	K8sResourceQuotaUsed      *Metric `yaml:"k8s.resource_quota.used,omitempty"`
+}
+
+type K8sClusterNamespaceMetrics struct {
+	K8sNamespacePhase *Metric `yaml:"k8s.namespace.phase,omitempty"`
+}
+
 type K8sClusterPodMetrics struct {
 	K8sPodPhase *Metric `yaml:"k8s.pod.phase,omitempty"`
 }
@@ -216,17 +238,14 @@ type K8sClusterContainerMetrics struct {
 }
 
 type K8sClusterOptionalMetrics struct {
-	K8sContainerStatusReason                *Metric `yaml:"k8s.container.status.reason,omitempty"`
-	K8sContainerStatusState                 *Metric `yaml:"k8s.container.status.state,omitempty"`
-	K8sNodeCondition                        *Metric `yaml:"k8s.node.condition,omitempty"`
-	K8sPersistentVolumeStatusPhase          *Metric `yaml:"k8s.persistentvolume.status.phase,omitempty"`
-	K8sPersistentVolumeStorageCapacity      *Metric `yaml:"k8s.persistentvolume.storage.capacity,omitempty"`
-	K8sPersistentVolumeClaimStatusPhase     *Metric `yaml:"k8s.persistentvolumeclaim.status.phase,omitempty"`
-	K8sPersistentVolumeClaimStorageCapacity *Metric `yaml:"k8s.persistentvolumeclaim.storage.capacity,omitempty"`
-	K8sPersistentVolumeClaimStorageRequest  *Metric `yaml:"k8s.persistentvolumeclaim.storage.request,omitempty"`
-	K8sPodStatusReason                      *Metric `yaml:"k8s.pod.status_reason,omitempty"`
-	K8sServiceEndpointCount                 *Metric `yaml:"k8s.service.endpoint.count,omitempty"`
-	K8sServiceLoadBalancerIngressCount      *Metric `yaml:"k8s.service.load_balancer.ingress.count,omitempty"`
+	K8sContainerStatusReason           *Metric `yaml:"k8s.container.status.reason,omitempty"`
+	K8sContainerStatusState            *Metric `yaml:"k8s.container.status.state,omitempty"`
+	K8sNodeCondition                   *Metric `yaml:"k8s.node.condition,omitempty"`
+	K8sPersistentVolumeStatusPhase     *Metric `yaml:"k8s.persistentvolume.status.phase,omitempty"`
+	K8sPersistentVolumeStorageCapacity *Metric `yaml:"k8s.persistentvolume.storage.capacity,omitempty"`
+	K8sPodStatusReason                 *Metric `yaml:"k8s.pod.status_reason,omitempty"`
+	K8sServiceEndpointCount            *Metric `yaml:"k8s.service.endpoint.count,omitempty"`
+	K8sServiceLoadBalancerIngressCount *Metric `yaml:"k8s.service.load_balancer.ingress.count,omitempty"`
 }
 
 type K8sClusterReceiverConfig struct {
@@ -237,6 +256,33 @@ type K8sClusterReceiverConfig struct {
 	K8sLeaderElector       string            `yaml:"k8s_leader_elector"`
 }
 
+type HostMetricsReceiverConfig struct {
+	CollectionInterval string              `yaml:"collection_interval"`
+	RootPath           string              `yaml:"root_path"`
+	Scrapers           HostMetricsScrapers `yaml:"scrapers"`
+}
+
+type HostMetricsScrapers struct {
+	CPU        *HostMetricsScraper           `yaml:"cpu"`
+	Memory     *HostMetricsScraper           `yaml:"memory"`
+	Load       *HostMetricsScraper           `yaml:"load"`
+	Disk       *HostMetricsScraper           `yaml:"disk"`
+	Filesystem *HostMetricsFilesystemScraper `yaml:"filesystem"`
+}
+
+type HostMetricsScraper struct{}
+
+type HostMetricsFilesystemScraper struct {
+	ExcludeFSTypes     HostMetricsFilter `yaml:"exclude_fs_types"`
+	ExcludeMountPoints HostMetricsFilter `yaml:"exclude_mount_points"`
+}
+
+type HostMetricsFilter struct {
+	FSTypes     []string `yaml:"fs_types,omitempty"`
+	MountPoints []string `yaml:"mount_points,omitempty"`
+	MatchType   string   `yaml:"match_type"`
+}
+
 type PrometheusReceiverConfig struct {
 	Prometheus PrometheusScrape `yaml:"config"`
 }
@@ -246,17 +292,25 @@ type PrometheusScrape struct {
 }
 
 type Scrape struct {
-	JobName              string        `yaml:"job_name"`
-	SampleLimit          int           `yaml:"sample_limit,omitempty"`
-	BodySizeLimit        string        `yaml:"body_size_limit,omitempty"`
-	ScrapeInterval       time.Duration `yaml:"scrape_interval,omitempty"`
-	MetricsPath          string        `yaml:"metrics_path,omitempty"`
-	RelabelConfigs       []Relabel     `yaml:"relabel_configs,omitempty"`
-	MetricRelabelConfigs []Relabel     `yaml:"metric_relabel_configs,omitempty"`
+	JobName                string        `yaml:"job_name"`
+	SampleLimit            int           `yaml:"sample_limit,omitempty"`
+	BodySizeLimit          string        `yaml:"body_size_limit,omitempty"`
+	ScrapeInterval         time.Duration `yaml:"scrape_interval,omitempty"`
+	MetricsPath            string        `yaml:"metrics_path,omitempty"`
+	Scheme                 string        `yaml:"scheme,omitempty"`
+	ScrapeNativeHistograms bool          `yaml:"scrape_native_histograms,omitempty"`
+	ScrapeProtocols        []string      `yaml:"scrape_protocols,omitempty"`
+	RelabelConfigs         []Relabel     `yaml:"relabel_configs,omitempty"`
+	MetricRelabelConfigs   []Relabel     `yaml:"metric_relabel_configs,omitempty"`
 
 	KubernetesDiscoveryConfigs []KubernetesDiscovery `yaml:"kubernetes_sd_configs,omitempty"`
 
-	TLS *TLS `yaml:"tls_config,omitempty"`
+	TLS           *TLS           `yaml:"tls_config,omitempty"`
+	Authorization *Authorization `yaml:"authorization,omitempty"`
+}
+
+type Authorization struct {
+	CredentialsFile string `yaml:"credentials_file"`
 }
 
 type TLS struct {
@@ -267,8 +321,13 @@ type TLS struct {
 }
 
 type KubernetesDiscovery struct {
-	Role      Role                   `yaml:"role"`
-	Selectors []K8SDiscoverySelector `yaml:"selectors,omitempty"`
+	Role       Role                    `yaml:"role"`
+	Namespaces *K8SDiscoveryNamespaces `yaml:"namespaces,omitempty"`
+	Selectors  []K8SDiscoverySelector  `yaml:"selectors,omitempty"`
+}
+
+type K8SDiscoveryNamespaces struct {
+	Names []string `yaml:"names"`
 }
 
 type Role string
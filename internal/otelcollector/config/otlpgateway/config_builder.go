@@ -1,6 +1,7 @@
 package otlpgateway
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"slices"
@@ -15,6 +16,8 @@ import (
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 )
 
+const bytesPerMiB = 1024 * 1024
+
 type buildTraceComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.TracePipeline]
 type buildLogComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.LogPipeline]
 type buildMetricComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.MetricPipeline]
@@ -30,6 +33,8 @@ type BuildOptions struct {
 	LogPipelines    []telemetryv1beta1.LogPipeline
 	TracePipelines  []telemetryv1beta1.TracePipeline
 	MetricPipelines []telemetryv1beta1.MetricPipeline
+	// TelemetryRoutes are namespaced pipelines, which are merged into the shared config with their own exporters
+	TelemetryRoutes []telemetryv1beta1.TelemetryRoute
 
 	Cluster     common.ClusterOptions
 	Enrichments *operatorv1beta1.EnrichmentSpec
@@ -41,15 +46,28 @@ type BuildOptions struct {
 	GatewayNamespace string
 	// VpaActive indicates whether VPA is active (VPA CRD exists and VPA is enabled via annotation in Telemetry CR).
 	VpaActive bool
+	// ExternalIngestion enables an additional authenticated OTLP receiver for senders outside the cluster (optional)
+	ExternalIngestion *operatorv1beta1.ExternalIngestionSpec
+	// Receiver defines the settings of the shared OTLP receiver, such as message size limits, keepalive, and CORS (optional)
+	Receiver *operatorv1beta1.OTLPReceiverSpec
+	// TapSink is the sink endpoint of the manager with the open taps, which are attached to the tapped pipelines. Without a sink, no taps are added (optional)
+	TapSink *TapSink
 }
 
-// Build creates OTel Collector configuration from TracePipeline, LogPipeline, and MetricPipeline CRs.
+// Build creates OTel Collector configuration from TracePipeline, LogPipeline, MetricPipeline, and TelemetryRoute CRs.
 func (b *Builder) Build(ctx context.Context, opts BuildOptions) (*common.Config, common.EnvVars, error) {
+	b.dropSuspendedPipelines(&opts)
 	b.sortPipelinesByName(&opts)
 
 	config := common.NewConfig()
 	envVars := make(common.EnvVars)
 
+	if opts.ExternalIngestion != nil {
+		if err := b.addExternalIngestionCredentials(ctx, config, envVars, opts.ExternalIngestion); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Build trace pipelines
 	traceBuilder := common.ComponentBuilder[*telemetryv1beta1.TracePipeline]{
 		Config:  config,
@@ -77,9 +95,31 @@ func (b *Builder) Build(ctx context.Context, opts BuildOptions) (*common.Config,
 		return nil, nil, err
 	}
 
+	// Build telemetry routes
+	routeBuilder := common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute]{
+		Config:  config,
+		EnvVars: envVars,
+	}
+	if err := b.buildTelemetryRoutes(ctx, &routeBuilder, opts); err != nil {
+		return nil, nil, err
+	}
+
 	return config, envVars, nil
 }
 
+// dropSuspendedPipelines leaves suspended pipelines out of the configuration without deleting them
+func (b *Builder) dropSuspendedPipelines(opts *BuildOptions) {
+	opts.LogPipelines = slices.DeleteFunc(slices.Clone(opts.LogPipelines), func(p telemetryv1beta1.LogPipeline) bool {
+		return p.Spec.Suspend
+	})
+	opts.TracePipelines = slices.DeleteFunc(slices.Clone(opts.TracePipelines), func(p telemetryv1beta1.TracePipeline) bool {
+		return p.Spec.Suspend
+	})
+	opts.MetricPipelines = slices.DeleteFunc(slices.Clone(opts.MetricPipelines), func(p telemetryv1beta1.MetricPipeline) bool {
+		return p.Spec.Suspend
+	})
+}
+
 // sortPipelinesByName sorts pipelines by name to ensure consistent order and checksum for generated ConfigMap
 func (b *Builder) sortPipelinesByName(opts *BuildOptions) {
 	slices.SortFunc(opts.LogPipelines, func(a, b telemetryv1beta1.LogPipeline) int {
@@ -91,6 +131,9 @@ func (b *Builder) sortPipelinesByName(opts *BuildOptions) {
 	slices.SortFunc(opts.MetricPipelines, func(a, b telemetryv1beta1.MetricPipeline) int {
 		return strings.Compare(a.Name, b.Name)
 	})
+	slices.SortFunc(opts.TelemetryRoutes, func(a, b telemetryv1beta1.TelemetryRoute) int {
+		return cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Name, b.Name))
+	})
 }
 
 // ================================================================================
@@ -98,15 +141,66 @@ func (b *Builder) sortPipelinesByName(opts *BuildOptions) {
 // ================================================================================
 
 // otlpReceiverConfig returns the shared OTLP receiver configuration used by all signal types.
+// The optional receiver settings of the Telemetry CR are applied on top of the collector defaults.
 //
 //nolint:mnd // port numbers are defined in the ports package
-func otlpReceiverConfig() *common.OTLPReceiverConfig {
-	return &common.OTLPReceiverConfig{
+func otlpReceiverConfig(spec *operatorv1beta1.OTLPReceiverSpec) *common.OTLPReceiverConfig {
+	config := &common.OTLPReceiverConfig{
 		Protocols: common.ReceiverProtocols{
 			HTTP: common.Endpoint{Endpoint: fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, ports.OTLPHTTP)},
 			GRPC: common.Endpoint{Endpoint: fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, ports.OTLPGRPC)},
 		},
 	}
+
+	if spec == nil {
+		return config
+	}
+
+	if spec.GRPC != nil {
+		applyOTLPReceiverGRPCSettings(&config.Protocols.GRPC, spec.GRPC)
+	}
+
+	if spec.HTTP != nil {
+		applyOTLPReceiverHTTPSettings(&config.Protocols.HTTP, spec.HTTP)
+	}
+
+	return config
+}
+
+func applyOTLPReceiverGRPCSettings(endpoint *common.Endpoint, spec *operatorv1beta1.OTLPReceiverGRPCSpec) {
+	if spec.MaxRecvMsgSizeMiB != nil {
+		endpoint.MaxRecvMsgSizeMiB = int(*spec.MaxRecvMsgSizeMiB)
+	}
+
+	if spec.Keepalive != nil {
+		var params common.KeepaliveServerParameters
+		if spec.Keepalive.Time != nil {
+			params.Time = spec.Keepalive.Time.Duration
+		}
+
+		if spec.Keepalive.Timeout != nil {
+			params.Timeout = spec.Keepalive.Timeout.Duration
+		}
+
+		if spec.Keepalive.MaxConnectionAge != nil {
+			params.MaxConnectionAge = spec.Keepalive.MaxConnectionAge.Duration
+		}
+
+		endpoint.Keepalive = &common.KeepaliveConfig{ServerParameters: params}
+	}
+}
+
+func applyOTLPReceiverHTTPSettings(endpoint *common.Endpoint, spec *operatorv1beta1.OTLPReceiverHTTPSpec) {
+	if spec.MaxRequestBodySizeMiB != nil {
+		endpoint.MaxRequestBodySize = int64(*spec.MaxRequestBodySizeMiB) * bytesPerMiB
+	}
+
+	if spec.CORS != nil {
+		endpoint.CORS = &common.CORSConfig{
+			AllowedOrigins: spec.CORS.AllowedOrigins,
+			AllowedHeaders: spec.CORS.AllowedHeaders,
+		}
+	}
 }
 
 // memoryLimiterConfig returns the shared memory limiter configuration used by all signal types.
@@ -139,3 +233,36 @@ func serviceEnrichmentProcessorConfig(opts BuildOptions) any {
 func istioNoiseFilterProcessorConfig() *common.IstioNoiseFilterProcessorConfig {
 	return &common.IstioNoiseFilterProcessorConfig{}
 }
+
+// dropHeartbeatProcessor returns a filter processor that drops the synthetic heartbeat telemetry of all signal types. The manager sends the heartbeat
+// into the OTLP Gateway for pipelines with enabled heartbeat, so that all other pipelines of the same signal type must not forward it to their backends.
+func dropHeartbeatProcessor() *common.FilterProcessorConfig {
+	filters := []telemetryv1beta1.FilterSpec{
+		{
+			Conditions: []string{common.ScopeNameEquals(common.InstrumentationScopeHeartbeat)},
+		},
+	}
+
+	processor := common.LogFilterProcessor(filters)
+	processor.Metrics = filters
+	processor.Traces = filters
+
+	return processor
+}
+
+// keepHeartbeat excludes the synthetic heartbeat telemetry from the given filters of a pipeline with enabled heartbeat.
+// The heartbeat is pushed over OTLP from the manager namespace, so that input and namespace filters would drop it otherwise.
+func keepHeartbeat(filters []telemetryv1beta1.FilterSpec) []telemetryv1beta1.FilterSpec {
+	result := make([]telemetryv1beta1.FilterSpec, 0, len(filters))
+
+	for _, filter := range filters {
+		conditions := make([]string, 0, len(filter.Conditions))
+		for _, condition := range filter.Conditions {
+			conditions = append(conditions, common.JoinWithAnd(condition, common.ScopeNameNotEquals(common.InstrumentationScopeHeartbeat)))
+		}
+
+		result = append(result, telemetryv1beta1.FilterSpec{Conditions: conditions})
+	}
+
+	return result
+}
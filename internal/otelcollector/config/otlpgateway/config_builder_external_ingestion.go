@@ -0,0 +1,105 @@
+package otlpgateway
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+// addExternalIngestionCredentials resolves the credentials used to authenticate senders outside the cluster
+// and adds them as environment variables. If bearer token authentication is used, the bearertokenauth extension is added as well.
+func (b *Builder) addExternalIngestionCredentials(ctx context.Context, config *common.Config, envVars common.EnvVars, spec *operatorv1beta1.ExternalIngestionSpec) error {
+	builder := common.ComponentBuilder[any]{
+		Config:  config,
+		EnvVars: envVars,
+	}
+
+	if spec.TLS != nil {
+		if err := b.addExternalIngestionEnvVar(ctx, envVars, common.EnvVarExternalIngestionTLSCert, spec.TLS.Cert); err != nil {
+			return err
+		}
+
+		if err := b.addExternalIngestionEnvVar(ctx, envVars, common.EnvVarExternalIngestionTLSKey, spec.TLS.Key); err != nil {
+			return err
+		}
+	}
+
+	if spec.Authentication.MTLS != nil {
+		if err := b.addExternalIngestionEnvVar(ctx, envVars, common.EnvVarExternalIngestionClientCA, spec.Authentication.MTLS.ClientCA); err != nil {
+			return err
+		}
+	}
+
+	if spec.Authentication.BearerToken != nil {
+		if err := b.addExternalIngestionEnvVar(ctx, envVars, common.EnvVarExternalIngestionBearerToken, *spec.Authentication.BearerToken); err != nil {
+			return err
+		}
+
+		builder.AddExtension(common.ComponentIDExternalIngestionBearerTokenAuthExtension,
+			common.BearerTokenAuthExtensionConfig{
+				Token: fmt.Sprintf("${%s}", common.EnvVarExternalIngestionBearerToken),
+			},
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (b *Builder) addExternalIngestionEnvVar(ctx context.Context, envVars common.EnvVars, key string, ref operatorv1beta1.SecretKeyRef) error {
+	value, err := secretref.GetValue(ctx, b.Reader, telemetryv1beta1.SecretKeyRef{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+		Key:       ref.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve external ingestion secret reference: %w", err)
+	}
+
+	envVars[key] = value
+
+	return nil
+}
+
+// otlpExternalReceiverConfig returns the OTLP receiver configuration for senders outside the cluster.
+// It listens on dedicated ports so that in-cluster senders are not affected by the authentication settings.
+func otlpExternalReceiverConfig(spec *operatorv1beta1.ExternalIngestionSpec) *common.OTLPReceiverConfig {
+	endpoint := func(port int32) common.Endpoint {
+		e := common.Endpoint{Endpoint: fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, port)}
+
+		if spec.TLS != nil {
+			e.TLS = &common.TLSServerConfig{
+				CertPem: fmt.Sprintf("${%s}", common.EnvVarExternalIngestionTLSCert),
+				KeyPem:  fmt.Sprintf("${%s}", common.EnvVarExternalIngestionTLSKey),
+			}
+
+			if spec.Authentication.MTLS != nil {
+				e.TLS.ClientCAFile = filepath.Join(common.ExternalIngestionClientCADir, common.ExternalIngestionClientCAFileName)
+			}
+		}
+
+		if spec.Authentication.BearerToken != nil {
+			e.Auth = &common.Auth{Authenticator: common.ComponentIDExternalIngestionBearerTokenAuthExtension}
+		}
+
+		return e
+	}
+
+	return &common.OTLPReceiverConfig{
+		Protocols: common.ReceiverProtocols{
+			HTTP: endpoint(ports.OTLPExternalHTTP),
+			GRPC: endpoint(ports.OTLPExternalGRPC),
+		},
+	}
+}
+
+// insertTenantAttributeProcessorConfig returns the transform processor configuration that sets the tenant identity on externally ingested data.
+func insertTenantAttributeProcessorConfig(spec *operatorv1beta1.ExternalIngestionSpec) *common.TransformProcessorConfig {
+	return common.AllSignalsTransformProcessor(common.InsertTenantAttributeProcessorStatements(spec.Tenant))
+}
@@ -3,6 +3,7 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
@@ -13,14 +14,26 @@ import (
 
 // buildLogPipelines builds log pipeline configuration and adds it to the shared config.
 func (b *Builder) buildLogPipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) error {
-	pipelines := opts.LogPipelines
-	if len(pipelines) == 0 {
+	logPipelines := opts.LogPipelines
+	if len(logPipelines) == 0 {
 		return nil
 	}
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	// The sending queue capacity is shared with the outputs of the output routes and with the telemetry routes of the same signal type
+	queueSize := common.BatchingMaxQueueSize / (len(logPipelines) + countOutputRoutes(opts, pipelines.SignalTypeLog) + len(telemetryRoutesForSignal(opts.TelemetryRoutes, telemetryv1beta1.TelemetryRouteSignalLogs)))
 
-	for _, pipeline := range pipelines {
+	// Input pipeline for external ingestion, forwarded to all log pipelines
+	if opts.ExternalIngestion != nil {
+		if err := builder.AddServicePipeline(ctx, nil, "logs/input-external",
+			b.addLogOTLPExternalReceiver(builder, opts),
+			b.addLogInsertTenantAttributeProcessor(builder, opts),
+			b.addLogExporterForExternalInputForwarder(builder),
+		); err != nil {
+			return fmt.Errorf("failed to add log input-external service pipeline: %w", err)
+		}
+	}
+
+	for _, pipeline := range logPipelines {
 		pipelineID := formatLogServicePipelineID(&pipeline)
 
 		if shouldEnableLogOAuth2(&pipeline) {
@@ -29,27 +42,52 @@ func (b *Builder) buildLogPipelines(ctx context.Context, builder *common.Compone
 			}
 		}
 
-		if err := builder.AddServicePipeline(ctx, &pipeline, pipelineID,
-			b.addLogOTLPReceiver(builder),
-			b.addLogMemoryLimiterProcessor(builder),
-			b.addSetObsTimeIfZeroProcessor(builder),
-			b.addLogDropUnknownServiceNameProcessor(builder, opts),
-			b.addLogK8sAttributesProcessor(builder, opts),
-			b.addLogRestoreOtelServiceAttrsProcessor(builder, opts),
-			b.addLogIstioNoiseFilterProcessor(builder),
-			b.addDropIfInputSourceOTLPProcessor(builder),
-			b.addNamespaceFilterProcessor(builder),
-			b.addLogInsertClusterAttributesProcessor(builder, opts),
-			b.addLogServiceEnrichmentProcessor(builder, opts),
-			// Kyma attributes are dropped before user-defined transform and filter processors
-			// to prevent user access to internal attributes.
-			b.addLogDropKymaAttributesProcessor(builder),
-			b.addLogIstioAccessLogsEnrichmentProcessor(builder, opts),
-			b.addLogUserDefinedTransformProcessor(builder),
-			b.addLogUserDefinedFilterProcessor(builder),
-			b.addLogBatchProcessor(builder),
-			b.addLogOTLPExporter(builder, queueSize),
-		); err != nil {
+		addServiceAccountTokenAuthExtension(builder, pipeline.Spec.Output.OTLP, pipelines.LogPipelineRef(&pipeline))
+
+		pipelineRef := pipelines.LogPipelineRef(&pipeline)
+
+		outputStage, err := addOutputStage(ctx, b, builder, &pipeline, pipelineID, pipelineRef, pipeline.Spec.Routes, queueSize, outputRouting[*telemetryv1beta1.LogPipeline]{
+			context:         "log",
+			routesProcessor: common.LogOutputRoutesProcessor,
+			batchProcessor:  b.addLogBatchProcessor(builder),
+			defaultOutput:   []buildLogComponentFunc{b.addLogOTLPExporter(builder, queueSize)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add log output service pipelines: %w", err)
+		}
+
+		if err := addTappableServicePipeline(ctx, builder, &pipeline, pipelineID, pipelineRef, opts.TapSink, sharedtypesutils.IsTapEnabled(pipeline.Spec.Tap), pipelineStages[*telemetryv1beta1.LogPipeline]{
+			input: []buildLogComponentFunc{
+				b.addLogOTLPReceiver(builder, opts),
+				b.addLogReceiverForExternalInputForwarder(builder, opts),
+			},
+			transform: []buildLogComponentFunc{
+				b.addLogMemoryLimiterProcessor(builder),
+				b.addLogDropHeartbeatProcessor(builder, opts),
+				b.addSetObsTimeIfZeroProcessor(builder),
+				b.addLogDropUnknownServiceNameProcessor(builder, opts),
+				b.addLogK8sAttributesProcessor(builder, opts),
+				b.addLogRestoreOtelServiceAttrsProcessor(builder, opts),
+				b.addLogIstioNoiseFilterProcessor(builder),
+				b.addDropIfInputSourceOTLPProcessor(builder),
+				b.addNamespaceFilterProcessor(builder),
+				b.addLogInsertClusterAttributesProcessor(builder, opts),
+				b.addLogServiceEnrichmentProcessor(builder, opts),
+				// Kyma attributes are dropped before user-defined transform and filter processors
+				// to prevent user access to internal attributes.
+				b.addLogDropKymaAttributesProcessor(builder),
+				b.addLogIstioAccessLogsEnrichmentProcessor(builder, opts),
+				b.addLogRedactionProcessor(builder),
+				b.addLogUserDefinedTransformProcessor(builder),
+			},
+			export: []buildLogComponentFunc{
+				b.addLogUserDefinedFilterProcessor(builder),
+			},
+			output: outputStage,
+			redaction: []buildLogComponentFunc{
+				b.addLogRedactionProcessor(builder),
+			},
+		}); err != nil {
 			return fmt.Errorf("failed to add log service pipeline: %w", err)
 		}
 	}
@@ -57,11 +95,51 @@ func (b *Builder) buildLogPipelines(ctx context.Context, builder *common.Compone
 	return nil
 }
 
-func (b *Builder) addLogOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+func (b *Builder) addLogOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
 	return builder.AddReceiver(
 		builder.StaticComponentID(common.ComponentIDOTLPReceiver),
 		func(lp *telemetryv1beta1.LogPipeline) any {
-			return otlpReceiverConfig()
+			return otlpReceiverConfig(opts.Receiver)
+		},
+	)
+}
+
+func (b *Builder) addLogOTLPExternalReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDOTLPExternalReceiver),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			return otlpExternalReceiverConfig(opts.ExternalIngestion)
+		},
+	)
+}
+
+func (b *Builder) addLogInsertTenantAttributeProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDInsertTenantAttributeProcessor),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			return insertTenantAttributeProcessorConfig(opts.ExternalIngestion)
+		},
+	)
+}
+
+func (b *Builder) addLogExporterForExternalInputForwarder(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDExternalInputConnector),
+		func(ctx context.Context, lp *telemetryv1beta1.LogPipeline) (any, common.EnvVars, error) {
+			return &common.ForwardConnectorConfig{}, nil, nil
+		},
+	)
+}
+
+func (b *Builder) addLogReceiverForExternalInputForwarder(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDExternalInputConnector),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if opts.ExternalIngestion == nil {
+				return nil
+			}
+
+			return &common.ForwardConnectorConfig{}
 		},
 	)
 }
@@ -76,6 +154,22 @@ func (b *Builder) addLogMemoryLimiterProcessor(builder *common.ComponentBuilder[
 	)
 }
 
+func (b *Builder) addLogDropHeartbeatProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropHeartbeatProcessor),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			heartbeatSent := slices.ContainsFunc(opts.LogPipelines, func(p telemetryv1beta1.LogPipeline) bool {
+				return sharedtypesutils.IsHeartbeatEnabled(p.Spec.Heartbeat)
+			})
+			if !heartbeatSent || sharedtypesutils.IsHeartbeatEnabled(lp.Spec.Heartbeat) {
+				return nil
+			}
+
+			return dropHeartbeatProcessor()
+		},
+	)
+}
+
 func (b *Builder) addSetObsTimeIfZeroProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDSetObservedTimeIfZeroProcessor),
@@ -134,13 +228,18 @@ func (b *Builder) addLogIstioNoiseFilterProcessor(builder *common.ComponentBuild
 
 func (b *Builder) addDropIfInputSourceOTLPProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
-		builder.StaticComponentID(common.ComponentIDDropIfInputSourceOTLPProcessor),
+		formatLogDropIfInputSourceOTLPID,
 		func(lp *telemetryv1beta1.LogPipeline) any {
 			if sharedtypesutils.IsOTLPInputEnabled(lp.Spec.Input.OTLP) {
 				return nil // Skip this processor if OTLP input is enabled
 			}
 
-			return dropIfInputSourceOTLPProcessor()
+			processor := dropIfInputSourceOTLPProcessor()
+			if sharedtypesutils.IsHeartbeatEnabled(lp.Spec.Heartbeat) {
+				processor.Logs = keepHeartbeat(processor.Logs)
+			}
+
+			return processor
 		},
 	)
 }
@@ -150,11 +249,16 @@ func (b *Builder) addNamespaceFilterProcessor(builder *common.ComponentBuilder[*
 		formatNamespaceFilterID,
 		func(lp *telemetryv1beta1.LogPipeline) any {
 			otlpInput := lp.Spec.Input.OTLP
-			if otlpInput == nil || !sharedtypesutils.IsOTLPInputEnabled(otlpInput) || !shouldFilterByNamespace(otlpInput.Namespaces) {
+			if otlpInput == nil || !sharedtypesutils.IsOTLPInputEnabled(otlpInput) || !sharedtypesutils.IsNamespaceFilterDefined(otlpInput.Namespaces) {
 				return nil // No namespace filter needed
 			}
 
-			return namespaceFilterProcessor(otlpInput.Namespaces)
+			processor := namespaceFilterProcessor(otlpInput.Namespaces)
+			if sharedtypesutils.IsHeartbeatEnabled(lp.Spec.Heartbeat) {
+				processor.Logs = keepHeartbeat(processor.Logs)
+			}
+
+			return processor
 		},
 	)
 }
@@ -199,6 +303,20 @@ func (b *Builder) addLogIstioAccessLogsEnrichmentProcessor(builder *common.Compo
 	)
 }
 
+func (b *Builder) addLogRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		formatLogRedactionProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			processor := common.LogRedactionProcessor(lp.Spec.Redaction)
+			if processor == nil {
+				return nil
+			}
+
+			return processor
+		},
+	)
+}
+
 func (b *Builder) addLogUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
 		formatLogUserDefinedTransformProcessorID,
@@ -286,10 +404,23 @@ func formatLogServicePipelineID(lp *telemetryv1beta1.LogPipeline) string {
 	return fmt.Sprintf("logs/%s", lp.Name)
 }
 
+// formatLogDropIfInputSourceOTLPID returns a dedicated ID for pipelines with enabled heartbeat, because their filter lets the heartbeat pass.
+func formatLogDropIfInputSourceOTLPID(lp *telemetryv1beta1.LogPipeline) string {
+	if sharedtypesutils.IsHeartbeatEnabled(lp.Spec.Heartbeat) {
+		return common.ComponentIDDropIfInputSourceOTLPKeepHeartbeatProcessor
+	}
+
+	return common.ComponentIDDropIfInputSourceOTLPProcessor
+}
+
 func formatNamespaceFilterID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDNamespaceFilterProcessor(lp.Name)
 }
 
+func formatLogRedactionProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.LogPipelineRef(lp))
+}
+
 func formatLogUserDefinedTransformProcessorID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.LogPipelineRef(lp))
 }
@@ -348,7 +479,3 @@ func dropIfInputSourceOTLPProcessor() *common.FilterProcessorConfig {
 		}},
 	})
 }
-
-func shouldFilterByNamespace(namespaceSelector *telemetryv1beta1.NamespaceSelector) bool {
-	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
-}
@@ -3,6 +3,7 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
@@ -16,8 +17,8 @@ import (
 // Unlike trace/log which use flat per-pipeline pipelines, metrics use a 3-stage architecture:
 // input pipelines → enrichment pipeline → per-pipeline output pipelines (connected via forward connectors).
 func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) error {
-	pipelines := opts.MetricPipelines
-	if len(pipelines) == 0 {
+	metricPipelines := opts.MetricPipelines
+	if len(metricPipelines) == 0 {
 		return nil
 	}
 
@@ -31,17 +32,30 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 		nil,
 	)
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	// The sending queue capacity is shared with the outputs of the output routes and with the telemetry routes of the same signal type
+	queueSize := common.BatchingMaxQueueSize / (len(metricPipelines) + countOutputRoutes(opts, pipelines.SignalTypeMetric) + len(telemetryRoutesForSignal(opts.TelemetryRoutes, telemetryv1beta1.TelemetryRouteSignalMetrics)))
 
 	// Input pipeline: OTLP receiver
 	if err := builder.AddServicePipeline(ctx, nil, "metrics/input-otlp",
-		b.addMetricOTLPReceiver(builder),
+		b.addMetricOTLPReceiver(builder, opts),
 		b.addMetricSetKymaInputNameProcessor(builder, common.InputSourceOTLP),
 		b.addMetricExporterForInputForwarder(builder),
 	); err != nil {
 		return fmt.Errorf("failed to add metric input-otlp service pipeline: %w", err)
 	}
 
+	// Input pipeline: OTLP receiver for external ingestion
+	if opts.ExternalIngestion != nil {
+		if err := builder.AddServicePipeline(ctx, nil, "metrics/input-otlp-external",
+			b.addMetricOTLPExternalReceiver(builder, opts),
+			b.addMetricSetKymaInputNameProcessor(builder, common.InputSourceOTLP),
+			b.addMetricInsertTenantAttributeProcessor(builder, opts),
+			b.addMetricExporterForInputForwarder(builder),
+		); err != nil {
+			return fmt.Errorf("failed to add metric input-otlp-external service pipeline: %w", err)
+		}
+	}
+
 	// Input pipeline: KymaStats receiver
 	if err := builder.AddServicePipeline(ctx, nil, "metrics/input-kyma-stats",
 		b.addMetricKymaStatsReceiver(builder),
@@ -67,7 +81,7 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 	}
 
 	// Per-pipeline output pipelines
-	for _, pipeline := range pipelines {
+	for _, pipeline := range metricPipelines {
 		outputPipelineID := formatMetricOutputServicePipelineID(&pipeline)
 
 		if shouldEnableMetricOAuth2(&pipeline) {
@@ -76,17 +90,52 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 			}
 		}
 
-		if err := builder.AddServicePipeline(ctx, &pipeline, outputPipelineID,
-			b.addMetricReceiverForEnrichmentForwarder(builder),
-			b.addMetricDropOTLPIfInputDisabledProcessor(builder),
-			b.addMetricOTLPNamespaceFilterProcessor(builder),
-			b.addMetricDropKymaAttributesProcessor(builder),
-			b.addMetricUserDefinedTransformProcessor(builder),
-			b.addMetricUserDefinedFilterProcessor(builder),
-			b.addMetricCumulativeToDeltaProcessor(builder),
-			b.addMetricBatchProcessor(builder),
-			b.addMetricOTLPExporter(builder, queueSize),
-		); err != nil {
+		pipelineRef := pipelines.MetricPipelineRef(&pipeline)
+
+		if pipeline.Spec.Output.OTLP != nil {
+			addServiceAccountTokenAuthExtension(builder, &pipeline.Spec.Output.OTLP.OTLPOutput, pipelineRef)
+		}
+
+		outputStage, err := addOutputStage(ctx, b, builder, &pipeline, outputPipelineID, pipelineRef, pipeline.Spec.Routes, queueSize, outputRouting[*telemetryv1beta1.MetricPipeline]{
+			context:         "datapoint",
+			routesProcessor: common.MetricOutputRoutesProcessor,
+			batchProcessor:  b.addMetricBatchProcessor(builder),
+			defaultOutput: []buildMetricComponentFunc{
+				b.addMetricOTLPExporter(builder, queueSize),
+				b.addMetricPrometheusExporter(builder),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add metric output service pipelines: %w", err)
+		}
+
+		// The input stage of an output pipeline is the enriched data, which is shared by all metric pipelines
+		if err := addTappableServicePipeline(ctx, builder, &pipeline, outputPipelineID, pipelineRef, opts.TapSink, sharedtypesutils.IsTapEnabled(pipeline.Spec.Tap), pipelineStages[*telemetryv1beta1.MetricPipeline]{
+			input: []buildMetricComponentFunc{
+				b.addMetricReceiverForEnrichmentForwarder(builder),
+			},
+			transform: []buildMetricComponentFunc{
+				b.addMetricDropHeartbeatProcessor(builder, opts),
+				b.addMetricDropOTLPIfInputDisabledProcessor(builder),
+				b.addMetricOTLPNamespaceFilterProcessor(builder),
+				b.addMetricDropKymaAttributesProcessor(builder),
+				b.addMetricRedactionProcessor(builder),
+				b.addMetricUserDefinedTransformProcessor(builder),
+			},
+			export: []buildMetricComponentFunc{
+				b.addMetricUserDefinedFilterProcessor(builder),
+				b.addMetricDropExemplarsProcessor(builder),
+				b.addMetricAggregationDropAttributesProcessor(builder),
+				b.addMetricAggregationGroupByAttrsProcessor(builder),
+				b.addMetricAggregationMergeSeriesProcessor(builder),
+				b.addMetricIntervalProcessor(builder),
+				b.addMetricCumulativeToDeltaProcessor(builder),
+			},
+			output: outputStage,
+			redaction: []buildMetricComponentFunc{
+				b.addMetricRedactionProcessor(builder),
+			},
+		}); err != nil {
 			return fmt.Errorf("failed to add metric output service pipeline: %w", err)
 		}
 	}
@@ -98,11 +147,20 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 // Input pipeline components
 // ======================================================
 
-func (b *Builder) addMetricOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+func (b *Builder) addMetricOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) buildMetricComponentFunc {
 	return builder.AddReceiver(
 		builder.StaticComponentID(common.ComponentIDOTLPReceiver),
 		func(mp *telemetryv1beta1.MetricPipeline) any {
-			return otlpReceiverConfig()
+			return otlpReceiverConfig(opts.Receiver)
+		},
+	)
+}
+
+func (b *Builder) addMetricOTLPExternalReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) buildMetricComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDOTLPExternalReceiver),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return otlpExternalReceiverConfig(opts.ExternalIngestion)
 		},
 	)
 }
@@ -154,6 +212,15 @@ func (b *Builder) addMetricReceiverForInputForwarder(builder *common.ComponentBu
 	)
 }
 
+func (b *Builder) addMetricInsertTenantAttributeProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDInsertTenantAttributeProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return insertTenantAttributeProcessorConfig(opts.ExternalIngestion)
+		},
+	)
+}
+
 // ======================================================
 // Enrichment pipeline components
 // ======================================================
@@ -253,19 +320,40 @@ func (b *Builder) addMetricReceiverForEnrichmentForwarder(builder *common.Compon
 	)
 }
 
+func (b *Builder) addMetricDropHeartbeatProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropHeartbeatProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			heartbeatSent := slices.ContainsFunc(opts.MetricPipelines, func(p telemetryv1beta1.MetricPipeline) bool {
+				return sharedtypesutils.IsHeartbeatEnabled(p.Spec.Heartbeat)
+			})
+			if !heartbeatSent || sharedtypesutils.IsHeartbeatEnabled(mp.Spec.Heartbeat) {
+				return nil
+			}
+
+			return dropHeartbeatProcessor()
+		},
+	)
+}
+
 func (b *Builder) addMetricDropOTLPIfInputDisabledProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
-		builder.StaticComponentID(common.ComponentIDDropIfInputSourceOTLPProcessor),
+		formatMetricDropIfInputSourceOTLPID,
 		func(mp *telemetryv1beta1.MetricPipeline) any {
 			if sharedtypesutils.IsOTLPInputEnabled(mp.Spec.Input.OTLP) {
 				return nil
 			}
 
-			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+			filters := []telemetryv1beta1.FilterSpec{
 				{
 					Conditions: []string{common.KymaInputNameEquals(common.InputSourceOTLP)},
 				},
-			})
+			}
+			if sharedtypesutils.IsHeartbeatEnabled(mp.Spec.Heartbeat) {
+				filters = keepHeartbeat(filters)
+			}
+
+			return common.MetricFilterProcessor(filters)
 		},
 	)
 }
@@ -275,11 +363,16 @@ func (b *Builder) addMetricOTLPNamespaceFilterProcessor(builder *common.Componen
 		formatMetricOTLPNamespaceFilterID,
 		func(mp *telemetryv1beta1.MetricPipeline) any {
 			input := mp.Spec.Input
-			if !sharedtypesutils.IsOTLPInputEnabled(input.OTLP) || input.OTLP == nil || !metricShouldFilterByNamespace(input.OTLP.Namespaces) {
+			if !sharedtypesutils.IsOTLPInputEnabled(input.OTLP) || input.OTLP == nil || !sharedtypesutils.IsNamespaceFilterDefined(input.OTLP.Namespaces) {
 				return nil
 			}
 
-			return metricFilterByNamespaceProcessorConfig(input.OTLP.Namespaces)
+			processor := metricFilterByNamespaceProcessorConfig(input.OTLP.Namespaces)
+			if sharedtypesutils.IsHeartbeatEnabled(mp.Spec.Heartbeat) {
+				processor.Metrics = keepHeartbeat(processor.Metrics)
+			}
+
+			return processor
 		},
 	)
 }
@@ -294,6 +387,20 @@ func (b *Builder) addMetricDropKymaAttributesProcessor(builder *common.Component
 	)
 }
 
+func (b *Builder) addMetricRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricRedactionProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			processor := common.MetricRedactionProcessor(mp.Spec.Redaction)
+			if processor == nil {
+				return nil
+			}
+
+			return processor
+		},
+	)
+}
+
 func (b *Builder) addMetricUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
 		formatMetricUserDefinedTransformProcessorID,
@@ -322,6 +429,71 @@ func (b *Builder) addMetricUserDefinedFilterProcessor(builder *common.ComponentB
 	)
 }
 
+func (b *Builder) addMetricAggregationDropAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricAggregationDropAttributesProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationDropAttributesProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addMetricAggregationGroupByAttrsProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricAggregationGroupByAttrsProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationGroupByAttrsProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addMetricAggregationMergeSeriesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricAggregationMergeSeriesProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || len(mp.Spec.Aggregation.DropAttributes) == 0 {
+				return nil
+			}
+
+			return common.AggregationMergeSeriesProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addMetricIntervalProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricIntervalProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Aggregation == nil || mp.Spec.Aggregation.Interval == nil {
+				return nil
+			}
+
+			return common.IntervalProcessor(mp.Spec.Aggregation)
+		},
+	)
+}
+
+func (b *Builder) addMetricDropExemplarsProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropExemplarsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !metricpipelineutils.IsExemplarsDropped(mp.Spec.Output) {
+				return nil
+			}
+
+			return common.MetricTransformProcessor(common.DropExemplarsProcessorStatements())
+		},
+	)
+}
+
 func (b *Builder) addMetricCumulativeToDeltaProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDCumulativeToDeltaProcessor),
@@ -358,6 +530,10 @@ func (b *Builder) addMetricOTLPExporter(builder *common.ComponentBuilder[*teleme
 	return builder.AddExporter(
 		formatMetricOTLPExporterID,
 		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			if mp.Spec.Output.OTLP == nil {
+				return nil, nil, nil
+			}
+
 			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
 				b.Reader,
 				&mp.Spec.Output.OTLP.OTLPOutput,
@@ -370,6 +546,19 @@ func (b *Builder) addMetricOTLPExporter(builder *common.ComponentBuilder[*teleme
 	)
 }
 
+func (b *Builder) addMetricPrometheusExporter(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddExporter(
+		formatMetricPrometheusExporterID,
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			if mp.Spec.Output.Prometheus == nil {
+				return nil, nil, nil
+			}
+
+			return common.PrometheusExporter(mp.Spec.Output.Prometheus), nil, nil
+		},
+	)
+}
+
 // ======================================================
 // Authentication extensions
 // ======================================================
@@ -397,10 +586,6 @@ func (b *Builder) addMetricOAuth2Extension(ctx context.Context, builder *common.
 // Helper functions
 // ======================================================
 
-func metricShouldFilterByNamespace(namespaceSelector *telemetryv1beta1.NamespaceSelector) bool {
-	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
-}
-
 func metricFilterByNamespaceProcessorConfig(namespaceSelector *telemetryv1beta1.NamespaceSelector) *common.FilterProcessorConfig {
 	var filterExpressions []string
 
@@ -442,6 +627,15 @@ func formatMetricOutputServicePipelineID(mp *telemetryv1beta1.MetricPipeline) st
 	return fmt.Sprintf("metrics/%s-output", mp.Name)
 }
 
+// formatMetricDropIfInputSourceOTLPID returns a dedicated ID for pipelines with enabled heartbeat, because their filter lets the heartbeat pass.
+func formatMetricDropIfInputSourceOTLPID(mp *telemetryv1beta1.MetricPipeline) string {
+	if sharedtypesutils.IsHeartbeatEnabled(mp.Spec.Heartbeat) {
+		return common.ComponentIDDropIfInputSourceOTLPKeepHeartbeatProcessor
+	}
+
+	return common.ComponentIDDropIfInputSourceOTLPProcessor
+}
+
 func formatMetricOTLPNamespaceFilterID(mp *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDNamespacePerInputFilterProcessor(mp.Name, common.InputSourceOTLP)
 }
@@ -450,6 +644,14 @@ func formatMetricOTLPExporterID(pipeline *telemetryv1beta1.MetricPipeline) strin
 	return common.ComponentIDOTLPExporter(pipeline.Spec.Output.OTLP.Protocol, pipelines.MetricPipelineRef(pipeline))
 }
 
+func formatMetricPrometheusExporterID(pipeline *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDPrometheusExporter(pipelines.MetricPipelineRef(pipeline))
+}
+
+func formatMetricRedactionProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.MetricPipelineRef(mp))
+}
+
 func formatMetricUserDefinedTransformProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.MetricPipelineRef(mp))
 }
@@ -458,6 +660,22 @@ func formatMetricUserDefinedFilterProcessorID(mp *telemetryv1beta1.MetricPipelin
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.MetricPipelineRef(mp))
 }
 
+func formatMetricAggregationDropAttributesProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationDropAttributesProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatMetricAggregationGroupByAttrsProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationGroupByAttrsProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatMetricAggregationMergeSeriesProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDAggregationMergeSeriesProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+func formatMetricIntervalProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDIntervalProcessor(pipelines.MetricPipelineRef(mp))
+}
+
 func shouldEnableMetricOAuth2(mp *telemetryv1beta1.MetricPipeline) bool {
-	return mp.Spec.Output.OTLP.Authentication != nil && mp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+	return mp.Spec.Output.OTLP != nil && mp.Spec.Output.OTLP.Authentication != nil && mp.Spec.Output.OTLP.Authentication.OAuth2 != nil
 }
@@ -0,0 +1,182 @@
+package otlpgateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// outputRouting contains the signal-specific parts of the output stage of a pipeline with output routes.
+type outputRouting[T any] struct {
+	// context is the OTTL context of the routing table, which is the context of the records marked by the routes processor
+	context string
+	// routesProcessor creates the processor that marks each record with the name of the first route it matches
+	routesProcessor func(routes []telemetryv1beta1.OutputRoute) *common.TransformProcessorConfig
+	// batchProcessor is added to the service pipeline of each output
+	batchProcessor common.BuildComponentFunc[T]
+	// defaultOutput contains the exporters of the default output of the pipeline
+	defaultOutput []common.BuildComponentFunc[T]
+}
+
+// addOutputStage returns the output stage of a pipeline. Without output routes, the output stage consists of the batch processor
+// and the exporters of the default output. With output routes, the records are marked with the first route they match, and a routing
+// connector distributes them to one additional service pipeline per output:
+//
+//	<pipelineID>:                ... → transform/<pipeline>-output-routes → routing/<pipeline>
+//	<pipelineID>_default-output: routing/<pipeline> → transform/drop-output-route-attribute → batch → default exporter
+//	<pipelineID>_output-<route>: routing/<pipeline> → transform/drop-output-route-attribute → batch → otlp_<protocol>/<pipeline>_output-<route>
+//
+// The underscore cannot be part of a pipeline name, so the additional service pipeline IDs do not collide with other pipelines.
+func addOutputStage[T any](ctx context.Context, b *Builder, builder *common.ComponentBuilder[T], pipeline T, pipelineID string, pipelineRef pipelines.PipelineRef, routes []telemetryv1beta1.OutputRoute, queueSize int, routing outputRouting[T]) ([]common.BuildComponentFunc[T], error) {
+	if len(routes) == 0 {
+		return append([]common.BuildComponentFunc[T]{routing.batchProcessor}, routing.defaultOutput...), nil
+	}
+
+	defaultOutputPipelineID := pipelineID + "_default-output"
+	routingConnectorConfig := outputRoutingConnectorConfig(routing.context, defaultOutputPipelineID, pipelineID, routes)
+	connectorID := common.ComponentIDOutputRoutingConnector(pipelineRef)
+	connectorReceiver := builder.AddReceiver(
+		builder.StaticComponentID(connectorID),
+		func(_ T) any {
+			return routingConnectorConfig
+		},
+	)
+	dropRouteAttributeProcessor := builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropOutputRouteAttributeProcessor),
+		func(_ T) any {
+			return common.DropOutputRouteAttributeProcessor()
+		},
+	)
+
+	outputHead := []common.BuildComponentFunc[T]{connectorReceiver, dropRouteAttributeProcessor, routing.batchProcessor}
+
+	if err := builder.AddServicePipeline(ctx, pipeline, defaultOutputPipelineID, slices.Concat(outputHead, routing.defaultOutput)...); err != nil {
+		return nil, err
+	}
+
+	for _, route := range routes {
+		outputRef := pipelineRef.OutputRef(route.Name)
+
+		if route.Output.OTLP.Authentication != nil && route.Output.OTLP.Authentication.OAuth2 != nil {
+			if err := addOutputRouteOAuth2Extension(ctx, b, builder, route, outputRef); err != nil {
+				return nil, err
+			}
+		}
+
+		addServiceAccountTokenAuthExtension(builder, route.Output.OTLP, outputRef)
+
+		if err := builder.AddServicePipeline(ctx, pipeline, formatOutputRouteServicePipelineID(pipelineID, route.Name),
+			append(slices.Clone(outputHead), addOutputRouteOTLPExporter(b, builder, route, outputRef, queueSize))...,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return []common.BuildComponentFunc[T]{
+		builder.AddProcessor(
+			builder.StaticComponentID(common.ComponentIDOutputRoutesProcessor(pipelineRef)),
+			func(_ T) any {
+				return routing.routesProcessor(routes)
+			},
+		),
+		builder.AddExporter(
+			builder.StaticComponentID(connectorID),
+			func(ctx context.Context, _ T) (any, common.EnvVars, error) {
+				return routingConnectorConfig, nil, nil
+			},
+		),
+	}, nil
+}
+
+func addOutputRouteOTLPExporter[T any](b *Builder, builder *common.ComponentBuilder[T], route telemetryv1beta1.OutputRoute, outputRef pipelines.PipelineRef, queueSize int) common.BuildComponentFunc[T] {
+	return builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDOTLPExporter(route.Output.OTLP.Protocol, outputRef)),
+		func(ctx context.Context, _ T) (any, common.EnvVars, error) {
+			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
+				b.Reader,
+				route.Output.OTLP,
+				outputRef,
+				common.NewSendingQueue(queueSize),
+			)
+
+			return otlpExporterBuilder.OTLPExporter(ctx)
+		},
+	)
+}
+
+func addOutputRouteOAuth2Extension[T any](ctx context.Context, b *Builder, builder *common.ComponentBuilder[T], route telemetryv1beta1.OutputRoute, outputRef pipelines.PipelineRef) error {
+	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
+		b.Reader,
+		route.Output.OTLP.Authentication.OAuth2,
+		outputRef,
+	).OAuth2Extension(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build OAuth2 extension for output route %s: %w", route.Name, err)
+	}
+
+	builder.AddExtension(common.ComponentIDOAuth2Extension(outputRef), oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+
+	return nil
+}
+
+// addServiceAccountTokenAuthExtension adds the bearer token extension that sends the projected service account token, if the output authenticates with it.
+// The token is read from a file, so no environment variables are needed.
+func addServiceAccountTokenAuthExtension[T any](builder *common.ComponentBuilder[T], output *telemetryv1beta1.OTLPOutput, outputRef pipelines.PipelineRef) {
+	if output == nil || !common.IsServiceAccountTokenAuthEnabled(output.Authentication) {
+		return
+	}
+
+	builder.AddExtension(common.ComponentIDServiceAccountTokenAuthExtension(outputRef), common.ServiceAccountTokenAuthExtensionConfig(output.Authentication.ServiceAccountToken), nil)
+}
+
+// Output route helper functions
+
+// outputRoutingConnectorConfig creates the routing connector that sends the records marked with a route to the service pipeline of its output.
+// Unmarked records match no route and are sent to the service pipeline of the default output.
+func outputRoutingConnectorConfig(routingContext, defaultOutputPipelineID, pipelineID string, routes []telemetryv1beta1.OutputRoute) common.RoutingConnectorConfig {
+	tableEntries := make([]common.RoutingConnectorTableEntry, 0, len(routes))
+	for _, route := range routes {
+		tableEntries = append(tableEntries, common.RoutingConnectorTableEntry{
+			Context:   routingContext,
+			Statement: fmt.Sprintf("route() where %s", common.OutputRouteAttributeEquals(route.Name)),
+			Pipelines: []string{formatOutputRouteServicePipelineID(pipelineID, route.Name)},
+		})
+	}
+
+	return common.RoutingConnectorConfig{
+		DefaultPipelines: []string{defaultOutputPipelineID},
+		ErrorMode:        "ignore",
+		Table:            tableEntries,
+	}
+}
+
+func formatOutputRouteServicePipelineID(pipelineID, routeName string) string {
+	return fmt.Sprintf("%s_output-%s", pipelineID, routeName)
+}
+
+// countOutputRoutes returns the number of output routes of all pipelines of the given signal type.
+// The exporters of the route outputs share the sending queue capacity with the exporters of the default outputs.
+func countOutputRoutes(opts BuildOptions, signalType pipelines.SignalType) int {
+	count := 0
+
+	switch signalType { //nolint:exhaustive // FluentBit log pipelines are not handled by the OTLP Gateway
+	case pipelines.SignalTypeTrace:
+		for i := range opts.TracePipelines {
+			count += len(opts.TracePipelines[i].Spec.Routes)
+		}
+	case pipelines.SignalTypeLog:
+		for i := range opts.LogPipelines {
+			count += len(opts.LogPipelines[i].Spec.Routes)
+		}
+	case pipelines.SignalTypeMetric:
+		for i := range opts.MetricPipelines {
+			count += len(opts.MetricPipelines[i].Spec.Routes)
+		}
+	}
+
+	return count
+}
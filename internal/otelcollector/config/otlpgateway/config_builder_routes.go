@@ -0,0 +1,295 @@
+package otlpgateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+)
+
+type buildRouteComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.TelemetryRoute]
+
+// routeSignal describes how a signal type is shipped by a TelemetryRoute.
+type routeSignal struct {
+	signal     telemetryv1beta1.TelemetryRouteSignal
+	signalType pipelines.SignalType
+	batchSize  int
+}
+
+//nolint:mnd // batch sizes match the ones of the corresponding pipeline types
+var routeSignals = []routeSignal{
+	{signal: telemetryv1beta1.TelemetryRouteSignalTraces, signalType: pipelines.SignalTypeTrace, batchSize: 512},
+	{signal: telemetryv1beta1.TelemetryRouteSignalLogs, signalType: pipelines.SignalTypeLog, batchSize: 512},
+	{signal: telemetryv1beta1.TelemetryRouteSignalMetrics, signalType: pipelines.SignalTypeMetric, batchSize: 1024},
+}
+
+// buildTelemetryRoutes builds the service pipelines of TelemetryRoutes and adds them to the shared config.
+// Every route gets one flat pipeline per signal, which receives the data pushed to the OTLP receiver, keeps only the data
+// originating from the namespace of the route, and exports it to the backend of the route.
+// The external OTLP receiver is intentionally not connected, because data of external senders belongs to no namespace.
+func (b *Builder) buildTelemetryRoutes(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) error {
+	for _, rs := range routeSignals {
+		routes := telemetryRoutesForSignal(opts.TelemetryRoutes, rs.signal)
+		if len(routes) == 0 {
+			continue
+		}
+
+		queueSize := common.BatchingMaxQueueSize / (countPipelinesForSignal(opts, rs.signalType) + countOutputRoutes(opts, rs.signalType) + len(routes))
+
+		for _, route := range routes {
+			routeRef := pipelines.TelemetryRouteRef(&route, rs.signalType)
+
+			if shouldEnableRouteOAuth2(&route) {
+				if err := b.addRouteOAuth2Extension(ctx, builder, &route, routeRef); err != nil {
+					return err
+				}
+			}
+
+			addServiceAccountTokenAuthExtension(builder, route.Spec.Output.OTLP, routeRef)
+
+			if err := builder.AddServicePipeline(ctx, &route, formatRouteServicePipelineID(rs.signal, routeRef),
+				b.addRouteOTLPReceiver(builder, opts),
+				b.addRouteMemoryLimiterProcessor(builder),
+				b.addRouteDropUnknownServiceNameProcessor(builder, opts),
+				b.addRouteDropSenderPodIdentityProcessor(builder),
+				b.addRouteK8sAttributesProcessor(builder, opts),
+				b.addRouteRestoreOtelServiceAttrsProcessor(builder, opts),
+				b.addRouteIstioNoiseFilterProcessor(builder, rs),
+				b.addRouteInsertClusterAttributesProcessor(builder, opts),
+				b.addRouteServiceEnrichmentProcessor(builder, opts),
+				b.addRouteNamespaceFilterProcessor(builder, rs),
+				b.addRouteDropKymaAttributesProcessor(builder),
+				b.addRouteBatchProcessor(builder, rs),
+				b.addRouteOTLPExporter(builder, routeRef, queueSize),
+			); err != nil {
+				return fmt.Errorf("failed to add telemetry route service pipeline: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *Builder) addRouteOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDOTLPReceiver),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return otlpReceiverConfig(opts.Receiver)
+		},
+	)
+}
+
+func (b *Builder) addRouteMemoryLimiterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute]) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDMemoryLimiterProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return memoryLimiterConfig()
+		},
+	)
+}
+
+func (b *Builder) addRouteDropUnknownServiceNameProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropUnknownServiceNameProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			if opts.ServiceEnrichment != commonresources.AnnotationValueTelemetryServiceEnrichmentOtel {
+				return nil
+			}
+
+			return common.AllSignalsTransformProcessor(common.DropUnknownServiceNameProcessorStatements())
+		},
+	)
+}
+
+// addRouteDropSenderPodIdentityProcessor adds the processor that drops the pod and namespace attributes set by the sender, so that the k8s_attributes processor
+// resolves them from the connection. Otherwise, a workload could claim another namespace and have its data shipped by the routes of that namespace.
+func (b *Builder) addRouteDropSenderPodIdentityProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute]) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropSenderPodIdentityProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return common.AllSignalsTransformProcessor(common.DropSenderPodIdentityProcessorStatements())
+		},
+	)
+}
+
+func (b *Builder) addRouteK8sAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDK8sAttributesProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return k8sAttributesProcessorConfig(opts)
+		},
+	)
+}
+
+func (b *Builder) addRouteRestoreOtelServiceAttrsProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDRestoreOtelServiceAttrsProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			if opts.ServiceEnrichment != commonresources.AnnotationValueTelemetryServiceEnrichmentOtel {
+				return nil
+			}
+
+			return common.AllSignalsTransformProcessor(common.RestoreOtelServiceAnnotationsProcessorStatements())
+		},
+	)
+}
+
+func (b *Builder) addRouteIstioNoiseFilterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], rs routeSignal) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDIstioNoiseFilterProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			// Metric pipelines don't filter Istio noise either
+			if rs.signal == telemetryv1beta1.TelemetryRouteSignalMetrics {
+				return nil
+			}
+
+			return istioNoiseFilterProcessorConfig()
+		},
+	)
+}
+
+func (b *Builder) addRouteInsertClusterAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDInsertClusterAttributesProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			transformStatements := common.InsertClusterAttributesProcessorStatements(opts.Cluster)
+			return common.AllSignalsTransformProcessor(transformStatements)
+		},
+	)
+}
+
+func (b *Builder) addRouteServiceEnrichmentProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], opts BuildOptions) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDServiceEnrichmentProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return serviceEnrichmentProcessorConfig(opts)
+		},
+	)
+}
+
+// addRouteNamespaceFilterProcessor adds the filter processor that confines a route to its own namespace.
+// It must be placed after the k8s_attributes processor, so that the namespace attribute is resolved from the connection of the sender before it is filtered.
+func (b *Builder) addRouteNamespaceFilterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], rs routeSignal) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		func(tr *telemetryv1beta1.TelemetryRoute) string {
+			return common.ComponentIDTelemetryRouteNamespaceFilterProcessor(pipelines.TelemetryRouteRef(tr, rs.signalType))
+		},
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			return routeNamespaceFilterProcessorConfig(tr.Namespace, rs.signal)
+		},
+	)
+}
+
+func (b *Builder) addRouteDropKymaAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute]) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropKymaAttributesProcessor),
+		func(tr *telemetryv1beta1.TelemetryRoute) any {
+			transformStatements := common.DropKymaAttributesProcessorStatements()
+			return common.AllSignalsTransformProcessor(transformStatements)
+		},
+	)
+}
+
+func (b *Builder) addRouteBatchProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], rs routeSignal) buildRouteComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDBatchProcessor),
+		func(_ *telemetryv1beta1.TelemetryRoute) any {
+			return &common.BatchProcessorConfig{
+				SendBatchSize:    rs.batchSize,
+				Timeout:          "10s",
+				SendBatchMaxSize: rs.batchSize,
+			}
+		},
+	)
+}
+
+func (b *Builder) addRouteOTLPExporter(builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], routeRef pipelines.PipelineRef, queueSize int) buildRouteComponentFunc {
+	return builder.AddExporter(
+		func(tr *telemetryv1beta1.TelemetryRoute) string {
+			return common.ComponentIDOTLPExporter(tr.Spec.Output.OTLP.Protocol, routeRef)
+		},
+		func(ctx context.Context, tr *telemetryv1beta1.TelemetryRoute) (any, common.EnvVars, error) {
+			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
+				b.Reader,
+				tr.Spec.Output.OTLP,
+				routeRef,
+				common.NewSendingQueue(queueSize),
+			)
+
+			return otlpExporterBuilder.OTLPExporter(ctx)
+		},
+	)
+}
+
+func (b *Builder) addRouteOAuth2Extension(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.TelemetryRoute], route *telemetryv1beta1.TelemetryRoute, routeRef pipelines.PipelineRef) error {
+	oauth2ExtensionID := common.ComponentIDOAuth2Extension(routeRef)
+
+	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
+		b.Reader,
+		route.Spec.Output.OTLP.Authentication.OAuth2,
+		routeRef,
+	).OAuth2Extension(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build OAuth2 extension for telemetry route %s/%s: %w", route.Namespace, route.Name, err)
+	}
+
+	builder.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+
+	return nil
+}
+
+// Telemetry route helper functions
+
+// routeNamespaceFilterProcessorConfig drops all data that does not originate from the given namespace, including data without a namespace.
+func routeNamespaceFilterProcessorConfig(namespace string, signal telemetryv1beta1.TelemetryRouteSignal) *common.FilterProcessorConfig {
+	filters := []telemetryv1beta1.FilterSpec{{
+		// A missing namespace attribute does not equal the namespace either, so such data is dropped as well
+		Conditions: []string{common.ResourceAttributeNotEquals(common.K8sNamespaceName, namespace)},
+	}}
+
+	switch signal {
+	case telemetryv1beta1.TelemetryRouteSignalLogs:
+		return common.LogFilterProcessor(filters)
+	case telemetryv1beta1.TelemetryRouteSignalMetrics:
+		return common.MetricFilterProcessor(filters)
+	default:
+		return common.TraceFilterProcessor(filters)
+	}
+}
+
+func telemetryRoutesForSignal(routes []telemetryv1beta1.TelemetryRoute, signal telemetryv1beta1.TelemetryRouteSignal) []telemetryv1beta1.TelemetryRoute {
+	var result []telemetryv1beta1.TelemetryRoute
+
+	for _, route := range routes {
+		if slices.Contains(route.Spec.Signals, signal) {
+			result = append(result, route)
+		}
+	}
+
+	return result
+}
+
+func countPipelinesForSignal(opts BuildOptions, signalType pipelines.SignalType) int {
+	switch signalType { //nolint:exhaustive // FluentBit log pipelines are not handled by the OTLP Gateway
+	case pipelines.SignalTypeTrace:
+		return len(opts.TracePipelines)
+	case pipelines.SignalTypeLog:
+		return len(opts.LogPipelines)
+	case pipelines.SignalTypeMetric:
+		return len(opts.MetricPipelines)
+	default:
+		return 0
+	}
+}
+
+func shouldEnableRouteOAuth2(tr *telemetryv1beta1.TelemetryRoute) bool {
+	return tr.Spec.Output.OTLP.Authentication != nil && tr.Spec.Output.OTLP.Authentication.OAuth2 != nil
+}
+
+func formatRouteServicePipelineID(signal telemetryv1beta1.TelemetryRouteSignal, routeRef pipelines.PipelineRef) string {
+	return fmt.Sprintf("%s/route-%s", signal, routeRef.Name())
+}
@@ -0,0 +1,197 @@
+package otlpgateway
+
+import (
+	"context"
+	"slices"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
+)
+
+const (
+	// tapQueueSize is the number of batches the tap exporter buffers. The tapped data is sampled before it is queued and the tap is removed
+	// once the requested number of records is captured, so the queue only needs to absorb short delays of the manager.
+	// A full queue rejects data, which the forward connector reports back to the tapped pipeline.
+	tapQueueSize = 100
+
+	// tapMaxElapsedTime limits the retries of the tap exporter. Tapped data is only a sample, and retrying for longer
+	// would fill the queue while the manager is unavailable.
+	tapMaxElapsedTime = "30s"
+)
+
+// tapSamplingKeys are the keys by which the data of a tapped pipeline is sampled. Spans are sampled by trace, so that a tap shows complete traces.
+var tapSamplingKeys = map[pipelines.SignalType]string{
+	pipelines.SignalTypeTrace:  "span.trace_id.string",
+	pipelines.SignalTypeLog:    `Concat([log.time_unix_nano, log.observed_time_unix_nano, log.body], "")`,
+	pipelines.SignalTypeMetric: `Concat([metric.name, datapoint.attributes, datapoint.time_unix_nano], "")`,
+}
+
+// TapSink describes the sink endpoint of the manager and the open taps, which send their data to it.
+type TapSink struct {
+	// CAPem is the PEM-encoded CA certificate, which signs the serving certificate of the sink
+	CAPem string
+	// Taps are the open taps of the pipelines with enabled tap
+	Taps []tap.Tap
+}
+
+// tapOf returns the open tap of the given pipeline, or nil if the pipeline is not tapped.
+func (s *TapSink) tapOf(pipelineRef pipelines.PipelineRef) *tap.Tap {
+	if s == nil {
+		return nil
+	}
+
+	i := slices.IndexFunc(s.Taps, func(t tap.Tap) bool {
+		return t.SignalType == pipelineRef.SignalType() && t.PipelineName == pipelineRef.Name()
+	})
+	if i < 0 {
+		return nil
+	}
+
+	return &s.Taps[i]
+}
+
+// pipelineStages groups the components of a per-pipeline service pipeline by the stages that can be tapped.
+type pipelineStages[T any] struct {
+	// input contains the receivers
+	input []common.BuildComponentFunc[T]
+	// transform contains the processors up to and including the user-defined transform processor
+	transform []common.BuildComponentFunc[T]
+	// export contains the remaining processors up to and including the user-defined filter and the processors that prepare the data for export
+	export []common.BuildComponentFunc[T]
+	// output contains the batch processor and the exporters
+	output []common.BuildComponentFunc[T]
+	// redaction contains the processors of the transform stage that redact sensitive data. They are applied to the data tapped
+	// after the input as well, so that a tap never exposes data that the pipeline redacts.
+	redaction []common.BuildComponentFunc[T]
+}
+
+// addTappableServicePipeline adds the service pipeline of a pipeline. Unless the pipeline is tapped, all stages form a single service pipeline.
+// While a tap is open for a pipeline with enabled tap, the service pipeline is split after the tapped stage by a forward connector,
+// which also feeds the sampled tap exporter:
+//
+//	<pipelineID>:          input [→ transform [→ export]] → forward/tap_<pipeline>
+//	<pipelineID>_output:   forward/tap_<pipeline> → remaining stages → output
+//	<pipelineID>_tap:      forward/tap_<pipeline> [→ redaction] [→ filter/tap_<pipeline>] → otlp_http/tap_<pipeline>
+//
+// The underscore cannot be part of a pipeline name, so the additional service pipeline IDs do not collide with other pipelines.
+func addTappableServicePipeline[T any](ctx context.Context, builder *common.ComponentBuilder[T], pipeline T, pipelineID string, pipelineRef pipelines.PipelineRef, sink *TapSink, tapEnabled bool, stages pipelineStages[T]) error {
+	t := sink.tapOf(pipelineRef)
+	if t == nil || !tapEnabled {
+		return builder.AddServicePipeline(ctx, pipeline, pipelineID, slices.Concat(stages.input, stages.transform, stages.export, stages.output)...)
+	}
+
+	var (
+		beforeTap = stages.input
+		afterTap  = slices.Concat(stages.transform, stages.export, stages.output)
+		tapped    []common.BuildComponentFunc[T]
+	)
+
+	switch t.Stage {
+	case tap.StageInput:
+		tapped = stages.redaction
+	case tap.StageTransform:
+		beforeTap = slices.Concat(stages.input, stages.transform)
+		afterTap = slices.Concat(stages.export, stages.output)
+	case tap.StageExport:
+		beforeTap = slices.Concat(stages.input, stages.transform, stages.export)
+		afterTap = stages.output
+	}
+
+	builder.AddExtension(
+		common.ComponentIDTapSinkAuthExtension,
+		common.ServiceAccountTokenAuthExtensionConfig(&telemetryv1beta1.ServiceAccountTokenAuthOptions{Audience: tap.SinkAudience}),
+		nil,
+	)
+
+	connectorID := builder.StaticComponentID(common.ComponentIDTapConnector(pipelineRef))
+	connectorExporter := builder.AddExporter(connectorID, func(ctx context.Context, _ T) (any, common.EnvVars, error) {
+		return &common.ForwardConnectorConfig{}, nil, nil
+	})
+	connectorReceiver := builder.AddReceiver(connectorID, func(_ T) any {
+		return &common.ForwardConnectorConfig{}
+	})
+
+	if t.SamplingRatio < 1 {
+		samplingCondition := common.NotSampled(tapSamplingKeys[t.SignalType], t.SamplingRatio)
+
+		tapped = append(tapped, builder.AddProcessor(
+			builder.StaticComponentID(common.ComponentIDTapSamplingProcessor(pipelineRef)),
+			func(_ T) any {
+				return tapSamplingProcessorConfig(t.SignalType, samplingCondition)
+			},
+		))
+	}
+
+	tapped = append(tapped, builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDTapExporter(pipelineRef)),
+		func(ctx context.Context, _ T) (any, common.EnvVars, error) {
+			return tapExporterConfig(t.Endpoint, sink.CAPem), nil, nil
+		},
+	))
+
+	servicePipelines := []struct {
+		id         string
+		components []common.BuildComponentFunc[T]
+	}{
+		{
+			id:         pipelineID,
+			components: slices.Concat(beforeTap, []common.BuildComponentFunc[T]{connectorExporter}),
+		},
+		{
+			id:         pipelineID + "_output",
+			components: slices.Concat([]common.BuildComponentFunc[T]{connectorReceiver}, afterTap),
+		},
+		{
+			id:         pipelineID + "_tap",
+			components: slices.Concat([]common.BuildComponentFunc[T]{connectorReceiver}, tapped),
+		},
+	}
+
+	for _, sp := range servicePipelines {
+		if err := builder.AddServicePipeline(ctx, pipeline, sp.id, sp.components...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func tapSamplingProcessorConfig(signalType pipelines.SignalType, condition string) *common.FilterProcessorConfig {
+	filters := []telemetryv1beta1.FilterSpec{{Conditions: []string{condition}}}
+
+	switch signalType {
+	case pipelines.SignalTypeLog:
+		return common.LogFilterProcessor(filters)
+	case pipelines.SignalTypeMetric:
+		return common.MetricFilterProcessor(filters)
+	default:
+		return common.TraceFilterProcessor(filters)
+	}
+}
+
+func tapExporterConfig(endpoint, caPem string) *TapExporterConfig {
+	return &TapExporterConfig{
+		Endpoint:    endpoint,
+		Encoding:    "proto",
+		Compression: "gzip",
+		// The manager serves the sink with the serving certificate of its webhook server, which is signed by the webhook CA
+		TLS: common.TLS{
+			CAPem: caPem,
+		},
+		Auth: &common.Auth{
+			Authenticator: common.ComponentIDTapSinkAuthExtension,
+		},
+		SendingQueue: common.SendingQueue{
+			Enabled:   true,
+			QueueSize: tapQueueSize,
+		},
+		RetryOnFailure: common.RetryOnFailure{
+			Enabled:         true,
+			InitialInterval: "1s",
+			MaxInterval:     "5s",
+			MaxElapsedTime:  tapMaxElapsedTime,
+		},
+	}
+}
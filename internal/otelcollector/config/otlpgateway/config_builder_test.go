@@ -5,19 +5,37 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
 )
 
 func TestBuild(t *testing.T) {
-	fakeClient := fake.NewClientBuilder().Build()
+	externalIngestionSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "external-ingestion",
+			Namespace: "kyma-system",
+		},
+		Data: map[string][]byte{
+			"token":   []byte("my-token"),
+			"tls.crt": []byte("my-cert"),
+			"tls.key": []byte("my-key"),
+			"ca.crt":  []byte("my-ca"),
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(externalIngestionSecret).Build()
 	sut := Builder{Reader: fakeClient}
 
 	tests := []struct {
@@ -29,6 +47,10 @@ func TestBuild(t *testing.T) {
 		serviceEnrichment string
 		moduleVersion     string
 		vpaActive         bool
+		externalIngestion *operatorv1beta1.ExternalIngestionSpec
+		receiver          *operatorv1beta1.OTLPReceiverSpec
+		telemetryRoutes   []telemetryv1beta1.TelemetryRoute
+		tapSink           *TapSink
 	}{
 		{
 			name:           "gateway with VPA active - all signals",
@@ -102,6 +124,34 @@ func TestBuild(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "metric-pipeline with aggregation",
+			goldenFileName: "metric-aggregation.yaml",
+			moduleVersion:  "1.0.0",
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithOTLPInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithAggregation(telemetryv1beta1.MetricPipelineAggregation{
+						Interval:       &metav1.Duration{Duration: time.Minute},
+						DropAttributes: []string{"k8s.pod.name"},
+					}).
+					Build(),
+			},
+		},
+		{
+			name:           "metric-pipeline with prometheus output",
+			goldenFileName: "metric-prometheus-output.yaml",
+			moduleVersion:  "1.0.0",
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithOTLPInput(true).
+					WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{}).
+					Build(),
+			},
+		},
 		{
 			name:           "metric-comprehensive setup",
 			goldenFileName: "metric-comprehensive.yaml",
@@ -147,6 +197,20 @@ func TestBuild(t *testing.T) {
 				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
 			},
 		},
+		{
+			name:           "suspended pipelines are left out",
+			goldenFileName: "trace-single-pipeline.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+				testutils.NewTracePipelineBuilder().WithName("suspended-trace").WithSuspend(true).Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().WithName("suspended-log").WithOTLPOutput().WithSuspend(true).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("suspended-metric").WithMetricPipelineOTLPOutput().WithSuspend(true).Build(),
+			},
+		},
 		// Comprehensive test cases
 		{
 			name:           "single pipeline",
@@ -502,6 +566,31 @@ func TestBuild(t *testing.T) {
 				testutils.NewLogPipelineBuilder().WithName("test-log-2").WithOTLPOutput().Build(),
 			},
 		},
+		{
+			name:           "pipelines with heartbeat",
+			goldenFileName: "heartbeat.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("trace-heartbeat").WithHeartbeat(true).Build(),
+				testutils.NewTracePipelineBuilder().WithName("trace-no-heartbeat").Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-heartbeat").
+					WithOTLPInput(true, testutils.IncludeNamespaces("default")).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithHeartbeat(true).Build(),
+				testutils.NewLogPipelineBuilder().WithName("log-no-heartbeat").WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-heartbeat").
+					WithOTLPInput(false).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithHeartbeat(true).Build(),
+				testutils.NewMetricPipelineBuilder().WithName("metric-no-heartbeat").WithOTLPInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+		},
 		{
 			name:           "all-signals-multi-backend",
 			goldenFileName: "all-signals-multi-backend.yaml",
@@ -578,6 +667,282 @@ func TestBuild(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "external ingestion with bearer token",
+			goldenFileName: "external-ingestion-bearer-token.yaml",
+			moduleVersion:  "1.0.0",
+			externalIngestion: &operatorv1beta1.ExternalIngestionSpec{
+				Tenant: "my-tenant",
+				Authentication: operatorv1beta1.ExternalIngestionAuthentication{
+					BearerToken: &operatorv1beta1.SecretKeyRef{Name: "external-ingestion", Namespace: "kyma-system", Key: "token"},
+				},
+			},
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().WithName("test-log").WithOTLPOutput().Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-metric").WithOTLPInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+		},
+		{
+			name:           "external ingestion with mTLS",
+			goldenFileName: "external-ingestion-mtls.yaml",
+			moduleVersion:  "1.0.0",
+			externalIngestion: &operatorv1beta1.ExternalIngestionSpec{
+				Tenant: "my-tenant",
+				Authentication: operatorv1beta1.ExternalIngestionAuthentication{
+					MTLS: &operatorv1beta1.ExternalIngestionMTLS{
+						ClientCA: operatorv1beta1.SecretKeyRef{Name: "external-ingestion", Namespace: "kyma-system", Key: "ca.crt"},
+					},
+				},
+				TLS: &operatorv1beta1.ExternalIngestionTLS{
+					Cert: operatorv1beta1.SecretKeyRef{Name: "external-ingestion", Namespace: "kyma-system", Key: "tls.crt"},
+					Key:  operatorv1beta1.SecretKeyRef{Name: "external-ingestion", Namespace: "kyma-system", Key: "tls.key"},
+				},
+			},
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+			},
+		},
+		{
+			name:           "otlp receiver options",
+			goldenFileName: "otlp-receiver-options.yaml",
+			moduleVersion:  "1.0.0",
+			receiver: &operatorv1beta1.OTLPReceiverSpec{
+				GRPC: &operatorv1beta1.OTLPReceiverGRPCSpec{
+					MaxRecvMsgSizeMiB: new(int32(16)),
+					Keepalive: &operatorv1beta1.OTLPReceiverKeepalive{
+						Time:             &metav1.Duration{Duration: 30 * time.Second},
+						Timeout:          &metav1.Duration{Duration: 10 * time.Second},
+						MaxConnectionAge: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+				HTTP: &operatorv1beta1.OTLPReceiverHTTPSpec{
+					MaxRequestBodySizeMiB: new(int32(8)),
+					CORS: &operatorv1beta1.OTLPReceiverCORS{
+						AllowedOrigins: []string{"https://shop.example.com", "https://*.example.com"},
+						AllowedHeaders: []string{"X-Request-Id"},
+					},
+				},
+			},
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().WithName("test-log").WithOTLPOutput().Build(),
+			},
+		},
+		{
+			name:           "telemetry routes",
+			goldenFileName: "telemetry-routes.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+			},
+			telemetryRoutes: []telemetryv1beta1.TelemetryRoute{
+				testutils.NewTelemetryRouteBuilder().
+					WithName("backend").
+					WithNamespace("team-b").
+					WithSignals(telemetryv1beta1.TelemetryRouteSignalMetrics).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://team-b.example.com"), testutils.OTLPProtocol(telemetryv1beta1.OTLPProtocolHTTP)).
+					Build(),
+				testutils.NewTelemetryRouteBuilder().
+					WithName("backend").
+					WithNamespace("team-a").
+					WithSignals(telemetryv1beta1.TelemetryRouteSignalTraces, telemetryv1beta1.TelemetryRouteSignalLogs).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://team-a.example.com:4317")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with redaction",
+			goldenFileName: "redaction.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-redacted").
+					WithRedaction(telemetryv1beta1.Redaction{
+						Presets:           []telemetryv1beta1.RedactionPreset{telemetryv1beta1.RedactionPresetJWT, telemetryv1beta1.RedactionPresetBearerToken},
+						AllowedAttributes: []string{"http.request.method", "http.route"},
+					}).
+					WithTransform(telemetryv1beta1.TransformSpec{
+						Statements: []string{"set(span.attributes[\"redacted\"], true)"},
+					}).
+					Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-redacted").
+					WithOTLPInput(true).
+					WithOTLPOutput().
+					WithRedaction(telemetryv1beta1.Redaction{
+						Presets:          []telemetryv1beta1.RedactionPreset{telemetryv1beta1.RedactionPresetEmail},
+						Patterns:         []string{`customer-[0-9]+`},
+						DeniedAttributes: []string{"user.id"},
+					}).
+					Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-redacted").
+					WithOTLPInput(true).
+					WithRedaction(telemetryv1beta1.Redaction{
+						DeniedAttributes: []string{"user.id"},
+					}).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with output routes",
+			goldenFileName: "output-routes.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-routed").
+					WithRoute("slow", []string{"span.end_time_unix_nano - span.start_time_unix_nano > 1000000000"},
+						testutils.OTLPEndpoint("https://slow-spans.example.com:4317"),
+					).
+					Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-routed").
+					WithOTLPInput(true).
+					WithOTLPOutput().
+					WithRoute("errors", []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"},
+						testutils.OTLPEndpoint("https://errors.example.com:4318"),
+						testutils.OTLPProtocol("http"),
+						testutils.OTLPBasicAuth("user", "password"),
+					).
+					WithRoute("audit", []string{`resource.attributes["k8s.namespace.name"] == "audit"`},
+						testutils.OTLPEndpoint("https://audit.example.com:4317"),
+						testutils.OTLPOAuth2(
+							testutils.OAuth2ClientID("client-id"),
+							testutils.OAuth2ClientSecret("client-secret"),
+							testutils.OAuth2TokenURL("https://auth.example.com/oauth2/token"),
+						),
+					).
+					Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-routed").
+					WithOTLPInput(true).
+					WithRoute("billing", []string{`metric.name == "billing.usage"`},
+						testutils.OTLPEndpoint("https://billing.example.com:4317"),
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with proxy",
+			goldenFileName: "proxy.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-proxy").
+					WithOTLPOutput(
+						testutils.OTLPEndpoint("https://traces.example.com"),
+						testutils.OTLPProtocol("http"),
+						testutils.OTLPProxyURL("http://proxy.corp.example.com:3128"),
+						testutils.OTLPProxyBasicAuth("proxy-user", "proxy-password"),
+					).
+					Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-proxy").
+					WithOTLPOutput(
+						testutils.OTLPEndpoint("https://logs.example.com"),
+						testutils.OTLPProtocol("http"),
+						testutils.OTLPProxyURL("https://proxy.corp.example.com:3129"),
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with service account token",
+			goldenFileName: "service-account-token.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-sa-token").
+					WithOTLPOutput(
+						testutils.OTLPEndpoint("https://traces.example.com"),
+						testutils.OTLPServiceAccountToken("https://traces.example.com"),
+					).
+					WithRoute("audit", []string{`span.attributes["audit"] == true`},
+						testutils.OTLPEndpoint("https://audit.example.com"),
+						testutils.OTLPServiceAccountToken("https://audit.example.com"),
+					).
+					Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-sa-token").
+					WithOTLPInput(true).
+					WithMetricPipelineOTLPOutput(
+						testutils.OTLPEndpoint("https://metrics.example.com"),
+						testutils.OTLPServiceAccountToken("https://traces.example.com"),
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with taps",
+			goldenFileName: "taps.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("trace-tapped").WithTap(true).Build(),
+				testutils.NewTracePipelineBuilder().WithName("trace-untapped").Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-tapped").
+					WithTap(true).
+					WithRedaction(telemetryv1beta1.Redaction{
+						Presets: []telemetryv1beta1.RedactionPreset{telemetryv1beta1.RedactionPresetEmail},
+					}).
+					WithOTLPOutput().
+					Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("metric-tapped").WithTap(true).WithOTLPInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+			tapSink: &TapSink{
+				CAPem: "my-ca",
+				Taps: []tap.Tap{
+					{
+						SignalType:    pipelines.SignalTypeLog,
+						PipelineName:  "log-tapped",
+						Stage:         tap.StageInput,
+						SamplingRatio: 1,
+						Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/log-session",
+					},
+					{
+						SignalType:    pipelines.SignalTypeMetric,
+						PipelineName:  "metric-tapped",
+						Stage:         tap.StageTransform,
+						SamplingRatio: 0.5,
+						Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/metric-session",
+					},
+					{
+						SignalType:    pipelines.SignalTypeTrace,
+						PipelineName:  "trace-tapped",
+						Stage:         tap.StageExport,
+						SamplingRatio: 0.1,
+						Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/trace-session",
+					},
+					{
+						// The tap of the pipeline is not enabled, so the tap is not attached
+						SignalType:    pipelines.SignalTypeTrace,
+						PipelineName:  "trace-untapped",
+						Stage:         tap.StageExport,
+						SamplingRatio: 1,
+						Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/untapped-session",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -586,6 +951,7 @@ func TestBuild(t *testing.T) {
 				TracePipelines:  tt.tracePipelines,
 				LogPipelines:    tt.logPipelines,
 				MetricPipelines: tt.metricPipelines,
+				TelemetryRoutes: tt.telemetryRoutes,
 				Cluster: common.ClusterOptions{
 					ClusterName:   "${KUBERNETES_SERVICE_HOST}",
 					CloudProvider: "test-cloud-provider",
@@ -594,6 +960,9 @@ func TestBuild(t *testing.T) {
 				ModuleVersion:     tt.moduleVersion,
 				GatewayNamespace:  "kyma-system",
 				VpaActive:         tt.vpaActive,
+				ExternalIngestion: tt.externalIngestion,
+				Receiver:          tt.receiver,
+				TapSink:           tt.tapSink,
 			}
 
 			config, _, err := sut.Build(context.Background(), buildOptions)
@@ -712,3 +1081,21 @@ func TestBuildConfigShuffled(t *testing.T) {
 
 	require.Equal(t, string(config1YAML), string(config2YAML), "config should be equal regardless of pipeline order")
 }
+
+func TestBuildExternalIngestionSecretNotFound(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	sut := Builder{Reader: fakeClient}
+
+	_, _, err := sut.Build(t.Context(), BuildOptions{
+		TracePipelines: []telemetryv1beta1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+		},
+		ExternalIngestion: &operatorv1beta1.ExternalIngestionSpec{
+			Tenant: "my-tenant",
+			Authentication: operatorv1beta1.ExternalIngestionAuthentication{
+				BearerToken: &operatorv1beta1.SecretKeyRef{Name: "external-ingestion", Namespace: "kyma-system", Key: "token"},
+			},
+		},
+	})
+	require.Error(t, err)
+}
@@ -3,23 +3,37 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 )
 
 // buildTracePipelines builds trace pipeline configuration and adds it to the shared config.
 func (b *Builder) buildTracePipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) error {
-	pipelines := opts.TracePipelines
-	if len(pipelines) == 0 {
+	tracePipelines := opts.TracePipelines
+	if len(tracePipelines) == 0 {
 		return nil
 	}
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	// The sending queue capacity is shared with the outputs of the output routes and with the telemetry routes of the same signal type
+	queueSize := common.BatchingMaxQueueSize / (len(tracePipelines) + countOutputRoutes(opts, pipelines.SignalTypeTrace) + len(telemetryRoutesForSignal(opts.TelemetryRoutes, telemetryv1beta1.TelemetryRouteSignalTraces)))
 
-	for _, pipeline := range pipelines {
+	// Input pipeline for external ingestion, forwarded to all trace pipelines
+	if opts.ExternalIngestion != nil {
+		if err := builder.AddServicePipeline(ctx, nil, "traces/input-external",
+			b.addTraceOTLPExternalReceiver(builder, opts),
+			b.addTraceInsertTenantAttributeProcessor(builder, opts),
+			b.addTraceExporterForExternalInputForwarder(builder),
+		); err != nil {
+			return fmt.Errorf("failed to add trace input-external service pipeline: %w", err)
+		}
+	}
+
+	for _, pipeline := range tracePipelines {
 		pipelineID := formatTraceServicePipelineID(&pipeline)
 
 		if shouldEnableTraceOAuth2(&pipeline) {
@@ -28,22 +42,47 @@ func (b *Builder) buildTracePipelines(ctx context.Context, builder *common.Compo
 			}
 		}
 
-		if err := builder.AddServicePipeline(ctx, &pipeline, pipelineID,
-			b.addTraceOTLPReceiver(builder),
-			b.addTraceMemoryLimiterProcessor(builder),
-			b.addDropIstioServiceEnrichmentProcessor(builder, opts),
-			b.addTraceDropUnknownServiceNameProcessor(builder, opts),
-			b.addTraceK8sAttributesProcessor(builder, opts),
-			b.addTraceRestoreOtelServiceAttrsProcessor(builder, opts),
-			b.addTraceIstioNoiseFilterProcessor(builder),
-			b.addTraceInsertClusterAttributesProcessor(builder, opts),
-			b.addTraceServiceEnrichmentProcessor(builder, opts),
-			b.addTraceDropKymaAttributesProcessor(builder),
-			b.addTraceUserDefinedTransformProcessor(builder),
-			b.addTraceUserDefinedFilterProcessor(builder),
-			b.addTraceBatchProcessor(builder),
-			b.addTraceOTLPExporter(builder, queueSize),
-		); err != nil {
+		pipelineRef := pipelines.TracePipelineRef(&pipeline)
+
+		addServiceAccountTokenAuthExtension(builder, pipeline.Spec.Output.OTLP, pipelineRef)
+
+		outputStage, err := addOutputStage(ctx, b, builder, &pipeline, pipelineID, pipelineRef, pipeline.Spec.Routes, queueSize, outputRouting[*telemetryv1beta1.TracePipeline]{
+			context:         "span",
+			routesProcessor: common.TraceOutputRoutesProcessor,
+			batchProcessor:  b.addTraceBatchProcessor(builder),
+			defaultOutput:   []buildTraceComponentFunc{b.addTraceOTLPExporter(builder, queueSize)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add trace output service pipelines: %w", err)
+		}
+
+		if err := addTappableServicePipeline(ctx, builder, &pipeline, pipelineID, pipelineRef, opts.TapSink, sharedtypesutils.IsTapEnabled(pipeline.Spec.Tap), pipelineStages[*telemetryv1beta1.TracePipeline]{
+			input: []buildTraceComponentFunc{
+				b.addTraceOTLPReceiver(builder, opts),
+				b.addTraceReceiverForExternalInputForwarder(builder, opts),
+			},
+			transform: []buildTraceComponentFunc{
+				b.addTraceMemoryLimiterProcessor(builder),
+				b.addTraceDropHeartbeatProcessor(builder, opts),
+				b.addDropIstioServiceEnrichmentProcessor(builder, opts),
+				b.addTraceDropUnknownServiceNameProcessor(builder, opts),
+				b.addTraceK8sAttributesProcessor(builder, opts),
+				b.addTraceRestoreOtelServiceAttrsProcessor(builder, opts),
+				b.addTraceIstioNoiseFilterProcessor(builder),
+				b.addTraceInsertClusterAttributesProcessor(builder, opts),
+				b.addTraceServiceEnrichmentProcessor(builder, opts),
+				b.addTraceDropKymaAttributesProcessor(builder),
+				b.addTraceRedactionProcessor(builder),
+				b.addTraceUserDefinedTransformProcessor(builder),
+			},
+			export: []buildTraceComponentFunc{
+				b.addTraceUserDefinedFilterProcessor(builder),
+			},
+			output: outputStage,
+			redaction: []buildTraceComponentFunc{
+				b.addTraceRedactionProcessor(builder),
+			},
+		}); err != nil {
 			return fmt.Errorf("failed to add trace service pipeline: %w", err)
 		}
 	}
@@ -51,11 +90,51 @@ func (b *Builder) buildTracePipelines(ctx context.Context, builder *common.Compo
 	return nil
 }
 
-func (b *Builder) addTraceOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+func (b *Builder) addTraceOTLPReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
 	return builder.AddReceiver(
 		builder.StaticComponentID(common.ComponentIDOTLPReceiver),
 		func(tp *telemetryv1beta1.TracePipeline) any {
-			return otlpReceiverConfig()
+			return otlpReceiverConfig(opts.Receiver)
+		},
+	)
+}
+
+func (b *Builder) addTraceOTLPExternalReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDOTLPExternalReceiver),
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			return otlpExternalReceiverConfig(opts.ExternalIngestion)
+		},
+	)
+}
+
+func (b *Builder) addTraceInsertTenantAttributeProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDInsertTenantAttributeProcessor),
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			return insertTenantAttributeProcessorConfig(opts.ExternalIngestion)
+		},
+	)
+}
+
+func (b *Builder) addTraceExporterForExternalInputForwarder(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDExternalInputConnector),
+		func(ctx context.Context, tp *telemetryv1beta1.TracePipeline) (any, common.EnvVars, error) {
+			return &common.ForwardConnectorConfig{}, nil, nil
+		},
+	)
+}
+
+func (b *Builder) addTraceReceiverForExternalInputForwarder(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDExternalInputConnector),
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			if opts.ExternalIngestion == nil {
+				return nil
+			}
+
+			return &common.ForwardConnectorConfig{}
 		},
 	)
 }
@@ -70,6 +149,22 @@ func (b *Builder) addTraceMemoryLimiterProcessor(builder *common.ComponentBuilde
 	)
 }
 
+func (b *Builder) addTraceDropHeartbeatProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDDropHeartbeatProcessor),
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			heartbeatSent := slices.ContainsFunc(opts.TracePipelines, func(p telemetryv1beta1.TracePipeline) bool {
+				return sharedtypesutils.IsHeartbeatEnabled(p.Spec.Heartbeat)
+			})
+			if !heartbeatSent || sharedtypesutils.IsHeartbeatEnabled(tp.Spec.Heartbeat) {
+				return nil
+			}
+
+			return dropHeartbeatProcessor()
+		},
+	)
+}
+
 func (b *Builder) addDropIstioServiceEnrichmentProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDDropIstioServiceEnrichmentProcessor),
@@ -162,6 +257,20 @@ func (b *Builder) addTraceDropKymaAttributesProcessor(builder *common.ComponentB
 	)
 }
 
+func (b *Builder) addTraceRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		formatTraceRedactionProcessorID,
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			processor := common.TraceRedactionProcessor(tp.Spec.Redaction)
+			if processor == nil {
+				return nil
+			}
+
+			return processor
+		},
+	)
+}
+
 func (b *Builder) addTraceUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
 	return builder.AddProcessor(
 		formatTraceUserDefinedTransformProcessorID,
@@ -249,6 +358,10 @@ func formatTraceServicePipelineID(tp *telemetryv1beta1.TracePipeline) string {
 	return fmt.Sprintf("traces/%s", tp.Name)
 }
 
+func formatTraceRedactionProcessorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.TracePipelineRef(tp))
+}
+
 func formatTraceUserDefinedTransformProcessorID(tp *telemetryv1beta1.TracePipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.TracePipelineRef(tp))
 }
@@ -1,5 +1,7 @@
 package otlpgateway
 
+import "github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+
 // IstioEnrichmentProcessorConfig enriches Istio access logs with module version.
 type IstioEnrichmentProcessorConfig struct {
 	ScopeVersion string `yaml:"scope_version,omitempty"`
@@ -19,3 +21,14 @@ type ModuleGVR struct {
 	Version  string `yaml:"version"`
 	Resource string `yaml:"resource"`
 }
+
+// TapExporterConfig configures the OTLP HTTP exporter of a pipeline tap, which sends the tapped data to the manager.
+type TapExporterConfig struct {
+	Endpoint       string                `yaml:"endpoint"`
+	Encoding       string                `yaml:"encoding"`
+	Compression    string                `yaml:"compression"`
+	TLS            common.TLS            `yaml:"tls"`
+	Auth           *common.Auth          `yaml:"auth,omitempty"`
+	SendingQueue   common.SendingQueue   `yaml:"sending_queue"`
+	RetryOnFailure common.RetryOnFailure `yaml:"retry_on_failure"`
+}
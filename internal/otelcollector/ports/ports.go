@@ -3,8 +3,12 @@ package ports
 const (
 	OTLPHTTP            int32 = 4318
 	OTLPGRPC            int32 = 4317
+	OTLPExternalHTTP    int32 = 4320
+	OTLPExternalGRPC    int32 = 4319
 	Metrics             int32 = 8888
 	HealthCheck         int32 = 13133
 	Pprof               int32 = 1777
 	IstioEnvoyTelemetry int32 = 15090
+	PrometheusExporter  int32 = 9464
+	ScrapeHealth        int32 = 8889
 )
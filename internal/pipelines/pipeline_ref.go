@@ -6,6 +6,7 @@ import telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetr
 type PipelineRef struct {
 	name       string
 	signalType SignalType
+	route      bool
 }
 
 func LogPipelineRef(lp *telemetryv1beta1.LogPipeline) PipelineRef {
@@ -20,6 +21,27 @@ func TracePipelineRef(tp *telemetryv1beta1.TracePipeline) PipelineRef {
 	return PipelineRef{name: tp.Name, signalType: SignalTypeTrace}
 }
 
+// TelemetryRouteRef identifies the part of a TelemetryRoute that handles the given signal type.
+// The name is prefixed with the namespace, so that routes with the same name in different namespaces are kept apart.
+// Example: namespace="team-a", name="my-route" → "team-a.my-route"
+func TelemetryRouteRef(tr *telemetryv1beta1.TelemetryRoute, signalType SignalType) PipelineRef {
+	return PipelineRef{name: TelemetryRouteName(tr), signalType: signalType, route: true}
+}
+
+// TelemetryRouteName returns the name under which the components of a TelemetryRoute are identified, for example, in the self-monitor metrics.
+// Example: namespace="team-a", name="my-route" → "team-a.my-route"
+func TelemetryRouteName(tr *telemetryv1beta1.TelemetryRoute) string {
+	return tr.Namespace + "." + tr.Name
+}
+
+// OutputRef identifies the output of the given route of a pipeline. The name is suffixed with the route name,
+// so that the components of the output are kept apart from the ones of the default output.
+// The underscore cannot be part of a pipeline name, so the suffixed name does not collide with other pipelines.
+// Example: name="my-pipeline", routeName="errors" → "my-pipeline_output-errors"
+func (r PipelineRef) OutputRef(routeName string) PipelineRef {
+	return PipelineRef{name: r.name + "_output-" + routeName, signalType: r.signalType, route: r.route}
+}
+
 func (r PipelineRef) Name() string {
 	return r.name
 }
@@ -28,13 +50,22 @@ func (r PipelineRef) SignalType() SignalType {
 	return r.signalType
 }
 
-// TypePrefix returns "<signalType>pipeline".
+// IsTelemetryRoute returns true if the ref identifies a TelemetryRoute.
+func (r PipelineRef) IsTelemetryRoute() bool {
+	return r.route
+}
+
+// TypePrefix returns "<signalType>pipeline", or "<signalType>route" for a TelemetryRoute.
 // Example: signalType="trace" → "tracepipeline"
 func (r PipelineRef) TypePrefix() string {
 	if r.signalType == "" {
 		return ""
 	}
 
+	if r.route {
+		return string(r.signalType) + "route"
+	}
+
 	return string(r.signalType) + "pipeline"
 }
 
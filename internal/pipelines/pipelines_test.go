@@ -42,6 +42,26 @@ func TestTracePipelineRef(t *testing.T) {
 	require.Equal(t, SignalTypeTrace, ref.SignalType())
 	require.Equal(t, "tracepipeline", ref.TypePrefix())
 	require.Equal(t, "tracepipeline-my-trace", ref.QualifiedName())
+	require.False(t, ref.IsTelemetryRoute())
+}
+
+func TestTelemetryRouteRef(t *testing.T) {
+	tr := &telemetryv1beta1.TelemetryRoute{ObjectMeta: metav1.ObjectMeta{Name: "my-route", Namespace: "team-a"}}
+	ref := TelemetryRouteRef(tr, SignalTypeLog)
+	require.Equal(t, "team-a.my-route", ref.Name())
+	require.Equal(t, SignalTypeLog, ref.SignalType())
+	require.Equal(t, "logroute", ref.TypePrefix())
+	require.Equal(t, "logroute-team-a.my-route", ref.QualifiedName())
+	require.True(t, ref.IsTelemetryRoute())
+}
+
+func TestOutputRef(t *testing.T) {
+	tp := &telemetryv1beta1.TracePipeline{ObjectMeta: metav1.ObjectMeta{Name: "my-trace"}}
+	ref := TracePipelineRef(tp).OutputRef("errors")
+	require.Equal(t, "my-trace_output-errors", ref.Name())
+	require.Equal(t, SignalTypeTrace, ref.SignalType())
+	require.Equal(t, "tracepipeline", ref.TypePrefix())
+	require.Equal(t, "tracepipeline-my-trace_output-errors", ref.QualifiedName())
 }
 
 func TestPipelineRefEmptySignalType(t *testing.T) {
@@ -0,0 +1,144 @@
+package commonstatus
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+// DefaultEventDeduplicationWindow is the period in which the same condition transition of an object is emitted as an Event only once.
+// It prevents a flapping probe from spamming Events.
+const DefaultEventDeduplicationWindow = 10 * time.Minute
+
+// eventAction is the action reported in the emitted Events.
+const eventAction = "UpdateStatus"
+
+// EventRecorder emits Kubernetes Events for status condition transitions.
+type EventRecorder interface {
+	// RecordConditionTransitions emits an Event for every condition whose status or reason differs between oldConditions and newConditions.
+	RecordConditionTransitions(obj client.Object, oldConditions, newConditions []metav1.Condition)
+}
+
+// conditionTypesWithEvents are the condition types whose transitions are emitted as Events.
+var conditionTypesWithEvents = []string{
+	conditions.TypeConfigurationGenerated,
+	conditions.TypeGatewayHealthy,
+	conditions.TypeAgentHealthy,
+	conditions.TypeFlowHealthy,
+	conditions.TypeLogComponentsHealthy,
+	conditions.TypeMetricComponentsHealthy,
+	conditions.TypeTraceComponentsHealthy,
+}
+
+type transitionKey struct {
+	uid           types.UID
+	conditionType string
+	status        metav1.ConditionStatus
+	reason        string
+}
+
+// ConditionEventRecorder emits Kubernetes Events on the reconciled objects when one of their health conditions transitions.
+// Events with status True are of type Normal, all others are of type Warning. Reason and message of the Event are taken from the condition.
+// A transition to the same status and reason is emitted only once per deduplication window.
+type ConditionEventRecorder struct {
+	recorder          events.EventRecorder
+	deduplicateWindow time.Duration
+	now               func() time.Time
+
+	mu          sync.Mutex
+	lastEmitted map[transitionKey]time.Time
+}
+
+// ConditionEventRecorderOption configures the ConditionEventRecorder during initialization.
+type ConditionEventRecorderOption func(*ConditionEventRecorder)
+
+// WithDeduplicationWindow sets the period in which the same transition is emitted only once.
+func WithDeduplicationWindow(window time.Duration) ConditionEventRecorderOption {
+	return func(r *ConditionEventRecorder) {
+		r.deduplicateWindow = window
+	}
+}
+
+// WithClock sets the function used to determine the current time.
+func WithClock(now func() time.Time) ConditionEventRecorderOption {
+	return func(r *ConditionEventRecorder) {
+		r.now = now
+	}
+}
+
+// NewConditionEventRecorder creates a new ConditionEventRecorder that emits Events using the given recorder.
+func NewConditionEventRecorder(recorder events.EventRecorder, opts ...ConditionEventRecorderOption) *ConditionEventRecorder {
+	r := &ConditionEventRecorder{
+		recorder:          recorder,
+		deduplicateWindow: DefaultEventDeduplicationWindow,
+		now:               time.Now,
+		lastEmitted:       make(map[transitionKey]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *ConditionEventRecorder) RecordConditionTransitions(obj client.Object, oldConditions, newConditions []metav1.Condition) {
+	for _, condType := range conditionTypesWithEvents {
+		newCond := meta.FindStatusCondition(newConditions, condType)
+		if newCond == nil {
+			continue
+		}
+
+		oldCond := meta.FindStatusCondition(oldConditions, condType)
+		if oldCond != nil && oldCond.Status == newCond.Status && oldCond.Reason == newCond.Reason {
+			continue
+		}
+
+		if !r.shouldEmit(transitionKey{
+			uid:           obj.GetUID(),
+			conditionType: condType,
+			status:        newCond.Status,
+			reason:        newCond.Reason,
+		}) {
+			continue
+		}
+
+		eventType := corev1.EventTypeWarning
+		if newCond.Status == metav1.ConditionTrue {
+			eventType = corev1.EventTypeNormal
+		}
+
+		r.recorder.Eventf(obj, nil, eventType, newCond.Reason, eventAction, "%s: %s", condType, newCond.Message)
+	}
+}
+
+// shouldEmit returns true if the transition was not emitted within the deduplication window and records it as emitted.
+func (r *ConditionEventRecorder) shouldEmit(key transitionKey) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	// Drop expired entries so that the map does not grow with deleted objects
+	for k, emittedAt := range r.lastEmitted {
+		if now.Sub(emittedAt) >= r.deduplicateWindow {
+			delete(r.lastEmitted, k)
+		}
+	}
+
+	if _, found := r.lastEmitted[key]; found {
+		return false
+	}
+
+	r.lastEmitted[key] = now
+
+	return true
+}
@@ -0,0 +1,120 @@
+package commonstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestRecordConditionTransitions(t *testing.T) {
+	gatewayReady := metav1.Condition{
+		Type:    conditions.TypeGatewayHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  conditions.ReasonGatewayReady,
+		Message: "OTLP Gateway DaemonSet is ready",
+	}
+	gatewayNotReady := metav1.Condition{
+		Type:    conditions.TypeGatewayHealthy,
+		Status:  metav1.ConditionFalse,
+		Reason:  conditions.ReasonGatewayNotReady,
+		Message: "No Pods deployed",
+	}
+	unrelated := metav1.Condition{
+		Type:   "Unrelated",
+		Status: metav1.ConditionFalse,
+		Reason: "Unrelated",
+	}
+
+	tests := []struct {
+		name           string
+		oldConditions  []metav1.Condition
+		newConditions  []metav1.Condition
+		expectedEvents []string
+	}{
+		{
+			name:           "new condition",
+			newConditions:  []metav1.Condition{gatewayReady},
+			expectedEvents: []string{"Normal GatewayReady GatewayHealthy: OTLP Gateway DaemonSet is ready"},
+		},
+		{
+			name:           "status transition",
+			oldConditions:  []metav1.Condition{gatewayReady},
+			newConditions:  []metav1.Condition{gatewayNotReady},
+			expectedEvents: []string{"Warning GatewayNotReady GatewayHealthy: No Pods deployed"},
+		},
+		{
+			name:          "unchanged condition",
+			oldConditions: []metav1.Condition{gatewayReady},
+			newConditions: []metav1.Condition{gatewayReady},
+		},
+		{
+			name:          "condition type without events",
+			newConditions: []metav1.Condition{unrelated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRecorder := events.NewFakeRecorder(10)
+			sut := NewConditionEventRecorder(fakeRecorder)
+			pipeline := testutils.NewTracePipelineBuilder().Build()
+
+			sut.RecordConditionTransitions(&pipeline, tt.oldConditions, tt.newConditions)
+
+			require.Equal(t, tt.expectedEvents, drain(fakeRecorder))
+		})
+	}
+}
+
+func TestRecordConditionTransitions_Deduplication(t *testing.T) {
+	ready := []metav1.Condition{{Type: conditions.TypeFlowHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonSelfMonFlowHealthy}}
+	dropped := []metav1.Condition{{Type: conditions.TypeFlowHealthy, Status: metav1.ConditionFalse, Reason: conditions.ReasonSelfMonGatewayAllDataDropped}}
+
+	now := time.Now()
+	fakeRecorder := events.NewFakeRecorder(10)
+	sut := NewConditionEventRecorder(fakeRecorder,
+		WithDeduplicationWindow(time.Minute),
+		WithClock(func() time.Time { return now }),
+	)
+
+	pipeline := testutils.NewTracePipelineBuilder().Build()
+	pipeline.UID = "pipeline-uid"
+	otherPipeline := testutils.NewTracePipelineBuilder().Build()
+	otherPipeline.UID = "other-pipeline-uid"
+
+	// A flapping probe only emits the first transition in each direction
+	sut.RecordConditionTransitions(&pipeline, ready, dropped)
+	sut.RecordConditionTransitions(&pipeline, dropped, ready)
+	sut.RecordConditionTransitions(&pipeline, ready, dropped)
+	sut.RecordConditionTransitions(&pipeline, dropped, ready)
+	require.Len(t, drain(fakeRecorder), 2)
+
+	// Other objects are deduplicated independently
+	sut.RecordConditionTransitions(&otherPipeline, ready, dropped)
+	require.Len(t, drain(fakeRecorder), 1)
+
+	// After the deduplication window, the transition is emitted again
+	now = now.Add(time.Minute)
+
+	sut.RecordConditionTransitions(&pipeline, ready, dropped)
+	require.Len(t, drain(fakeRecorder), 1)
+}
+
+func drain(recorder *events.FakeRecorder) []string {
+	var result []string
+
+	for {
+		select {
+		case e := <-recorder.Events:
+			result = append(result, e)
+		default:
+			return result
+		}
+	}
+}
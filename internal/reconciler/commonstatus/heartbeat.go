@@ -0,0 +1,39 @@
+package commonstatus
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// HeartbeatTracker reports whether the synthetic heartbeat telemetry of a pipeline is delivered to the backend by the exporter of the pipeline.
+type HeartbeatTracker interface {
+	LastDelivered(signalType pipelines.SignalType, pipelineName string) time.Time
+	IsFailing(signalType pipelines.SignalType, pipelineName string) bool
+}
+
+// IsHeartbeatFailing returns true if the heartbeat of a pipeline is not delivered to the backend, either because the OTLP Gateway does not accept it,
+// or because the exporter of the pipeline does not send it.
+func IsHeartbeatFailing(tracker HeartbeatTracker, signalType pipelines.SignalType, pipelineName string) bool {
+	if tracker == nil {
+		return false
+	}
+
+	return tracker.IsFailing(signalType, pipelineName)
+}
+
+// LastHeartbeatDelivered returns the time of the last heartbeat of a pipeline that the exporter of the pipeline sent to the backend.
+func LastHeartbeatDelivered(tracker HeartbeatTracker, signalType pipelines.SignalType, pipelineName string, previous *metav1.Time) *metav1.Time {
+	if tracker == nil {
+		return previous
+	}
+
+	lastDelivered := tracker.LastDelivered(signalType, pipelineName)
+	if lastDelivered.IsZero() || (previous != nil && !lastDelivered.After(previous.Time)) {
+		return previous
+	}
+
+	return &metav1.Time{Time: lastDelivered}
+}
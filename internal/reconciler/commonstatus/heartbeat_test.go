@@ -0,0 +1,88 @@
+package commonstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
+)
+
+func TestIsHeartbeatFailing(t *testing.T) {
+	tests := []struct {
+		name     string
+		tracker  HeartbeatTracker
+		expected bool
+	}{
+		{
+			name:     "no tracker",
+			expected: false,
+		},
+		{
+			name:     "heartbeat delivered",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(time.Now(), false),
+			expected: false,
+		},
+		{
+			name:     "heartbeat not delivered",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsHeartbeatFailing(tt.tracker, pipelines.SignalTypeTrace, "cls"))
+		})
+	}
+}
+
+func TestLastHeartbeatDelivered(t *testing.T) {
+	previous := &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	lastDelivered := previous.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		tracker  HeartbeatTracker
+		previous *metav1.Time
+		expected *metav1.Time
+	}{
+		{
+			name:     "no tracker",
+			previous: previous,
+			expected: previous,
+		},
+		{
+			name:     "heartbeat delivered",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(lastDelivered, false),
+			previous: previous,
+			expected: &metav1.Time{Time: lastDelivered},
+		},
+		{
+			name:     "heartbeat failing after last delivery",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			previous: previous,
+			expected: &metav1.Time{Time: lastDelivered},
+		},
+		{
+			name:     "no heartbeat delivered yet",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(time.Time{}, false),
+			expected: nil,
+		},
+		{
+			name:     "heartbeat delivered before previous delivery",
+			tracker:  commonStatusStubs.NewHeartbeatTracker(previous.Add(-time.Minute), false),
+			previous: previous,
+			expected: previous,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, LastHeartbeatDelivered(tt.tracker, pipelines.SignalTypeTrace, "cls", tt.previous))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package stubs
+
+import (
+	"time"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+type HeartbeatTracker struct {
+	lastDelivered time.Time
+	failing       bool
+}
+
+func NewHeartbeatTracker(lastDelivered time.Time, failing bool) *HeartbeatTracker {
+	return &HeartbeatTracker{
+		lastDelivered: lastDelivered,
+		failing:       failing,
+	}
+}
+
+func (h *HeartbeatTracker) LastDelivered(signalType pipelines.SignalType, pipelineName string) time.Time {
+	return h.lastDelivered
+}
+
+func (h *HeartbeatTracker) IsFailing(signalType pipelines.SignalType, pipelineName string) bool {
+	return h.failing
+}
@@ -115,4 +115,8 @@ type PipelineLock interface {
 	// Returns nil if the owner holds a lock, or an error if it does not.
 	// This is used to determine if a pipeline is already registered and active.
 	IsLockHolder(ctx context.Context, owner metav1.Object) error
+
+	// ReleaseLock releases the lock held by the given owner, so that it no longer counts towards the maximum pipeline count.
+	// This is used for suspended pipelines.
+	ReleaseLock(ctx context.Context, owner metav1.Object) error
 }
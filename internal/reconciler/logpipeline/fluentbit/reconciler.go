@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/k8sclients"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/fluentbit"
 	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
@@ -39,6 +40,7 @@ type Reconciler struct {
 	pipelineLock        PipelineLock
 	pipelineValidator   PipelineValidator
 	errToMsgConverter   ErrorToMessageConverter
+	eventRecorder       commonstatus.EventRecorder
 }
 
 func (r *Reconciler) SupportedOutput() logpipelineutils.Mode {
@@ -118,6 +120,13 @@ func WithClient(client client.Client) Option {
 	}
 }
 
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
 // New creates a new Reconciler with the provided client and functional options.
 // All dependencies must be provided via functional options.
 func New(opts ...Option) *Reconciler {
@@ -166,6 +175,10 @@ func (r *Reconciler) IsReconcilable(ctx context.Context, pipeline *telemetryv1be
 		return false, nil
 	}
 
+	if pipeline.Spec.Suspend {
+		return false, nil
+	}
+
 	var appInputEnabled *bool
 
 	// Treat the pipeline as non-reconcilable if the Runtime input is explicitly disabled
@@ -194,7 +207,12 @@ func (r *Reconciler) IsReconcilable(ctx context.Context, pipeline *telemetryv1be
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
-	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
+	if pipeline.Spec.Suspend {
+		// A suspended pipeline is not part of the configuration, so it must not count towards the maximum pipeline count
+		if err := r.pipelineLock.ReleaseLock(ctx, pipeline); err != nil {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+	} else if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		if errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
 			logf.FromContext(ctx).V(1).Info("Skipping reconciliation: maximum pipeline count limit exceeded")
 			return nil
@@ -325,6 +343,10 @@ func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1
 }
 
 func (r *Reconciler) calculateRequeueAfterDuration(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) *time.Duration {
+	if pipeline.Spec.Suspend {
+		return nil
+	}
+
 	err := r.pipelineValidator.Validate(ctx, pipeline)
 
 	if errCertAboutToExpire, ok := errors.AsType[*tlscert.CertAboutToExpireError](err); ok {
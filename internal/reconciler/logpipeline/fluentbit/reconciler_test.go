@@ -72,6 +72,34 @@ func TestMaxPipelineLimit(t *testing.T) {
 		"No logs delivered to backend because LogPipeline specification is not applied to the configuration of Log Agent. Check the 'ConfigurationGenerated' condition for more details")
 }
 
+func TestSuspendedPipeline(t *testing.T) {
+	pipeline := testutils.NewLogPipelineBuilder().
+		WithCustomFilter("Name grep").
+		WithSuspend(true).
+		Build()
+	testClient := newTestClient(t, &pipeline)
+
+	pipelineLock := &logpipelinefluentbitmocks.PipelineLock{}
+	pipelineLock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil).Once()
+
+	reconciler := newTestReconciler(testClient,
+		WithPipelineLock(pipelineLock),
+		WithPipelineValidator(newTestValidator(WithValidatorPipelineLock(pipelineLock))),
+	)
+
+	result := reconcileAndGet(t, testClient, reconciler, pipeline.Name)
+	require.NoError(t, result.err)
+
+	assertCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonSuspended,
+		"Pipeline is suspended and not part of the collector configuration. Remove the 'spec.suspend' field to resume it")
+
+	pipelineLock.AssertExpectations(t)
+	pipelineLock.AssertNotCalled(t, "TryAcquireLock", mock.Anything, mock.Anything)
+}
+
 func TestTLSCertificateValidation(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -40,6 +41,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		return nil
 	}
 
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
 	var allErrors error = nil
 
 	if err := r.updateStatusUnsupportedMode(ctx, &pipeline); err != nil {
@@ -55,6 +58,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update LogPipeline status: %w", err))
+	} else if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(&pipeline, oldConditions, pipeline.Status.Conditions)
 	}
 
 	return allErrors
@@ -100,6 +105,10 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 		return metav1.ConditionFalse, conditions.ReasonNoFluentbitInFipsMode, conditions.MessageForFluentBitLogPipeline(conditions.ReasonNoFluentbitInFipsMode)
 	}
 
+	if pipeline.Spec.Suspend {
+		return metav1.ConditionFalse, conditions.ReasonSuspended, conditions.MessageForFluentBitLogPipeline(conditions.ReasonSuspended)
+	}
+
 	err := r.pipelineValidator.Validate(ctx, pipeline)
 	if err == nil {
 		return metav1.ConditionTrue, conditions.ReasonAgentConfigured, conditions.MessageForFluentBitLogPipeline(conditions.ReasonAgentConfigured)
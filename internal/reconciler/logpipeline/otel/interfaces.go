@@ -60,6 +60,11 @@ type AgentConfigBuilder interface {
 	Build(ctx context.Context, pipelines []telemetryv1beta1.LogPipeline, options logagent.BuildOptions) (*common.Config, common.EnvVars, error)
 }
 
+// AgentConfigValidator validates the generated agent configuration before it is rolled out.
+type AgentConfigValidator interface {
+	Validate(cfg *common.Config, envVars common.EnvVars) error
+}
+
 // AgentApplierDeleter manages the lifecycle of Log Agent Kubernetes resources.
 // It handles both creation/updates and cleanup of agent DaemonSets, ConfigMaps, and related resources.
 type AgentApplierDeleter interface {
@@ -97,6 +102,10 @@ type PipelineLock interface {
 	// Returns nil if the owner holds a lock, or an error if it does not.
 	// This is used to determine if a pipeline is already registered and active.
 	IsLockHolder(ctx context.Context, owner metav1.Object) error
+
+	// ReleaseLock releases the lock held by the given owner, so that it no longer counts towards the maximum pipeline count.
+	// This is used for suspended pipelines.
+	ReleaseLock(ctx context.Context, owner metav1.Object) error
 }
 
 // EndpointValidator validates log pipeline endpoint configurations.
@@ -143,3 +152,11 @@ type FilterSpecValidator interface {
 	// Returns an error if any filter is invalid or unsupported.
 	Validate(filters []telemetryv1beta1.FilterSpec) error
 }
+
+// CollectorConfigValidator validates the collector configuration generated for LogPipeline resources.
+// It detects pipelines that would make the shared collector reject its configuration and crash-loop.
+type CollectorConfigValidator interface {
+	// ValidateLogPipeline renders the collector configuration for the pipeline and validates it against the collector components.
+	// Returns a ConfigInvalidError if the collector would reject the configuration.
+	ValidateLogPipeline(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error
+}
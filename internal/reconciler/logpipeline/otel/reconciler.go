@@ -20,9 +20,11 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/logagent"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
 	k8sutils "github.com/kyma-project/telemetry-manager/internal/utils/k8s"
 	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
@@ -41,6 +43,7 @@ type Reconciler struct {
 	gatewayFlowHealthProber GatewayFlowHealthProber
 	gatewayProber           Prober
 	agentConfigBuilder      AgentConfigBuilder
+	agentConfigValidator    AgentConfigValidator
 	agentProber             Prober
 	agentApplierDeleter     AgentApplierDeleter
 	istioStatusChecker      IstioStatusChecker
@@ -49,6 +52,8 @@ type Reconciler struct {
 	pipelineLock            PipelineLock
 	pipelineValidator       *Validator
 	errToMessageConverter   ErrorToMessageConverter
+	eventRecorder           commonstatus.EventRecorder
+	heartbeatTracker        commonstatus.HeartbeatTracker
 }
 
 // Option is a functional option for configuring a Reconciler.
@@ -89,6 +94,14 @@ func WithAgentConfigBuilder(builder AgentConfigBuilder) Option {
 	}
 }
 
+// WithAgentConfigValidator sets the validator for the generated agent configuration.
+// Without a validator, every generated configuration is rolled out.
+func WithAgentConfigValidator(validator AgentConfigValidator) Option {
+	return func(r *Reconciler) {
+		r.agentConfigValidator = validator
+	}
+}
+
 // WithAgentProber sets the agent prober.
 func WithAgentProber(prober Prober) Option {
 	return func(r *Reconciler) {
@@ -152,6 +165,20 @@ func WithClient(client client.Client) Option {
 	}
 }
 
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
+// WithHeartbeatTracker sets the tracker that reports the delivery of the synthetic heartbeat telemetry.
+func WithHeartbeatTracker(tracker commonstatus.HeartbeatTracker) Option {
+	return func(r *Reconciler) {
+		r.heartbeatTracker = tracker
+	}
+}
+
 // New creates a new Reconciler with the provided client and functional options.
 // All dependencies must be provided via functional options.
 func New(opts ...Option) *Reconciler {
@@ -183,7 +210,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, pipeline *telemetryv1beta1.L
 
 	requeueAfter := r.calculateRequeueAfterDuration(ctx, pipeline)
 	if requeueAfter != nil {
-		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire", "RequeueAfter", requeueAfter.String())
+		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire or enabled heartbeat", "RequeueAfter", requeueAfter.String())
 		return ctrl.Result{RequeueAfter: *requeueAfter}, nil
 	}
 
@@ -195,7 +222,12 @@ func (r *Reconciler) SupportedOutput() logpipelineutils.Mode {
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
-	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
+	if pipeline.Spec.Suspend {
+		// A suspended pipeline is not part of the configuration, so it must not count towards the maximum pipeline count
+		if err := r.pipelineLock.ReleaseLock(ctx, pipeline); err != nil {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+	} else if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		if errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
 			logf.FromContext(ctx).V(1).Info("Skipping reconciliation: maximum pipeline count limit exceeded")
 			return nil
@@ -306,6 +338,10 @@ func (r *Reconciler) isReconcilable(ctx context.Context, pipeline *telemetryv1be
 		return false, nil
 	}
 
+	if pipeline.Spec.Suspend {
+		return false, nil
+	}
+
 	err := r.pipelineValidator.Validate(ctx, pipeline)
 
 	// Pipeline with a certificate that is about to expire is still considered reconcilable
@@ -361,6 +397,14 @@ func (r *Reconciler) reconcileAgent(ctx context.Context, pipeline *telemetryv1be
 		return fmt.Errorf("failed to build agent config: %w", err)
 	}
 
+	if r.agentConfigValidator != nil {
+		if err := r.agentConfigValidator.Validate(agentConfig, envVars); err != nil {
+			// Pipelines with an invalid configuration are already excluded by the pipeline validation, so the combined configuration is
+			// only invalid if the pipelines conflict with each other. The current configuration is kept running instead of crash-looping the agent.
+			return fmt.Errorf("generated Log Agent configuration is invalid, keeping the current configuration: %w", err)
+		}
+	}
+
 	agentConfigYAML, err := yaml.Marshal(agentConfig)
 	if err != nil {
 		return fmt.Errorf("failed to marshal agent config: %w", err)
@@ -377,12 +421,13 @@ func (r *Reconciler) reconcileAgent(ctx context.Context, pipeline *telemetryv1be
 		ctx,
 		k8sclients.NewOwnerReferenceSetter(r.Client, pipeline),
 		otelcollector.AgentApplyOptions{
-			IstioEnabled:        isIstioActive,
-			VpaCRDExists:        vpaCRDExists,
-			VpaEnabled:          isVpaEnabled,
-			VPAMaxAllowedMemory: vpaMaxAllowedMemory,
-			CollectorConfigYAML: string(agentConfigYAML),
-			CollectorEnvVars:    envVars,
+			IstioEnabled:                 isIstioActive,
+			VpaCRDExists:                 vpaCRDExists,
+			VpaEnabled:                   isVpaEnabled,
+			VPAMaxAllowedMemory:          vpaMaxAllowedMemory,
+			CollectorConfigYAML:          string(agentConfigYAML),
+			CollectorEnvVars:             envVars,
+			ServiceAccountTokenAudiences: serviceAccountTokenAudiences(allPipelines),
 		},
 	); err != nil {
 		return fmt.Errorf("failed to apply agent resources: %w", err)
@@ -391,11 +436,22 @@ func (r *Reconciler) reconcileAgent(ctx context.Context, pipeline *telemetryv1be
 	return nil
 }
 
+// serviceAccountTokenAudiences returns the audiences of the outputs with service account token authentication, for which a token is projected into the Log Agent
+func serviceAccountTokenAudiences(pipelines []telemetryv1beta1.LogPipeline) []string {
+	var outputs []*telemetryv1beta1.OTLPOutput
+
+	for i := range pipelines {
+		outputs = append(outputs, pipelines[i].Spec.Output.OTLP)
+	}
+
+	return common.ServiceAccountTokenAudiences(outputs...)
+}
+
 func (r *Reconciler) getPipelinesRequiringAgents(allPipelines []telemetryv1beta1.LogPipeline) []telemetryv1beta1.LogPipeline {
 	var pipelinesRequiringAgents = make([]telemetryv1beta1.LogPipeline, 0)
 
 	for i := range allPipelines {
-		if isLogAgentRequired(&allPipelines[i]) {
+		if logpipelineutils.IsAgentRequired(&allPipelines[i]) {
 			pipelinesRequiringAgents = append(pipelinesRequiringAgents, allPipelines[i])
 		}
 	}
@@ -403,12 +459,6 @@ func (r *Reconciler) getPipelinesRequiringAgents(allPipelines []telemetryv1beta1
 	return pipelinesRequiringAgents
 }
 
-func isLogAgentRequired(pipeline *telemetryv1beta1.LogPipeline) bool {
-	input := pipeline.Spec.Input
-
-	return input.Runtime != nil && input.Runtime.Enabled != nil && *input.Runtime.Enabled
-}
-
 func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []telemetryv1beta1.LogPipeline) {
 	for i := range pipelines {
 		pipeline := &pipelines[i]
@@ -454,12 +504,25 @@ func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1
 }
 
 func (r *Reconciler) calculateRequeueAfterDuration(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) *time.Duration {
+	if pipeline.Spec.Suspend {
+		return nil
+	}
+
 	err := r.pipelineValidator.Validate(ctx, pipeline)
 
 	if errCertAboutToExpire, ok := errors.AsType[*tlscert.CertAboutToExpireError](err); ok {
 		duration := time.Until(errCertAboutToExpire.Expiry)
+		if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+			duration = min(duration, heartbeat.Interval)
+		}
+
 		return &duration
 	}
 
+	// The heartbeat delivery is reported in the status, so that it must be refreshed regularly
+	if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		return new(heartbeat.Interval)
+	}
+
 	return nil
 }
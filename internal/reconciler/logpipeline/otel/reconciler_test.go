@@ -1,8 +1,10 @@
 package otel
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
@@ -17,11 +19,13 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/otel/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/workloadstatus"
 )
@@ -111,6 +115,34 @@ func TestAgentFlowHealthCondition(t *testing.T) {
 			expectedReason:  conditions.ReasonSelfMonAgentAllDataDropped,
 			expectedMessage: "Backend is not reachable or rejecting logs. All logs are dropped in Log Agent. See troubleshooting: " + conditions.LinkNoDataArriveAtBackend,
 		},
+		{
+			name: "buffer filling up",
+			probe: prober.OTelAgentProbeResult{
+				BufferFillingUp: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonAgentBufferFillingUp,
+			expectedMessage: "Buffer nearing capacity. Incoming log rate exceeds export rate. Logs are dropped in Log Agent once the buffer is full. See troubleshooting: " + conditions.LinkLogBufferFillingUp,
+		},
+		{
+			name: "no logs delivered",
+			probe: prober.OTelAgentProbeResult{
+				NoLogsDelivered: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonAgentNoLogsDelivered,
+			expectedMessage: "Backend is not reachable or rejecting logs. Logs are read by Log Agent but not delivered, and are buffered until the buffer is full. See troubleshooting: " + conditions.LinkNoDataArriveAtBackend,
+		},
+		{
+			name: "no logs delivered shadows buffer filling up",
+			probe: prober.OTelAgentProbeResult{
+				BufferFillingUp: true,
+				NoLogsDelivered: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonAgentNoLogsDelivered,
+			expectedMessage: "Backend is not reachable or rejecting logs. Logs are read by Log Agent but not delivered, and are buffered until the buffer is full. See troubleshooting: " + conditions.LinkNoDataArriveAtBackend,
+		},
 		{
 			name: "all data dropped shadows other problems",
 			probe: prober.OTelAgentProbeResult{
@@ -252,6 +284,90 @@ func TestGatewayFlowHealthCondition(t *testing.T) {
 	}
 }
 
+func TestHeartbeat(t *testing.T) {
+	lastDelivered := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                           string
+		heartbeatEnabled               bool
+		probe                          prober.OTelGatewayProbeResult
+		tracker                        commonstatus.HeartbeatTracker
+		expectedStatus                 metav1.ConditionStatus
+		expectedReason                 string
+		expectedLastHeartbeatDelivered *metav1.Time
+	}{
+		{
+			name:           "heartbeat disabled",
+			probe:          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:        commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: conditions.ReasonSelfMonFlowHealthy,
+		},
+		{
+			name:                           "heartbeat delivered",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, false),
+			expectedStatus:                 metav1.ConditionTrue,
+			expectedReason:                 conditions.ReasonSelfMonFlowHealthy,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+		},
+		{
+			name:             "heartbeat not delivered",
+			heartbeatEnabled: true,
+			probe:            prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:          commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus:   metav1.ConditionFalse,
+			expectedReason:   conditions.ReasonSelfMonHeartbeatFailing,
+		},
+		{
+			name:                           "heartbeat failing after last delivery",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedReason:                 conditions.ReasonSelfMonHeartbeatFailing,
+		},
+		{
+			name:                           "all data dropped takes precedence",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedReason:                 conditions.ReasonSelfMonGatewayAllDataDropped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().WithName("pipeline").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).WithOTLPInput(true).WithHeartbeat(tt.heartbeatEnabled).Build()
+			fakeClient := newTestClient(t, &pipeline)
+
+			gatewayFlowHeathProber := &mocks.GatewayFlowHealthProber{}
+			gatewayFlowHeathProber.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+			sut := newTestReconciler(fakeClient,
+				WithGatewayFlowHealthProber(gatewayFlowHeathProber),
+				WithHeartbeatTracker(tt.tracker))
+			result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+			require.NoError(t, result.err)
+
+			cond := meta.FindStatusCondition(result.pipeline.Status.Conditions, conditions.TypeFlowHealthy)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.expectedStatus, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+
+			if tt.expectedLastHeartbeatDelivered == nil {
+				require.Nil(t, result.pipeline.Status.LastHeartbeatDelivered)
+			} else {
+				require.NotNil(t, result.pipeline.Status.LastHeartbeatDelivered)
+				require.True(t, tt.expectedLastHeartbeatDelivered.Equal(result.pipeline.Status.LastHeartbeatDelivered))
+			}
+		})
+	}
+}
+
 func TestOTTLSpecValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -304,6 +420,85 @@ func TestOTTLSpecValidation(t *testing.T) {
 	}
 }
 
+func TestConfigGenerationFailed(t *testing.T) {
+	pipeline := testutils.NewLogPipelineBuilder().WithName("pipeline").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).WithRuntimeInput(true).Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+	agentApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	sut := newTestReconciler(fakeClient,
+		WithPipelineValidator(newTestValidator(
+			WithCollectorConfigValidator(stubs.NewCollectorConfigValidator(
+				&collectorconfig.ConfigInvalidError{Err: errors.New("invalid configuration of extension 'oauth2client/logpipeline-pipeline': client_secret is missing")},
+			)),
+		)),
+		WithAgentApplierDeleter(agentApplierDeleterMock),
+	)
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonConfigGenerationFailed,
+		"Generated collector configuration is invalid: invalid configuration of extension 'oauth2client/logpipeline-pipeline': client_secret is missing. The pipeline is excluded from the collector configuration until it is fixed. See troubleshooting: "+conditions.LinkConfigGenerationFailed,
+	)
+
+	// The pipeline is excluded, so no agent is required anymore
+	agentApplierDeleterMock.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+	agentApplierDeleterMock.AssertExpectations(t)
+}
+
+func TestInvalidAgentConfigIsNotApplied(t *testing.T) {
+	pipeline := testutils.NewLogPipelineBuilder().WithName("pipeline").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).WithRuntimeInput(true).Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+
+	sut := newTestReconciler(fakeClient,
+		WithAgentConfigValidator(stubs.NewAgentConfigValidator(
+			&collectorconfig.ConfigInvalidError{Err: errors.New("service pipeline 'logs/output-pipeline' has no exporters")},
+		)),
+		WithAgentApplierDeleter(agentApplierDeleterMock),
+	)
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.ErrorContains(t, result.err, "generated Log Agent configuration is invalid")
+
+	// The agent keeps running the current configuration
+	agentApplierDeleterMock.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+	agentApplierDeleterMock.AssertNotCalled(t, "DeleteResources", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSuspendedPipeline(t *testing.T) {
+	pipeline := testutils.NewLogPipelineBuilder().WithName("pipeline").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).WithRuntimeInput(true).WithSuspend(true).Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	pipelineLockMock := &mocks.PipelineLock{}
+	pipelineLockMock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil).Once()
+
+	agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+	agentApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	sut := newTestReconciler(fakeClient,
+		WithPipelineLock(pipelineLockMock),
+		WithAgentApplierDeleter(agentApplierDeleterMock),
+	)
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonSuspended,
+		"Pipeline is suspended and not part of the collector configuration. Remove the 'spec.suspend' field to resume it",
+	)
+
+	pipelineLockMock.AssertExpectations(t)
+	pipelineLockMock.AssertNotCalled(t, "TryAcquireLock", mock.Anything, mock.Anything)
+	agentApplierDeleterMock.AssertExpectations(t)
+}
+
 func TestAgentRequiredScenarios(t *testing.T) {
 	tests := []struct {
 		name                         string
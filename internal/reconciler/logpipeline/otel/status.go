@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -19,6 +20,9 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
@@ -40,6 +44,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		return nil
 	}
 
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
 	var allErrors error = nil
 
 	r.setGatewayHealthyCondition(ctx, &pipeline)
@@ -50,8 +56,12 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		allErrors = errors.Join(allErrors, err)
 	}
 
+	r.setLastHeartbeatDelivered(&pipeline)
+
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update LogPipeline status: %w", err))
+	} else if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(&pipeline, oldConditions, pipeline.Status.Conditions)
 	}
 
 	return allErrors
@@ -125,7 +135,9 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 		}
 
 		logf.FromContext(ctx).V(1).Info("Probed gateway flow health", "result", gatewayProbeResult)
-		gatewayReason = gatewayFlowHealthReasonFor(gatewayProbeResult)
+		heartbeatFailing := sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) &&
+			commonstatus.IsHeartbeatFailing(r.heartbeatTracker, pipelines.SignalTypeLog, pipeline.Name)
+		gatewayReason = gatewayFlowHealthReasonFor(gatewayProbeResult, heartbeatFailing)
 	}
 
 	// Combine results: worst status wins
@@ -137,9 +149,9 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 	return metav1.ConditionFalse, reason, nil
 }
 
-// requiresGatewayProbing returns true if the pipeline uses OTLP input
+// requiresGatewayProbing returns true if the pipeline uses OTLP input or enabled heartbeat, which is sent through the OTLP Gateway
 func requiresGatewayProbing(pipeline *telemetryv1beta1.LogPipeline) bool {
-	return pipeline.Spec.Input.OTLP != nil
+	return pipeline.Spec.Input.OTLP != nil || sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat)
 }
 
 // requiresAgentProbing returns true if the pipeline uses runtime input
@@ -148,10 +160,12 @@ func requiresAgentProbing(pipeline *telemetryv1beta1.LogPipeline) bool {
 }
 
 // gatewayFlowHealthReasonFor maps gateway probe results to condition reasons
-func gatewayFlowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
+func gatewayFlowHealthReasonFor(probeResult prober.OTelGatewayProbeResult, heartbeatFailing bool) string {
 	switch {
 	case probeResult.AllDataDropped:
 		return conditions.ReasonSelfMonGatewayAllDataDropped
+	case heartbeatFailing:
+		return conditions.ReasonSelfMonHeartbeatFailing
 	case probeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
 	case probeResult.Throttling:
@@ -163,7 +177,7 @@ func gatewayFlowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) strin
 
 // combineFlowHealthReasons returns the worst health reason between agent and gateway
 func combineFlowHealthReasons(agentReason, gatewayReason string) string {
-	// Priority: AllDataDropped > SomeDataDropped > BufferFilling > Throttling > Healthy
+	// Priority: AllDataDropped > HeartbeatFailing > SomeDataDropped > NoLogsDelivered > BufferFilling > Throttling > Healthy
 	reasons := []string{agentReason, gatewayReason}
 
 	for _, reason := range reasons {
@@ -173,6 +187,12 @@ func combineFlowHealthReasons(agentReason, gatewayReason string) string {
 		}
 	}
 
+	for _, reason := range reasons {
+		if reason == conditions.ReasonSelfMonHeartbeatFailing {
+			return reason
+		}
+	}
+
 	for _, reason := range reasons {
 		if reason == conditions.ReasonSelfMonAgentSomeDataDropped ||
 			reason == conditions.ReasonSelfMonGatewaySomeDataDropped {
@@ -180,6 +200,12 @@ func combineFlowHealthReasons(agentReason, gatewayReason string) string {
 		}
 	}
 
+	for _, reason := range reasons {
+		if reason == conditions.ReasonSelfMonAgentNoLogsDelivered {
+			return reason
+		}
+	}
+
 	for _, reason := range reasons {
 		if reason == conditions.ReasonSelfMonAgentBufferFillingUp {
 			return reason
@@ -201,6 +227,10 @@ func agentFlowHealthReasonFor(agentProbeResult prober.OTelAgentProbeResult) stri
 		return conditions.ReasonSelfMonAgentAllDataDropped
 	case agentProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonAgentSomeDataDropped
+	case agentProbeResult.NoLogsDelivered:
+		return conditions.ReasonSelfMonAgentNoLogsDelivered
+	case agentProbeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonAgentBufferFillingUp
 	default:
 		return conditions.ReasonSelfMonFlowHealthy
 	}
@@ -214,7 +244,7 @@ func (r *Reconciler) setAgentHealthyCondition(ctx context.Context, pipeline *tel
 		Message: conditions.MessageForOtelLogPipeline(conditions.ReasonLogAgentNotRequired),
 	}
 
-	if isLogAgentRequired(pipeline) {
+	if logpipelineutils.IsAgentRequired(pipeline) {
 		condition = commonstatus.GetAgentHealthyCondition(ctx,
 			r.agentProber,
 			types.NamespacedName{Name: names.LogAgent, Namespace: r.globals.TargetNamespace()},
@@ -241,6 +271,10 @@ func (r *Reconciler) setGatewayConfigGeneratedCondition(ctx context.Context, pip
 }
 
 func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) (status metav1.ConditionStatus, reason string, message string) {
+	if pipeline.Spec.Suspend {
+		return metav1.ConditionFalse, conditions.ReasonSuspended, conditions.MessageForOtelLogPipeline(conditions.ReasonSuspended)
+	}
+
 	err := r.pipelineValidator.Validate(ctx, pipeline)
 	if err == nil {
 		return metav1.ConditionTrue, conditions.ReasonGatewayConfigured, conditions.MessageForOtelLogPipeline(conditions.ReasonGatewayConfigured)
@@ -266,9 +300,25 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			fmt.Sprintf(conditions.MessageForOtelLogPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
 	}
 
+	if collectorconfig.IsConfigInvalidError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonConfigGenerationFailed,
+			fmt.Sprintf(conditions.MessageForOtelLogPipeline(conditions.ReasonConfigGenerationFailed), err.Error())
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForOtelLogPipeline(conditions.ReasonValidationFailed)
 	}
 
 	return conditions.EvaluateTLSCertCondition(err)
 }
+
+// setLastHeartbeatDelivered reports the time of the last heartbeat that the exporter of the pipeline sent to the backend.
+func (r *Reconciler) setLastHeartbeatDelivered(pipeline *telemetryv1beta1.LogPipeline) {
+	if !sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		pipeline.Status.LastHeartbeatDelivered = nil
+		return
+	}
+
+	pipeline.Status.LastHeartbeatDelivered = commonstatus.LastHeartbeatDelivered(r.heartbeatTracker, pipelines.SignalTypeLog, pipeline.Name, pipeline.Status.LastHeartbeatDelivered)
+}
@@ -94,7 +94,7 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 	// Create mock pipeline lock that allows all operations by default
 	pipelineLock := &mocks.PipelineLock{}
 	pipelineLock.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
-	pipelineLock.On("ReleaseLock", mock.Anything).Return(nil)
+	pipelineLock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil)
 	pipelineLock.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
 
 	// Create validator with all validations passing by default
@@ -105,6 +105,7 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 		WithSecretRefValidator(stubs.NewSecretRefValidator(nil)),
 		WithTransformSpecValidator(stubs.NewTransformSpecValidator(nil)),
 		WithFilterSpecValidator(stubs.NewFilterSpecValidator(nil)),
+		WithCollectorConfigValidator(stubs.NewCollectorConfigValidator(nil)),
 	}
 
 	allOpts = append(allOpts, opts...)
@@ -148,7 +149,7 @@ func newTestReconciler(client client.Client, opts ...Option) *Reconciler {
 
 	pipelineLock := &mocks.PipelineLock{}
 	pipelineLock.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
-	pipelineLock.On("ReleaseLock", mock.Anything).Return(nil)
+	pipelineLock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil)
 	pipelineLock.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
 
 	// Create validator with passing validations by default
@@ -0,0 +1,19 @@
+package stubs
+
+import (
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+type AgentConfigValidator struct {
+	err error
+}
+
+func NewAgentConfigValidator(err error) *AgentConfigValidator {
+	return &AgentConfigValidator{
+		err: err,
+	}
+}
+
+func (v *AgentConfigValidator) Validate(_ *common.Config, _ common.EnvVars) error {
+	return v.err
+}
@@ -0,0 +1,21 @@
+package stubs
+
+import (
+	"context"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type CollectorConfigValidator struct {
+	err error
+}
+
+func NewCollectorConfigValidator(err error) *CollectorConfigValidator {
+	return &CollectorConfigValidator{
+		err: err,
+	}
+}
+
+func (v *CollectorConfigValidator) ValidateLogPipeline(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
+	return v.err
+}
@@ -27,6 +27,11 @@ type AgentConfigBuilder interface {
 	Build(ctx context.Context, pipelines []telemetryv1beta1.MetricPipeline, options metricagent.BuildOptions) (*common.Config, common.EnvVars, error)
 }
 
+// AgentConfigValidator validates the generated agent configuration before it is rolled out.
+type AgentConfigValidator interface {
+	Validate(cfg *common.Config, envVars common.EnvVars) error
+}
+
 // AgentApplierDeleter manages the lifecycle of Metric Agent Kubernetes resources.
 // The agent runs as a DaemonSet on each cluster node to collect metrics.
 type AgentApplierDeleter interface {
@@ -49,6 +54,9 @@ type PipelineLock interface {
 	// Returns nil if the owner holds a lock, or an error if it does not.
 	// This is used to determine if a pipeline is already registered and active.
 	IsLockHolder(ctx context.Context, owner metav1.Object) error
+	// ReleaseLock releases the lock held by the given owner, so that it no longer counts towards the maximum pipeline count.
+	// This is used for suspended pipelines.
+	ReleaseLock(ctx context.Context, owner metav1.Object) error
 }
 
 // PipelineSyncer synchronizes pipeline state and manages pipeline registration.
@@ -139,6 +147,14 @@ type RuntimeAdditionalMetricsValidator interface {
 	Validate(pipeline *telemetryv1beta1.MetricPipeline) error
 }
 
+// PrometheusOutputValidator validates the Prometheus output of MetricPipeline resources.
+// It ensures that the Prometheus exporter port is used by a single MetricPipeline only.
+type PrometheusOutputValidator interface {
+	// Validate checks if the pipeline is allowed to use the Prometheus output.
+	// Returns an error if another MetricPipeline already uses it.
+	Validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error
+}
+
 // TransformSpecValidator validates transform specifications in metric pipeline configurations.
 // It ensures that metric transformations are correctly defined and syntactically valid.
 type TransformSpecValidator interface {
@@ -164,3 +180,11 @@ type SecretWatcher interface {
 	// RemoveFromWatchers removes a pipeline from all watchers by name and GVK.
 	RemoveFromWatchers(ctx context.Context, name string, gvk schema.GroupVersionKind) error
 }
+
+// CollectorConfigValidator validates the collector configuration generated for MetricPipeline resources.
+// It detects pipelines that would make the shared collector reject its configuration and crash-loop.
+type CollectorConfigValidator interface {
+	// ValidateMetricPipeline renders the collector configuration for the pipeline and validates it against the collector components.
+	// Returns a ConfigInvalidError if the collector would reject the configuration.
+	ValidateMetricPipeline(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error
+}
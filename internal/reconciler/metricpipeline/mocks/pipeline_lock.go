@@ -95,6 +95,63 @@ func (_c *PipelineLock_IsLockHolder_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// ReleaseLock provides a mock function for the type PipelineLock
+func (_mock *PipelineLock) ReleaseLock(ctx context.Context, owner v1.Object) error {
+	ret := _mock.Called(ctx, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, v1.Object) error); ok {
+		r0 = returnFunc(ctx, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PipelineLock_ReleaseLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLock'
+type PipelineLock_ReleaseLock_Call struct {
+	*mock.Call
+}
+
+// ReleaseLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner v1.Object
+func (_e *PipelineLock_Expecter) ReleaseLock(ctx any, owner any) *PipelineLock_ReleaseLock_Call {
+	return &PipelineLock_ReleaseLock_Call{Call: _e.mock.On("ReleaseLock", ctx, owner)}
+}
+
+func (_c *PipelineLock_ReleaseLock_Call) Run(run func(ctx context.Context, owner v1.Object)) *PipelineLock_ReleaseLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 v1.Object
+		if args[1] != nil {
+			arg1 = args[1].(v1.Object)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PipelineLock_ReleaseLock_Call) Return(err error) *PipelineLock_ReleaseLock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PipelineLock_ReleaseLock_Call) RunAndReturn(run func(ctx context.Context, owner v1.Object) error) *PipelineLock_ReleaseLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryAcquireLock provides a mock function for the type PipelineLock
 func (_mock *PipelineLock) TryAcquireLock(ctx context.Context, owner v1.Object) error {
 	ret := _mock.Called(ctx, owner)
@@ -24,6 +24,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
 	k8sutils "github.com/kyma-project/telemetry-manager/internal/utils/k8s"
 	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
@@ -39,6 +40,7 @@ type Reconciler struct {
 
 	agentApplierDeleter     AgentApplierDeleter
 	agentConfigBuilder      AgentConfigBuilder
+	agentConfigValidator    AgentConfigValidator
 	agentProber             commonstatus.Prober
 	gatewayFlowHealthProber GatewayFlowHealthProber
 	agentFlowHealthProber   AgentFlowHealthProber
@@ -52,6 +54,8 @@ type Reconciler struct {
 	pipelineValidator       *Validator
 	errToMsgConverter       commonstatus.ErrorToMessageConverter
 	secretWatcher           SecretWatcher
+	eventRecorder           commonstatus.EventRecorder
+	heartbeatTracker        commonstatus.HeartbeatTracker
 }
 
 // Option is a functional option for configuring a Reconciler.
@@ -78,6 +82,14 @@ func WithAgentConfigBuilder(builder AgentConfigBuilder) Option {
 	}
 }
 
+// WithAgentConfigValidator sets the validator for the generated agent configuration.
+// Without a validator, every generated configuration is rolled out.
+func WithAgentConfigValidator(validator AgentConfigValidator) Option {
+	return func(r *Reconciler) {
+		r.agentConfigValidator = validator
+	}
+}
+
 // WithAgentProber sets the agent prober.
 func WithAgentProber(prober commonstatus.Prober) Option {
 	return func(r *Reconciler) {
@@ -176,6 +188,20 @@ func WithSecretWatcher(watcher SecretWatcher) Option {
 	}
 }
 
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
+// WithHeartbeatTracker sets the tracker that reports the delivery of the synthetic heartbeat telemetry.
+func WithHeartbeatTracker(tracker commonstatus.HeartbeatTracker) Option {
+	return func(r *Reconciler) {
+		r.heartbeatTracker = tracker
+	}
+}
+
 // New creates a new Reconciler with the provided client and functional options.
 // All dependencies must be provided via functional options.
 func New(opts ...Option) *Reconciler {
@@ -249,7 +275,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	requeueAfter := r.calculateRequeueAfterDuration(ctx, &metricPipeline)
 	if requeueAfter != nil {
-		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire", "RequeueAfter", requeueAfter.String())
+		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire or enabled heartbeat", "RequeueAfter", requeueAfter.String())
 		return ctrl.Result{RequeueAfter: *requeueAfter}, nil
 	}
 
@@ -257,7 +283,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
-	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
+	if pipeline.Spec.Suspend {
+		// A suspended pipeline is not part of the configuration, so it must not count towards the maximum pipeline count
+		if err := r.pipelineLock.ReleaseLock(ctx, pipeline); err != nil {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+	} else if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		if errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
 			logf.FromContext(ctx).V(1).Info("Skipping reconciliation: maximum pipeline count limit exceeded")
 			return nil
@@ -364,6 +395,10 @@ func (r *Reconciler) isReconcilable(ctx context.Context, pipeline *telemetryv1be
 		return false, nil
 	}
 
+	if pipeline.Spec.Suspend {
+		return false, nil
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 
 	// Pipeline with a certificate that is about to expire is still considered reconcilable
@@ -384,7 +419,7 @@ func (r *Reconciler) getPipelinesRequiringAgents(allPipelines []telemetryv1beta1
 	var pipelinesRequiringAgents []telemetryv1beta1.MetricPipeline
 
 	for i := range allPipelines {
-		if isMetricAgentRequired(&allPipelines[i]) {
+		if metricpipelineutils.IsAgentRequired(&allPipelines[i]) {
 			pipelinesRequiringAgents = append(pipelinesRequiringAgents, allPipelines[i])
 		}
 	}
@@ -392,12 +427,6 @@ func (r *Reconciler) getPipelinesRequiringAgents(allPipelines []telemetryv1beta1
 	return pipelinesRequiringAgents
 }
 
-func isMetricAgentRequired(pipeline *telemetryv1beta1.MetricPipeline) bool {
-	input := pipeline.Spec.Input
-
-	return metricpipelineutils.IsRuntimeInputEnabled(input) || metricpipelineutils.IsPrometheusInputEnabled(input) || metricpipelineutils.IsIstioInputEnabled(input)
-}
-
 func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline, allPipelines []telemetryv1beta1.MetricPipeline) error {
 	isIstioActive, err := r.istioStatusChecker.IsIstioActive(ctx)
 	if err != nil {
@@ -429,6 +458,7 @@ func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *teleme
 	agentConfig, collectorEnvVars, err := r.agentConfigBuilder.Build(ctx, allPipelines, metricagent.BuildOptions{
 		IstioActive:                 isIstioActive,
 		IstioCertPath:               otelcollector.IstioCertPath,
+		HostRootPath:                otelcollector.HostRootPath,
 		InstrumentationScopeVersion: r.globals.Version(),
 		AgentNamespace:              r.globals.TargetNamespace(),
 		Cluster: common.ClusterOptions{
@@ -445,6 +475,14 @@ func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *teleme
 		return fmt.Errorf("failed to create collector config: %w", err)
 	}
 
+	if r.agentConfigValidator != nil {
+		if err := r.agentConfigValidator.Validate(agentConfig, collectorEnvVars); err != nil {
+			// Pipelines with an invalid configuration are already excluded by the pipeline validation, so the combined configuration is
+			// only invalid if the pipelines conflict with each other. The current configuration is kept running instead of crash-looping the agent.
+			return fmt.Errorf("generated Metric Agent configuration is invalid, keeping the current configuration: %w", err)
+		}
+	}
+
 	agentConfigYAML, err := yaml.Marshal(agentConfig)
 	if err != nil {
 		return fmt.Errorf("failed to marshal collector config: %w", err)
@@ -461,13 +499,17 @@ func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *teleme
 		ctx,
 		k8sclients.NewOwnerReferenceSetter(r.Client, pipeline),
 		otelcollector.AgentApplyOptions{
-			IstioEnabled:        isIstioActive,
-			VpaCRDExists:        vpaCRDExists,
-			VpaEnabled:          isVpaEnabled,
-			VPAMaxAllowedMemory: vpaMaxAllowedMemory,
-			CollectorConfigYAML: string(agentConfigYAML),
-			CollectorEnvVars:    collectorEnvVars,
-			BackendPorts:        backendPorts,
+			IstioEnabled:                 isIstioActive,
+			VpaCRDExists:                 vpaCRDExists,
+			VpaEnabled:                   isVpaEnabled,
+			VPAMaxAllowedMemory:          vpaMaxAllowedMemory,
+			CollectorConfigYAML:          string(agentConfigYAML),
+			CollectorEnvVars:             collectorEnvVars,
+			BackendPorts:                 backendPorts,
+			HostRootMountEnabled:         isHostInputEnabled(allPipelines),
+			PrometheusExporterEnabled:    metricpipelineutils.IsPrometheusOutputDefinedInAny(allPipelines),
+			ScrapeHealthEnabled:          isPrometheusInputEnabled(allPipelines),
+			ServiceAccountTokenAudiences: serviceAccountTokenAudiences(allPipelines),
 		},
 	); err != nil {
 		return fmt.Errorf("failed to apply agent resources: %w", err)
@@ -476,6 +518,42 @@ func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *teleme
 	return nil
 }
 
+// serviceAccountTokenAudiences returns the audiences of the outputs with service account token authentication, for which a token is projected into the Metric Agent
+func serviceAccountTokenAudiences(pipelines []telemetryv1beta1.MetricPipeline) []string {
+	var outputs []*telemetryv1beta1.OTLPOutput
+
+	for i := range pipelines {
+		if pipelines[i].Spec.Output.OTLP != nil {
+			outputs = append(outputs, &pipelines[i].Spec.Output.OTLP.OTLPOutput)
+		}
+	}
+
+	return common.ServiceAccountTokenAudiences(outputs...)
+}
+
+// isHostInputEnabled returns true if any of the pipelines collects host metrics, which requires the root filesystem of the Node to be mounted in the Metric Agent.
+func isHostInputEnabled(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsRuntimeInputEnabled(input) && metricpipelineutils.IsRuntimeHostInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPrometheusInputEnabled returns true if any of the active pipelines scrapes Prometheus targets, whose health is then exposed by the Metric Agent to the self-monitor.
+func isPrometheusInputEnabled(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		if !pipelines[i].Spec.Suspend && metricpipelineutils.IsPrometheusInputEnabled(pipelines[i].Spec.Input) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []telemetryv1beta1.MetricPipeline) {
 	for i := range pipelines {
 		pipeline := &pipelines[i]
@@ -509,6 +587,10 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 			features = append(features, metrics.FeatureInputIstio)
 		}
 
+		if metricpipelineutils.IsControlPlaneInputEnabled(pipeline.Spec.Input) {
+			features = append(features, metrics.FeatureInputControlPlane)
+		}
+
 		if metricpipelineutils.IsDeltaTemporality(pipeline.Spec.Output) {
 			features = append(features, metrics.FeatureOutputDeltaTemporality)
 		}
@@ -535,13 +617,26 @@ func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1
 }
 
 func (r *Reconciler) calculateRequeueAfterDuration(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) *time.Duration {
+	if pipeline.Spec.Suspend {
+		return nil
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 
 	if errCertAboutToExpire, ok := errors.AsType[*tlscert.CertAboutToExpireError](err); ok {
 		duration := time.Until(errCertAboutToExpire.Expiry)
+		if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+			duration = min(duration, heartbeat.Interval)
+		}
+
 		return &duration
 	}
 
+	// The heartbeat delivery is reported in the status, so that it must be refreshed regularly
+	if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		return new(heartbeat.Interval)
+	}
+
 	return nil
 }
 
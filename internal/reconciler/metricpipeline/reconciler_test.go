@@ -3,6 +3,7 @@ package metricpipeline
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -18,15 +19,20 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
+	"github.com/kyma-project/telemetry-manager/internal/namespaces"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/prometheusoutput"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -263,6 +269,37 @@ func TestMaxPipelineLimit(t *testing.T) {
 	assertAll(t)
 }
 
+func TestSuspendedPipeline(t *testing.T) {
+	pipeline := testutils.NewMetricPipelineBuilder().WithSuspend(true).Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	pipelineLockMock := &mocks.PipelineLock{}
+	pipelineLockMock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil).Once()
+
+	agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+	agentApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	sut, assertAll := newTestReconciler(
+		fakeClient,
+		WithPipelineLock(pipelineLockMock),
+		withAgentApplierDeleterAssert(agentApplierDeleterMock),
+	)
+
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonSuspended,
+		"Pipeline is suspended and not part of the collector configuration. Remove the 'spec.suspend' field to resume it",
+	)
+
+	pipelineLockMock.AssertExpectations(t)
+	pipelineLockMock.AssertNotCalled(t, "TryAcquireLock", mock.Anything, mock.Anything)
+	assertAll(t)
+}
+
 func TestGatewayFlowHealthCondition(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -462,6 +499,191 @@ func TestAgentFlowHealthCondition(t *testing.T) {
 	}
 }
 
+func TestScrapeTargetsFailing(t *testing.T) {
+	failingScrapeTargets := []string{"shop/checkout", "kube-system/coredns", "shop/cart", "team-a/api", "team-b/worker"}
+
+	tests := []struct {
+		name            string
+		pipeline        telemetryv1beta1.MetricPipeline
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "all namespaces selected without namespace selector",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithPrometheusInput(true).Build(),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonScrapeTargetsFailing,
+			expectedMessage: "Prometheus scrape targets failing: workloads with the most failing targets are shop/checkout, kube-system/coredns, shop/cart. No metrics are collected from failing targets. See troubleshooting: " + conditions.LinkScrapeTargetsFailing,
+		},
+		{
+			name:            "system namespaces excluded by default",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.ExcludeNamespaces(namespaces.System()...)).Build(),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonScrapeTargetsFailing,
+			expectedMessage: "Prometheus scrape targets failing: workloads with the most failing targets are shop/checkout, shop/cart, team-a/api. No metrics are collected from failing targets. See troubleshooting: " + conditions.LinkScrapeTargetsFailing,
+		},
+		{
+			name:            "included namespaces",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.IncludeNamespaces("kube-system", "team-b")).Build(),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonScrapeTargetsFailing,
+			expectedMessage: "Prometheus scrape targets failing: workloads with the most failing targets are kube-system/coredns, team-b/worker. No metrics are collected from failing targets. See troubleshooting: " + conditions.LinkScrapeTargetsFailing,
+		},
+		{
+			name:            "excluded namespaces",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.ExcludeNamespaces("shop")).Build(),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonScrapeTargetsFailing,
+			expectedMessage: "Prometheus scrape targets failing: workloads with the most failing targets are kube-system/coredns, team-a/api, team-b/worker. No metrics are collected from failing targets. See troubleshooting: " + conditions.LinkScrapeTargetsFailing,
+		},
+		{
+			name:            "no failing targets in selected namespaces",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.IncludeNamespaces("team-c")).Build(),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage: "No problems detected in the telemetry flow",
+		},
+		{
+			name:            "prometheus input disabled",
+			pipeline:        testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage: "No problems detected in the telemetry flow",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := tt.pipeline
+			fakeClient := newTestClient(t, &pipeline)
+
+			agentConfigBuilderMock := &mocks.AgentConfigBuilder{}
+			agentConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&common.Config{}, nil, nil).Once()
+
+			agentFlowHealthProberStub := &mocks.AgentFlowHealthProber{}
+			agentFlowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelAgentProbeResult{
+				FailingScrapeTargets: failingScrapeTargets,
+			}, nil)
+
+			sut, assertAll := newTestReconciler(
+				fakeClient,
+				withAgentConfigBuilderAssert(agentConfigBuilderMock),
+				WithAgentFlowHealthProber(agentFlowHealthProberStub),
+			)
+			result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+			require.NoError(t, result.err)
+
+			requireHasStatusCondition(t, result.pipeline,
+				conditions.TypeFlowHealthy,
+				tt.expectedStatus,
+				tt.expectedReason,
+				tt.expectedMessage,
+			)
+
+			assertAll(t)
+		})
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	lastDelivered := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                           string
+		heartbeatEnabled               bool
+		probe                          prober.OTelGatewayProbeResult
+		tracker                        *commonStatusStubs.HeartbeatTracker
+		expectedStatus                 metav1.ConditionStatus
+		expectedReason                 string
+		expectedMessage                string
+		expectedLastHeartbeatDelivered *metav1.Time
+		expectedRequeueAfter           time.Duration
+	}{
+		{
+			name:            "heartbeat disabled",
+			tracker:         commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage: "No problems detected in the telemetry flow",
+		},
+		{
+			name:                           "heartbeat delivered",
+			heartbeatEnabled:               true,
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, false),
+			expectedStatus:                 metav1.ConditionTrue,
+			expectedReason:                 conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage:                "No problems detected in the telemetry flow",
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+		{
+			name:                 "heartbeat not delivered",
+			heartbeatEnabled:     true,
+			tracker:              commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       conditions.ReasonSelfMonHeartbeatFailing,
+			expectedMessage:      "Heartbeat metrics are not delivered to the backend. The pipeline does not deliver any data end to end. See troubleshooting: " + conditions.LinkHeartbeatFailing,
+			expectedRequeueAfter: heartbeat.Interval,
+		},
+		{
+			name:                           "heartbeat failing after last delivery",
+			heartbeatEnabled:               true,
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedReason:                 conditions.ReasonSelfMonHeartbeatFailing,
+			expectedMessage:                "Heartbeat metrics are not delivered to the backend. The pipeline does not deliver any data end to end. See troubleshooting: " + conditions.LinkHeartbeatFailing,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+		{
+			name:                           "all data dropped shadows heartbeat failure",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedReason:                 conditions.ReasonSelfMonGatewayAllDataDropped,
+			expectedMessage:                "Backend is not reachable or rejecting metrics. All metrics are dropped in OTLP Gateway. See troubleshooting: " + conditions.LinkNoDataArriveAtBackend,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewMetricPipelineBuilder().WithHeartbeat(tt.heartbeatEnabled).Build()
+			fakeClient := newTestClient(t, &pipeline)
+
+			gatewayFlowHealthProberStub := &mocks.GatewayFlowHealthProber{}
+			gatewayFlowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+			sut, assertAll := newTestReconciler(
+				fakeClient,
+				WithGatewayFlowHealthProber(gatewayFlowHealthProberStub),
+				WithHeartbeatTracker(tt.tracker),
+			)
+			result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+			require.NoError(t, result.err)
+			require.Equal(t, tt.expectedRequeueAfter, result.result.RequeueAfter)
+
+			requireHasStatusCondition(t, result.pipeline,
+				conditions.TypeFlowHealthy,
+				tt.expectedStatus,
+				tt.expectedReason,
+				tt.expectedMessage,
+			)
+
+			if tt.expectedLastHeartbeatDelivered == nil {
+				require.Nil(t, result.pipeline.Status.LastHeartbeatDelivered)
+			} else {
+				require.NotNil(t, result.pipeline.Status.LastHeartbeatDelivered)
+				require.True(t, tt.expectedLastHeartbeatDelivered.Equal(result.pipeline.Status.LastHeartbeatDelivered))
+			}
+
+			assertAll(t)
+		})
+	}
+}
+
 func TestTLSCertificateValidation(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -660,6 +882,124 @@ func TestRuntimeAdditionalMetricValidation(t *testing.T) {
 	assertAll(t)
 }
 
+func TestPrometheusOutputValidation(t *testing.T) {
+	pipeline := testutils.NewMetricPipelineBuilder().
+		WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{}).
+		Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	customValidator := newTestValidator(
+		WithPrometheusOutputValidator(stubs.NewPrometheusOutputValidator(
+			&prometheusoutput.PrometheusOutputInUseError{Err: fmt.Errorf("the Prometheus output is already used by MetricPipeline 'other'")},
+		)),
+	)
+
+	sut, assertAll := newTestReconciler(
+		fakeClient,
+		WithPipelineValidator(customValidator),
+	)
+
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonPrometheusOutputInUse,
+		"The Prometheus output is already used by MetricPipeline 'other'",
+	)
+	require.Empty(t, result.pipeline.Status.ScrapeURL)
+
+	assertAll(t)
+}
+
+func TestConfigGenerationFailed(t *testing.T) {
+	pipeline := testutils.NewMetricPipelineBuilder().Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	customValidator := newTestValidator(
+		WithCollectorConfigValidator(stubs.NewCollectorConfigValidator(
+			&collectorconfig.ConfigInvalidError{Err: fmt.Errorf("invalid configuration of processor 'transform/metricpipeline-user-defined-%s'", pipeline.Name)},
+		)),
+	)
+
+	sut, assertAll := newTestReconciler(
+		fakeClient,
+		WithPipelineValidator(customValidator),
+	)
+
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonConfigGenerationFailed,
+		fmt.Sprintf("Generated collector configuration is invalid: invalid configuration of processor 'transform/metricpipeline-user-defined-%s'. The pipeline is excluded from the collector configuration until it is fixed. See troubleshooting: %s", pipeline.Name, conditions.LinkConfigGenerationFailed),
+	)
+
+	// The pipeline is not referenced, so that the OTLP Gateway configuration stays valid for all other pipelines
+	gatewayConfig, err := coordinationconfig.ReadOTLPGatewayConfig(t.Context(), fakeClient, "default")
+	require.NoError(t, err)
+	require.False(t, slices.ContainsFunc(gatewayConfig.MetricPipelineReferences, func(ref coordinationconfig.PipelineReference) bool {
+		return ref.Name == pipeline.Name
+	}))
+
+	assertAll(t)
+}
+
+func TestInvalidAgentConfigIsNotApplied(t *testing.T) {
+	pipeline := testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+
+	sut, assertAll := newTestReconciler(
+		fakeClient,
+		WithAgentConfigValidator(stubs.NewAgentConfigValidator(&collectorconfig.ConfigInvalidError{Err: errors.New("service pipeline 'metrics/output-test' has no exporters")})),
+		withAgentApplierDeleterAssert(agentApplierDeleterMock),
+	)
+
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.ErrorContains(t, result.err, "generated Metric Agent configuration is invalid")
+
+	agentApplierDeleterMock.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+	assertAll(t)
+}
+
+func TestPrometheusOutputScrapeURL(t *testing.T) {
+	tests := []struct {
+		name              string
+		pipeline          telemetryv1beta1.MetricPipeline
+		expectedScrapeURL string
+	}{
+		{
+			name:     "pipeline with OTLP output",
+			pipeline: testutils.NewMetricPipelineBuilder().Build(),
+		},
+		{
+			name: "pipeline with prometheus output",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{}).
+				Build(),
+			expectedScrapeURL: "http://telemetry-metric-prometheus.default.svc.cluster.local:9464/metrics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := newTestClient(t, &tt.pipeline)
+			sut, assertAll := newTestReconciler(fakeClient)
+
+			result := reconcileAndGet(t, fakeClient, sut, tt.pipeline.Name)
+			require.NoError(t, result.err)
+			require.Equal(t, tt.expectedScrapeURL, result.pipeline.Status.ScrapeURL)
+
+			assertAll(t)
+		})
+	}
+}
+
 func TestAPIServerFailureHandling(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -14,17 +16,25 @@ import (
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/prometheusoutput"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
+// maxFailingScrapeTargets is the maximum number of failing scrape targets listed in the flow health condition.
+const maxFailingScrapeTargets = 3
+
 func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) error {
 	var pipeline telemetryv1beta1.MetricPipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pipelineName}, &pipeline); err != nil {
@@ -41,18 +51,25 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		return nil
 	}
 
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
 	var allErrors error = nil
 
 	r.setAgentHealthyCondition(ctx, &pipeline)
 	r.setGatewayHealthyCondition(ctx, &pipeline)
 	r.setGatewayConfigGeneratedCondition(ctx, &pipeline)
+	r.setScrapeURL(&pipeline)
 
 	if err := r.setFlowHealthCondition(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, err)
 	}
 
+	r.setLastHeartbeatDelivered(&pipeline)
+
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update MetricPipeline status: %w", err))
+	} else if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(&pipeline, oldConditions, pipeline.Status.Conditions)
 	}
 
 	return allErrors
@@ -66,7 +83,7 @@ func (r *Reconciler) setAgentHealthyCondition(ctx context.Context, pipeline *tel
 		Message: conditions.MessageForMetricPipeline(conditions.ReasonMetricAgentNotRequired),
 	}
 
-	if isMetricAgentRequired(pipeline) {
+	if metricpipelineutils.IsAgentRequired(pipeline) {
 		condition = commonstatus.GetAgentHealthyCondition(ctx,
 			r.agentProber,
 			types.NamespacedName{Name: names.MetricAgent, Namespace: r.globals.TargetNamespace()},
@@ -102,7 +119,26 @@ func (r *Reconciler) setGatewayConfigGeneratedCondition(ctx context.Context, pip
 	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
 }
 
+// setScrapeURL reports the URL of the Prometheus exporter endpoint, if the pipeline defines a Prometheus output that is applied to the configuration.
+func (r *Reconciler) setScrapeURL(pipeline *telemetryv1beta1.MetricPipeline) {
+	pipeline.Status.ScrapeURL = ""
+
+	if !metricpipelineutils.IsPrometheusOutputDefined(pipeline.Spec.Output) {
+		return
+	}
+
+	if !meta.IsStatusConditionTrue(pipeline.Status.Conditions, conditions.TypeConfigurationGenerated) {
+		return
+	}
+
+	pipeline.Status.ScrapeURL = fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/metrics", names.MetricPrometheusService, r.globals.TargetNamespace(), ports.PrometheusExporter)
+}
+
 func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (status metav1.ConditionStatus, reason string, message string) {
+	if pipeline.Spec.Suspend {
+		return metav1.ConditionFalse, conditions.ReasonSuspended, conditions.MessageForMetricPipeline(conditions.ReasonSuspended)
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 	if err == nil {
 		return metav1.ConditionTrue, conditions.ReasonGatewayConfigured, conditions.MessageForMetricPipeline(conditions.ReasonGatewayConfigured)
@@ -128,12 +164,24 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			fmt.Sprintf(conditions.MessageForMetricPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
 	}
 
+	if collectorconfig.IsConfigInvalidError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonConfigGenerationFailed,
+			fmt.Sprintf(conditions.MessageForMetricPipeline(conditions.ReasonConfigGenerationFailed), err.Error())
+	}
+
 	if runtimemetrics.IsInvalidAdditionalMetricError(err) {
 		return metav1.ConditionFalse,
 			conditions.ReasonRuntimeAdditionalMetricInvalid,
 			conditions.ConvertErrToMsg(err)
 	}
 
+	if prometheusoutput.IsPrometheusOutputInUseError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonPrometheusOutputInUse,
+			conditions.ConvertErrToMsg(err)
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForMetricPipeline(conditions.ReasonValidationFailed)
 	}
@@ -142,13 +190,13 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 }
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
-	status, reason, err := r.evaluateFlowHealthCondition(ctx, pipeline)
+	status, reason, message, err := r.evaluateFlowHealthCondition(ctx, pipeline)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
 		Status:             status,
 		Reason:             reason,
-		Message:            conditions.MessageForMetricPipeline(reason),
+		Message:            message,
 		ObservedGeneration: pipeline.Generation,
 	}
 
@@ -157,15 +205,15 @@ func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telem
 	return err
 }
 
-func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (metav1.ConditionStatus, string, error) {
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (metav1.ConditionStatus, string, string, error) {
 	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	if configGeneratedStatus == metav1.ConditionFalse {
-		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonConfigNotGenerated), nil
 	}
 
 	gatewayProbeResult, err := r.gatewayFlowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, fmt.Errorf("failed to probe gateway flow health: %w", err)
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonGatewayProbingFailed), fmt.Errorf("failed to probe gateway flow health: %w", err)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed gateway flow health", "result", gatewayProbeResult)
@@ -173,23 +221,32 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 	// Probe agent flow health
 	agentProbeResult, err := r.agentFlowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, fmt.Errorf("failed to probe agent flow health: %w", err)
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonAgentProbingFailed), fmt.Errorf("failed to probe agent flow health: %w", err)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed agent flow health", "result", agentProbeResult)
 
-	reason := flowHealthReasonFor(gatewayProbeResult, agentProbeResult)
+	heartbeatFailing := sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) &&
+		commonstatus.IsHeartbeatFailing(r.heartbeatTracker, pipelines.SignalTypeMetric, pipeline.Name)
+
+	failingScrapeTargets := failingScrapeTargetsFor(pipeline, agentProbeResult)
+
+	reason := flowHealthReasonFor(gatewayProbeResult, agentProbeResult, heartbeatFailing, failingScrapeTargets)
+	message := flowHealthMessageFor(reason, failingScrapeTargets)
+
 	if reason == conditions.ReasonSelfMonFlowHealthy {
-		return metav1.ConditionTrue, reason, nil
+		return metav1.ConditionTrue, reason, message, nil
 	}
 
-	return metav1.ConditionFalse, reason, nil
+	return metav1.ConditionFalse, reason, message, nil
 }
 
-func flowHealthReasonFor(gatewayProbeResult prober.OTelGatewayProbeResult, agentProbeResult prober.OTelAgentProbeResult) string {
+func flowHealthReasonFor(gatewayProbeResult prober.OTelGatewayProbeResult, agentProbeResult prober.OTelAgentProbeResult, heartbeatFailing bool, failingScrapeTargets []string) string {
 	switch {
 	case gatewayProbeResult.AllDataDropped:
 		return conditions.ReasonSelfMonGatewayAllDataDropped
+	case heartbeatFailing:
+		return conditions.ReasonSelfMonHeartbeatFailing
 	case gatewayProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
 	case gatewayProbeResult.Throttling:
@@ -198,7 +255,52 @@ func flowHealthReasonFor(gatewayProbeResult prober.OTelGatewayProbeResult, agent
 		return conditions.ReasonSelfMonAgentAllDataDropped
 	case agentProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonAgentSomeDataDropped
+	case len(failingScrapeTargets) > 0:
+		return conditions.ReasonSelfMonScrapeTargetsFailing
 	default:
 		return conditions.ReasonSelfMonFlowHealthy
 	}
 }
+
+func flowHealthMessageFor(reason string, failingScrapeTargets []string) string {
+	switch reason {
+	case conditions.ReasonSelfMonScrapeTargetsFailing:
+		return fmt.Sprintf(conditions.MessageForMetricPipeline(reason), strings.Join(failingScrapeTargets, ", "))
+	default:
+		return conditions.MessageForMetricPipeline(reason)
+	}
+}
+
+// failingScrapeTargetsFor returns the failing scrape targets reported for the metric agent that belong to the namespaces selected by the prometheus input of the pipeline.
+// The scrape targets are shared by all pipelines with enabled prometheus input, so they are filtered per pipeline here. The result keeps the order of the worst offenders.
+func failingScrapeTargetsFor(pipeline *telemetryv1beta1.MetricPipeline, agentProbeResult prober.OTelAgentProbeResult) []string {
+	if !metricpipelineutils.IsPrometheusInputEnabled(pipeline.Spec.Input) {
+		return nil
+	}
+
+	var targets []string
+
+	for _, target := range agentProbeResult.FailingScrapeTargets {
+		namespace, _, _ := strings.Cut(target, "/")
+		if !sharedtypesutils.IsNamespaceSelected(pipeline.Spec.Input.Prometheus.Namespaces, namespace) {
+			continue
+		}
+
+		targets = append(targets, target)
+		if len(targets) == maxFailingScrapeTargets {
+			break
+		}
+	}
+
+	return targets
+}
+
+// setLastHeartbeatDelivered reports the time of the last heartbeat that the exporter of the pipeline sent to the backend.
+func (r *Reconciler) setLastHeartbeatDelivered(pipeline *telemetryv1beta1.MetricPipeline) {
+	if !sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		pipeline.Status.LastHeartbeatDelivered = nil
+		return
+	}
+
+	pipeline.Status.LastHeartbeatDelivered = commonstatus.LastHeartbeatDelivered(r.heartbeatTracker, pipelines.SignalTypeMetric, pipeline.Name, pipeline.Status.LastHeartbeatDelivered)
+}
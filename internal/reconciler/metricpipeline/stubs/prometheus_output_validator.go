@@ -0,0 +1,21 @@
+package stubs
+
+import (
+	"context"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type PrometheusOutputValidator struct {
+	err error
+}
+
+func NewPrometheusOutputValidator(err error) *PrometheusOutputValidator {
+	return &PrometheusOutputValidator{
+		err: err,
+	}
+}
+
+func (v *PrometheusOutputValidator) Validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
+	return v.err
+}
@@ -126,7 +126,9 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 		WithValidatorPipelineLock(pipelineLock),
 		WithTransformSpecValidator(stubs.NewTransformSpecValidator(nil)),
 		WithFilterSpecValidator(stubs.NewFilterSpecValidator(nil)),
+		WithCollectorConfigValidator(stubs.NewCollectorConfigValidator(nil)),
 		WithRuntimeAdditionalMetricsValidator(stubs.NewRuntimeAdditionalMetricsValidator(nil)),
+		WithPrometheusOutputValidator(stubs.NewPrometheusOutputValidator(nil)),
 	}
 
 	allOpts = append(allOpts, opts...)
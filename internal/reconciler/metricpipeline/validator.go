@@ -17,6 +17,8 @@ type Validator struct {
 	TransformSpecValidator            TransformSpecValidator
 	FilterSpecValidator               FilterSpecValidator
 	RuntimeAdditionalMetricsValidator RuntimeAdditionalMetricsValidator
+	PrometheusOutputValidator         PrometheusOutputValidator
+	CollectorConfigValidator          CollectorConfigValidator
 }
 
 // ValidatorOption configures the Validator during initialization.
@@ -71,6 +73,20 @@ func WithRuntimeAdditionalMetricsValidator(validator RuntimeAdditionalMetricsVal
 	}
 }
 
+// WithPrometheusOutputValidator sets the Prometheus output validator for the Validator.
+func WithPrometheusOutputValidator(validator PrometheusOutputValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.PrometheusOutputValidator = validator
+	}
+}
+
+// WithCollectorConfigValidator sets the collector config validator for the Validator.
+func WithCollectorConfigValidator(validator CollectorConfigValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.CollectorConfigValidator = validator
+	}
+}
+
 // NewValidator creates a new Validator with the provided options.
 func NewValidator(opts ...ValidatorOption) *Validator {
 	v := &Validator{}
@@ -88,29 +104,13 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Met
 	}
 
 	if pipeline.Spec.Output.OTLP != nil {
-		var oauth2 *telemetryv1beta1.OAuth2Options = nil
-		if pipeline.Spec.Output.OTLP.Authentication != nil {
-			oauth2 = pipeline.Spec.Output.OTLP.Authentication.OAuth2
-		}
-
-		if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
-			Endpoint:   &pipeline.Spec.Output.OTLP.Endpoint,
-			Protocol:   pipeline.Spec.Output.OTLP.Protocol,
-			OTLPOAuth2: oauth2,
-			OutputTLS:  pipeline.Spec.Output.OTLP.TLS,
-		}); err != nil {
+		if err := v.validateOTLPOutput(ctx, &pipeline.Spec.Output.OTLP.OTLPOutput); err != nil {
 			return err
 		}
 	}
 
-	if tlsValidationRequired(pipeline) {
-		tlsConfig := tlscert.TLSValidationParams{
-			Cert: pipeline.Spec.Output.OTLP.TLS.Cert,
-			Key:  pipeline.Spec.Output.OTLP.TLS.Key,
-			CA:   pipeline.Spec.Output.OTLP.TLS.CA,
-		}
-
-		if err := v.TLSCertValidator.Validate(ctx, tlsConfig); err != nil {
+	for i := range pipeline.Spec.Routes {
+		if err := v.validateOTLPOutput(ctx, pipeline.Spec.Routes[i].Output.OTLP); err != nil {
 			return err
 		}
 	}
@@ -131,17 +131,49 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Met
 		return err
 	}
 
+	if err := v.PrometheusOutputValidator.Validate(ctx, pipeline); err != nil {
+		return err
+	}
+
+	// The generated configuration is validated last, because rendering it requires the references of the pipeline to be valid
+	if err := v.CollectorConfigValidator.ValidateMetricPipeline(ctx, pipeline); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// tlsValidationRequired checks if TLS validation is required for the pipeline.
-// Returns true if the pipeline has OTLP output with TLS configuration containing cert, key, or CA.
-func tlsValidationRequired(pipeline *telemetryv1beta1.MetricPipeline) bool {
-	otlp := pipeline.Spec.Output.OTLP
-	if otlp == nil {
-		return false
+// validateOTLPOutput validates the endpoint and the TLS certificates of an OTLP output, which is either the default output of the pipeline or the output of a route.
+func (v *Validator) validateOTLPOutput(ctx context.Context, otlp *telemetryv1beta1.OTLPOutput) error {
+	var oauth2 *telemetryv1beta1.OAuth2Options = nil
+	if otlp.Authentication != nil {
+		oauth2 = otlp.Authentication.OAuth2
 	}
 
+	if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
+		Endpoint:   &otlp.Endpoint,
+		Protocol:   otlp.Protocol,
+		OutputTLS:  otlp.TLS,
+		OTLPOAuth2: oauth2,
+		ProxyURL:   otlp.ProxyURL,
+	}); err != nil {
+		return err
+	}
+
+	if !tlsValidationRequired(otlp) {
+		return nil
+	}
+
+	return v.TLSCertValidator.Validate(ctx, tlscert.TLSValidationParams{
+		Cert: otlp.TLS.Cert,
+		Key:  otlp.TLS.Key,
+		CA:   otlp.TLS.CA,
+	})
+}
+
+// tlsValidationRequired checks if TLS validation is required for the OTLP output.
+// Returns true if the output has a TLS configuration containing cert, key, or CA.
+func tlsValidationRequired(otlp *telemetryv1beta1.OTLPOutput) bool {
 	if otlp.TLS == nil {
 		return false
 	}
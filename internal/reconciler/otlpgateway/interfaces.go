@@ -4,12 +4,14 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpgateway"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
 )
 
 // OTLPGatewayConfigBuilder builds OTel Collector configuration for the OTLP Gateway.
@@ -17,12 +19,30 @@ type OTLPGatewayConfigBuilder interface {
 	Build(ctx context.Context, opts otlpgateway.BuildOptions) (*common.Config, common.EnvVars, error)
 }
 
+// ConfigValidator validates the generated collector configuration before it is rolled out.
+type ConfigValidator interface {
+	Validate(cfg *common.Config, envVars common.EnvVars) error
+}
+
 // GatewayApplierDeleter manages the lifecycle of OTLP Gateway resources (DaemonSet, ConfigMap, Secret, etc.).
 type GatewayApplierDeleter interface {
 	ApplyResources(ctx context.Context, c client.Client, opts otelcollector.GatewayApplyOptions) error
 	DeleteResources(ctx context.Context, c client.Client, isIstioActive bool, vpaCRDExists bool) error
 }
 
+// TapRegistry provides the taps that are open in the manager.
+type TapRegistry interface {
+	Taps() []tap.Tap
+}
+
+// GatewayProber probes the rollout of the OTLP Gateway DaemonSet.
+type GatewayProber interface {
+	// IsRolledOut returns true if all Pods of the current DaemonSet generation are updated and ready.
+	IsRolledOut(ctx context.Context, name types.NamespacedName) (bool, error)
+	// IsCrashLooping returns true if a Pod of the current DaemonSet generation is in CrashLoopBackOff.
+	IsCrashLooping(ctx context.Context, name types.NamespacedName) (bool, error)
+}
+
 // IstioStatusChecker checks whether Istio is active in the cluster.
 type IstioStatusChecker interface {
 	IsIstioActive(ctx context.Context) (bool, error)
@@ -44,3 +64,9 @@ type NodeSizeTracker interface {
 type OverridesHandler interface {
 	LoadOverrides(ctx context.Context) (*overrides.Config, error)
 }
+
+// SecretWatcher manages watches on Kubernetes secrets referenced by the Telemetry CR.
+type SecretWatcher interface {
+	// SyncWatchers ensures the object watches exactly the given set of secrets.
+	SyncWatchers(ctx context.Context, object client.Object, secrets []types.NamespacedName) error
+}
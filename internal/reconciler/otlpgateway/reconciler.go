@@ -19,8 +19,10 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,13 +34,22 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpgateway"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/resources/rollouthistory"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
 	k8sutils "github.com/kyma-project/telemetry-manager/internal/utils/k8s"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 	telemetryutils "github.com/kyma-project/telemetry-manager/internal/utils/telemetry"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
+// tapSinkCACertKey is the key of the CA certificate in the Secret of the webhook certificates.
+const tapSinkCACertKey = "ca.crt"
+
 // Reconciler reconciles the OTLP Gateway DaemonSet based on pipeline references in the coordination ConfigMap.
 type Reconciler struct {
 	client.Client
@@ -52,6 +63,11 @@ type Reconciler struct {
 	vpaStatusChecker      VpaStatusChecker
 	nodeSizeTracker       NodeSizeTracker
 	overridesHandler      OverridesHandler
+	secretWatcher         SecretWatcher
+	gatewayProber         GatewayProber
+	configValidator       ConfigValidator
+	tapRegistry           TapRegistry
+	tapSinkCASecret       types.NamespacedName
 }
 
 // Option configures the Reconciler during initialization.
@@ -106,6 +122,38 @@ func WithOverridesHandler(handler OverridesHandler) Option {
 	}
 }
 
+// WithSecretWatcher sets the secret watcher for secrets referenced by the external ingestion configuration.
+func WithSecretWatcher(watcher SecretWatcher) Option {
+	return func(r *Reconciler) {
+		r.secretWatcher = watcher
+	}
+}
+
+// WithGatewayProber sets the prober used to determine the outcome of configuration rollouts.
+// Without a prober, no rollout history is recorded and failed rollouts are not rolled back.
+func WithGatewayProber(prober GatewayProber) Option {
+	return func(r *Reconciler) {
+		r.gatewayProber = prober
+	}
+}
+
+// WithConfigValidator sets the validator for the generated collector configuration.
+// Without a validator, every generated configuration is rolled out.
+func WithConfigValidator(validator ConfigValidator) Option {
+	return func(r *Reconciler) {
+		r.configValidator = validator
+	}
+}
+
+// WithTapRegistry sets the registry of the taps opened in the manager and the Secret holding the CA certificate of the serving certificate of the tap sink.
+// Without a registry, no taps are attached to the gateway configuration.
+func WithTapRegistry(registry TapRegistry, caSecret types.NamespacedName) Option {
+	return func(r *Reconciler) {
+		r.tapRegistry = registry
+		r.tapSinkCASecret = caSecret
+	}
+}
+
 // NewReconciler creates a new OTLP Gateway Reconciler with the given options.
 func NewReconciler(c client.Client, opts ...Option) *Reconciler {
 	r := &Reconciler{
@@ -141,111 +189,284 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	if err := r.doReconcile(ctx); err != nil {
+	return r.doReconcile(ctx)
+}
+
+// processConfigAndBuildResources handles config building and resource deployment.
+func (r *Reconciler) processConfigAndBuildResources(ctx context.Context, refs *coordinationconfig.OTLPGatewayConfigMap, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline, telemetryRoutes []telemetryv1beta1.TelemetryRoute) (ctrl.Result, error) {
+	externalIngestion, err := r.getExternalIngestion(ctx)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
-}
+	tapSink := r.getTapSink(ctx, tracePipelines, logPipelines, metricPipelines)
 
-// processConfigAndBuildResources handles config building and resource deployment.
-func (r *Reconciler) processConfigAndBuildResources(ctx context.Context, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline) error {
-	collectorConfig, collectorEnvVars, err := r.buildCollectorConfig(ctx, tracePipelines, logPipelines, metricPipelines)
+	collectorConfig, collectorEnvVars, err := r.buildCollectorConfig(ctx, tracePipelines, logPipelines, metricPipelines, telemetryRoutes, externalIngestion, tapSink)
 	if err != nil {
-		return fmt.Errorf("failed to build config: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to build config: %w", err)
+	}
+
+	if r.configValidator != nil {
+		if err := r.configValidator.Validate(collectorConfig, collectorEnvVars); err != nil {
+			// Pipelines with an invalid configuration are not referenced in the coordination ConfigMap, because the pipeline reconcilers
+			// exclude them and mark them with the ConfigGenerationFailed reason. So, the combined configuration is only invalid if the pipelines
+			// conflict with each other. The current configuration is kept running instead of crash-looping the gateway, and the rollout is retried.
+			return ctrl.Result{}, fmt.Errorf("generated OTLP Gateway configuration is invalid, keeping the current configuration: %w", err)
+		}
 	}
 
 	collectorConfigYAML, err := yaml.Marshal(collectorConfig)
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to marshal config: %w", err)
 	}
 
 	isIstioActive, err := r.istioStatusChecker.IsIstioActive(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to check Istio status: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to check Istio status: %w", err)
 	}
 
 	vpaCRDExists, err := r.vpaStatusChecker.VpaCRDExists(ctx, r.Client)
 	if err != nil {
-		return fmt.Errorf("failed to check VPA CRD: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to check VPA CRD: %w", err)
 	}
 
 	vpaEnabled := telemetryutils.IsVpaEnabledInTelemetry(ctx, r.Client, r.globals.DefaultTelemetryNamespace())
 	vpaMaxAllowedMemory := r.nodeSizeTracker.VPAMaxAllowedMemory()
 
+	tokenAudiences := serviceAccountTokenAudiences(tracePipelines, logPipelines, metricPipelines, telemetryRoutes)
+	if tapSink != nil {
+		tokenAudiences = append(tokenAudiences, tap.SinkAudience)
+	}
+
 	opts := otelcollector.GatewayApplyOptions{
 		CollectorConfigYAML:            string(collectorConfigYAML),
 		CollectorEnvVars:               collectorEnvVars,
 		IstioEnabled:                   isIstioActive,
-		ResourceRequirementsMultiplier: len(tracePipelines) + len(logPipelines) + len(metricPipelines),
+		ResourceRequirementsMultiplier: len(tracePipelines) + len(logPipelines) + len(metricPipelines) + len(telemetryRoutes),
 		VpaCRDExists:                   vpaCRDExists,
 		VpaEnabled:                     vpaEnabled,
 		VPAMaxAllowedMemory:            vpaMaxAllowedMemory,
+		ExternalIngestion:              makeExternalIngestionOptions(externalIngestion),
+		PrometheusExporterEnabled:      metricpipelineutils.IsPrometheusOutputDefinedInAny(metricPipelines),
+		ServiceAccountTokenAudiences:   tokenAudiences,
+	}
+
+	rolloutInProgress, err := r.applyAndRecordRollout(ctx, refs, opts)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if rolloutInProgress {
+		// Check the outcome of the rollout again, even if the gateway Pods do not change anymore
+		return ctrl.Result{RequeueAfter: rolloutCheckInterval}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getExternalIngestion returns the external ingestion configuration of the Telemetry CR and keeps the watches on the referenced secrets in sync.
+// If a referenced secret cannot be resolved, external ingestion is disabled so that in-cluster ingestion keeps working.
+func (r *Reconciler) getExternalIngestion(ctx context.Context) (*operatorv1beta1.ExternalIngestionSpec, error) {
+	// External ingestion is optional, so a missing Telemetry CR disables it
+	t, err := telemetryutils.GetDefaultTelemetryInstance(ctx, r.Client, r.globals.DefaultTelemetryNamespace())
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Telemetry CR not available, skipping external ingestion", "error", err.Error())
+		return nil, nil
+	}
+
+	var spec *operatorv1beta1.ExternalIngestionSpec
+	if t.Spec.OTLPGateway != nil {
+		spec = t.Spec.OTLPGateway.ExternalIngestion
+	}
+
+	refs := secretref.GetSecretRefsExternalIngestion(spec)
+
+	if r.secretWatcher != nil {
+		if err := r.secretWatcher.SyncWatchers(ctx, &t, secretref.RefsToSecretNames(refs)); err != nil {
+			return nil, fmt.Errorf("failed to sync secret watchers: %w", err)
+		}
 	}
 
-	return r.gatewayApplierDeleter.ApplyResources(ctx, r.Client, opts)
+	for _, ref := range refs {
+		if _, err := secretref.GetValue(ctx, r.Client, ref); err != nil {
+			// The Telemetry reconciler reports the missing Secret in the ExternalIngestionConfigured condition of the Telemetry CR
+			logf.FromContext(ctx).Error(err, "Disabling external ingestion: referenced secret cannot be resolved")
+			return nil, nil
+		}
+	}
+
+	return spec, nil
+}
+
+// getTapSink returns the tap sink of the manager with the open taps of the pipelines that have the tap enabled, or nil if there are none.
+// If the CA certificate of the sink cannot be read, no tap is attached to the gateway configuration, so that the pipelines keep working.
+func (r *Reconciler) getTapSink(ctx context.Context, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline) *otlpgateway.TapSink {
+	if r.tapRegistry == nil {
+		return nil
+	}
+
+	taps := slices.DeleteFunc(r.tapRegistry.Taps(), func(t tap.Tap) bool {
+		return !isTapEnabled(t, tracePipelines, logPipelines, metricPipelines)
+	})
+	if len(taps) == 0 {
+		return nil
+	}
+
+	var secret corev1.Secret
+	if err := r.Get(ctx, r.tapSinkCASecret, &secret); err != nil {
+		logf.FromContext(ctx).Error(err, "Disabling tap: failed to get CA certificate of the tap sink")
+		return nil
+	}
+
+	caPem, found := secret.Data[tapSinkCACertKey]
+	if !found || len(caPem) == 0 {
+		logf.FromContext(ctx).Error(nil, "Disabling tap: CA certificate of the tap sink is missing", "secret", r.tapSinkCASecret.String())
+		return nil
+	}
+
+	return &otlpgateway.TapSink{
+		CAPem: string(caPem),
+		Taps:  taps,
+	}
+}
+
+// isTapEnabled returns true if the tapped pipeline is processed by the gateway and has the tap enabled.
+func isTapEnabled(t tap.Tap, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline) bool {
+	switch t.SignalType {
+	case pipelines.SignalTypeTrace:
+		return slices.ContainsFunc(tracePipelines, func(p telemetryv1beta1.TracePipeline) bool {
+			return p.Name == t.PipelineName && sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+		})
+	case pipelines.SignalTypeLog:
+		return slices.ContainsFunc(logPipelines, func(p telemetryv1beta1.LogPipeline) bool {
+			return p.Name == t.PipelineName && sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+		})
+	case pipelines.SignalTypeMetric:
+		return slices.ContainsFunc(metricPipelines, func(p telemetryv1beta1.MetricPipeline) bool {
+			return p.Name == t.PipelineName && sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+		})
+	default:
+		return false
+	}
+}
+
+func makeExternalIngestionOptions(spec *operatorv1beta1.ExternalIngestionSpec) *otelcollector.ExternalIngestionOptions {
+	if spec == nil {
+		return nil
+	}
+
+	serviceType := corev1.ServiceTypeClusterIP
+	if spec.Service != nil && spec.Service.Type == operatorv1beta1.ExternalIngestionServiceTypeLoadBalancer {
+		serviceType = corev1.ServiceTypeLoadBalancer
+	}
+
+	return &otelcollector.ExternalIngestionOptions{
+		ServiceType: serviceType,
+		MTLSEnabled: spec.Authentication.MTLS != nil,
+	}
+}
+
+// serviceAccountTokenAudiences collects the audiences of all outputs with service account token authentication, including the outputs of the routes,
+// so that a token is projected into the gateway pods for each of them
+func serviceAccountTokenAudiences(tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline, telemetryRoutes []telemetryv1beta1.TelemetryRoute) []string {
+	var outputs []*telemetryv1beta1.OTLPOutput
+
+	addRoutes := func(routes []telemetryv1beta1.OutputRoute) {
+		for _, route := range routes {
+			outputs = append(outputs, route.Output.OTLP)
+		}
+	}
+
+	for _, pipeline := range tracePipelines {
+		outputs = append(outputs, pipeline.Spec.Output.OTLP)
+		addRoutes(pipeline.Spec.Routes)
+	}
+
+	for _, pipeline := range logPipelines {
+		outputs = append(outputs, pipeline.Spec.Output.OTLP)
+		addRoutes(pipeline.Spec.Routes)
+	}
+
+	for _, pipeline := range metricPipelines {
+		if pipeline.Spec.Output.OTLP != nil {
+			outputs = append(outputs, &pipeline.Spec.Output.OTLP.OTLPOutput)
+		}
+
+		addRoutes(pipeline.Spec.Routes)
+	}
+
+	for _, route := range telemetryRoutes {
+		outputs = append(outputs, route.Spec.Output.OTLP)
+	}
+
+	return common.ServiceAccountTokenAudiences(outputs...)
 }
 
 // doReconcile performs the main reconciliation logic.
-func (r *Reconciler) doReconcile(ctx context.Context) error {
+func (r *Reconciler) doReconcile(ctx context.Context) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
 	// Clean up legacy per-signal gateway resources (from pre-OTLP-Gateway architecture)
 	if err := r.cleanupLegacyGateways(ctx); err != nil {
-		return fmt.Errorf("failed to clean up legacy gateways: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to clean up legacy gateways: %w", err)
 	}
 
 	config, err := coordinationconfig.ReadOTLPGatewayConfig(ctx, r.Client, r.globals.TargetNamespace())
 	if err != nil {
-		return fmt.Errorf("failed to read configmap: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to read configmap: %w", err)
 	}
 
 	tracePipelines, err := r.fetchTracePipelines(ctx, config.TracePipelineReferences)
 	if err != nil {
-		return fmt.Errorf("failed to fetch trace pipelines: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to fetch trace pipelines: %w", err)
 	}
 
 	logPipelines, err := r.fetchLogPipelines(ctx, config.LogPipelineReferences)
 	if err != nil {
-		return fmt.Errorf("failed to fetch log pipelines: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to fetch log pipelines: %w", err)
 	}
 
 	metricPipelines, err := r.fetchMetricPipelines(ctx, config.MetricPipelineReferences)
 	if err != nil {
-		return fmt.Errorf("failed to fetch metric pipelines: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to fetch metric pipelines: %w", err)
 	}
 
-	// If no valid pipelines of any type, clean up
-	if len(tracePipelines) == 0 && len(logPipelines) == 0 && len(metricPipelines) == 0 {
+	telemetryRoutes, err := r.fetchTelemetryRoutes(ctx, config.TelemetryRouteReferences)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to fetch telemetry routes: %w", err)
+	}
+
+	// If no valid pipelines or routes of any type, clean up
+	if len(tracePipelines) == 0 && len(logPipelines) == 0 && len(metricPipelines) == 0 && len(telemetryRoutes) == 0 {
 		log.V(1).Info("no valid pipelines, deleting gateway resources")
 
 		isIstioActive, err := r.istioStatusChecker.IsIstioActive(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to check Istio status: %w", err)
+			return ctrl.Result{}, fmt.Errorf("failed to check Istio status: %w", err)
 		}
 
 		vpaCRDExists, err := r.vpaStatusChecker.VpaCRDExists(ctx, r.Client)
 		if err != nil {
-			return fmt.Errorf("failed to check VPA CRD: %w", err)
+			return ctrl.Result{}, fmt.Errorf("failed to check VPA CRD: %w", err)
 		}
 
 		if err := r.gatewayApplierDeleter.DeleteResources(ctx, r.Client, isIstioActive, vpaCRDExists); err != nil {
-			return fmt.Errorf("failed to delete gateway: %w", err)
+			return ctrl.Result{}, fmt.Errorf("failed to delete gateway: %w", err)
+		}
+
+		// The snapshots contain the environment variables of the collector, so they must not outlive the gateway
+		if err := rollouthistory.DeleteSnapshots(ctx, r.Client, r.globals.TargetNamespace()); err != nil {
+			return ctrl.Result{}, err
 		}
 
 		// Pipeline controllers will detect gateway deletion via DaemonSet watch and update their own status
 
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	// Build and apply resources
-	if err := r.processConfigAndBuildResources(ctx, tracePipelines, logPipelines, metricPipelines); err != nil {
-		return err
-	}
-
 	// Pipeline controllers manage their own GatewayHealthy status conditions
-
-	return nil
+	return r.processConfigAndBuildResources(ctx, config, tracePipelines, logPipelines, metricPipelines, telemetryRoutes)
 }
 
 // fetchTracePipelines fetches TracePipeline CRs from references.
@@ -276,6 +497,11 @@ func (r *Reconciler) fetchTracePipelines(ctx context.Context, refs []coordinatio
 			continue
 		}
 
+		if !r.isProxyResolvable(ctx, pipelineOutputs(pipeline.Spec.Output.OTLP, pipeline.Spec.Routes)...) {
+			log.Info("pipeline proxy URL can't be resolved, skipping", "pipeline", ref.Name)
+			continue
+		}
+
 		pipelines = append(pipelines, pipeline)
 	}
 
@@ -310,6 +536,11 @@ func (r *Reconciler) fetchLogPipelines(ctx context.Context, refs []coordinationc
 			continue
 		}
 
+		if !r.isProxyResolvable(ctx, pipelineOutputs(pipeline.Spec.Output.OTLP, pipeline.Spec.Routes)...) {
+			log.Info("log pipeline proxy URL can't be resolved, skipping", "pipeline", ref.Name)
+			continue
+		}
+
 		pipelines = append(pipelines, pipeline)
 	}
 
@@ -344,14 +575,90 @@ func (r *Reconciler) fetchMetricPipelines(ctx context.Context, refs []coordinati
 			continue
 		}
 
+		var output *telemetryv1beta1.OTLPOutput
+		if pipeline.Spec.Output.OTLP != nil {
+			output = &pipeline.Spec.Output.OTLP.OTLPOutput
+		}
+
+		if !r.isProxyResolvable(ctx, pipelineOutputs(output, pipeline.Spec.Routes)...) {
+			log.Info("metric pipeline proxy URL can't be resolved, skipping", "pipeline", ref.Name)
+			continue
+		}
+
 		pipelines = append(pipelines, pipeline)
 	}
 
 	return pipelines, nil
 }
 
-// buildCollectorConfig builds OTel Collector configuration from TracePipeline, LogPipeline, and MetricPipeline CRs.
-func (r *Reconciler) buildCollectorConfig(ctx context.Context, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline) (*common.Config, common.EnvVars, error) {
+// fetchTelemetryRoutes fetches TelemetryRoute CRs from references.
+func (r *Reconciler) fetchTelemetryRoutes(ctx context.Context, refs []coordinationconfig.TelemetryRouteReference) ([]telemetryv1beta1.TelemetryRoute, error) {
+	log := logf.FromContext(ctx)
+	routes := make([]telemetryv1beta1.TelemetryRoute, 0, len(refs))
+
+	for _, ref := range refs {
+		key := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+
+		var route telemetryv1beta1.TelemetryRoute
+		if err := r.Get(ctx, key, &route); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.V(1).Info("telemetry route not found, skipping", "route", key.String())
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get telemetry route %s: %w", key.String(), err)
+		}
+
+		if route.DeletionTimestamp != nil {
+			log.V(1).Info("telemetry route being deleted, skipping", "route", key.String())
+			continue
+		}
+
+		if route.Generation != ref.Generation {
+			log.V(1).Info("telemetry route generation mismatch, skipping", "route", key.String(), "configGeneration", ref.Generation, "actualGeneration", route.Generation)
+			continue
+		}
+
+		if !r.isProxyResolvable(ctx, route.Spec.Output.OTLP) {
+			log.Info("telemetry route proxy URL can't be resolved, skipping", "route", key.String())
+			continue
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+// isProxyResolvable reports whether the proxy URLs of all given outputs can be resolved.
+// A pipeline with an unresolvable proxy URL is left out of the gateway configuration, so that it doesn't block the other pipelines.
+// The pipeline controller reports the problem in the pipeline status.
+func (r *Reconciler) isProxyResolvable(ctx context.Context, outputs ...*telemetryv1beta1.OTLPOutput) bool {
+	for _, output := range outputs {
+		if output == nil {
+			continue
+		}
+
+		if _, err := common.ResolveProxyURL(ctx, r.Client, output); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pipelineOutputs returns the default output and the route outputs of a pipeline.
+func pipelineOutputs(output *telemetryv1beta1.OTLPOutput, routes []telemetryv1beta1.OutputRoute) []*telemetryv1beta1.OTLPOutput {
+	outputs := []*telemetryv1beta1.OTLPOutput{output}
+	for _, route := range routes {
+		outputs = append(outputs, route.Output.OTLP)
+	}
+
+	return outputs
+}
+
+// buildCollectorConfig builds OTel Collector configuration from TracePipeline, LogPipeline, and MetricPipeline CRs and TelemetryRoutes.
+func (r *Reconciler) buildCollectorConfig(ctx context.Context, tracePipelines []telemetryv1beta1.TracePipeline, logPipelines []telemetryv1beta1.LogPipeline, metricPipelines []telemetryv1beta1.MetricPipeline, telemetryRoutes []telemetryv1beta1.TelemetryRoute, externalIngestion *operatorv1beta1.ExternalIngestionSpec, tapSink *otlpgateway.TapSink) (*common.Config, common.EnvVars, error) {
 	shootInfo := k8sutils.GetGardenerShootInfo(ctx, r.Client)
 	clusterName := telemetryutils.GetClusterNameFromTelemetry(ctx, r.Client, r.globals.DefaultTelemetryNamespace())
 
@@ -360,11 +667,18 @@ func (r *Reconciler) buildCollectorConfig(ctx context.Context, tracePipelines []
 		return nil, nil, fmt.Errorf("failed to get cluster uid: %w", err)
 	}
 
-	var enrichments *operatorv1beta1.EnrichmentSpec
+	var (
+		enrichments *operatorv1beta1.EnrichmentSpec
+		receiver    *operatorv1beta1.OTLPReceiverSpec
+	)
 
 	t, err := telemetryutils.GetDefaultTelemetryInstance(ctx, r.Client, r.globals.DefaultTelemetryNamespace())
 	if err == nil {
 		enrichments = t.Spec.Enrichments
+
+		if t.Spec.OTLPGateway != nil {
+			receiver = t.Spec.OTLPGateway.Receiver
+		}
 	}
 
 	vpaCRDExists, err := r.vpaStatusChecker.VpaCRDExists(ctx, r.Client)
@@ -378,6 +692,7 @@ func (r *Reconciler) buildCollectorConfig(ctx context.Context, tracePipelines []
 		LogPipelines:    logPipelines,
 		TracePipelines:  tracePipelines,
 		MetricPipelines: metricPipelines,
+		TelemetryRoutes: telemetryRoutes,
 		Cluster: common.ClusterOptions{
 			ClusterName:   clusterName,
 			ClusterUID:    clusterUID,
@@ -388,6 +703,9 @@ func (r *Reconciler) buildCollectorConfig(ctx context.Context, tracePipelines []
 		ModuleVersion:     r.globals.Version(),
 		GatewayNamespace:  r.globals.TargetNamespace(),
 		VpaActive:         vpaCRDExists && vpaEnabled,
+		ExternalIngestion: externalIngestion,
+		Receiver:          receiver,
+		TapSink:           tapSink,
 	})
 }
 
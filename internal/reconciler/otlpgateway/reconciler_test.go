@@ -2,6 +2,8 @@ package otlpgateway
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,16 +13,22 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpgateway"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/otlpgateway/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/otlpgateway/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 )
 
 func TestReconcile_MissingConfigMap_DeletesGateway(t *testing.T) {
@@ -272,6 +280,276 @@ func TestReconcile_IstioEnabled_PassesFlag(t *testing.T) {
 	assertAll(t)
 }
 
+func TestReconcile_ExternalIngestion(t *testing.T) {
+	newTelemetry := func() *operatorv1beta1.Telemetry {
+		return &operatorv1beta1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      names.DefaultTelemetry,
+				Namespace: "kyma-system",
+			},
+			Spec: operatorv1beta1.TelemetrySpec{
+				OTLPGateway: &operatorv1beta1.OTLPGatewaySpec{
+					ExternalIngestion: &operatorv1beta1.ExternalIngestionSpec{
+						Tenant: "my-tenant",
+						Authentication: operatorv1beta1.ExternalIngestionAuthentication{
+							BearerToken: &operatorv1beta1.SecretKeyRef{Name: "ingestion-token", Namespace: "default", Key: "token"},
+						},
+						Service: &operatorv1beta1.ExternalIngestionService{Type: operatorv1beta1.ExternalIngestionServiceTypeLoadBalancer},
+					},
+				},
+			},
+		}
+	}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingestion-token",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"token": []byte("my-token")},
+	}
+
+	tests := []struct {
+		name                    string
+		objects                 []client.Object
+		expectExternalIngestion bool
+		expectedServiceType     corev1.ServiceType
+	}{
+		{
+			name:                    "secret exists",
+			objects:                 []client.Object{newTelemetry(), tokenSecret},
+			expectExternalIngestion: true,
+			expectedServiceType:     corev1.ServiceTypeLoadBalancer,
+		},
+		{
+			name:                    "secret missing disables external ingestion",
+			objects:                 []client.Object{newTelemetry()},
+			expectExternalIngestion: false,
+		},
+		{
+			name:                    "no telemetry CR",
+			expectExternalIngestion: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewTracePipelineBuilder().
+				WithName("test-pipeline").
+				Build()
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      names.OTLPGatewayCoordinationConfigMap,
+					Namespace: "kyma-system",
+				},
+				Data: map[string]string{
+					coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-pipeline\n  generation: 1",
+				},
+			}
+
+			fakeClient := newTestClient(t, append(tt.objects, &pipeline, cm)...)
+
+			cb := &mocks.OTLPGatewayConfigBuilder{}
+			cb.On("Build", mock.Anything, mock.MatchedBy(func(opts otlpgateway.BuildOptions) bool {
+				return (opts.ExternalIngestion != nil) == tt.expectExternalIngestion
+			})).Return(&common.Config{}, common.EnvVars{}, nil).Once()
+
+			gad := &mocks.GatewayApplierDeleter{}
+			gad.On("ApplyResources", mock.Anything, mock.Anything, mock.MatchedBy(func(opts otelcollector.GatewayApplyOptions) bool {
+				if !tt.expectExternalIngestion {
+					return opts.ExternalIngestion == nil
+				}
+
+				return opts.ExternalIngestion != nil && opts.ExternalIngestion.ServiceType == tt.expectedServiceType
+			})).Return(nil).Once()
+
+			secretWatcher := &stubs.SecretWatcher{}
+
+			sut, assertAll := newTestReconciler(fakeClient,
+				withConfigBuilderAssert(cb),
+				withGatewayApplierDeleterAssert(gad),
+				WithSecretWatcher(secretWatcher),
+			)
+
+			_, err := sut.Reconcile(t.Context(), newReconcileRequest())
+			require.NoError(t, err)
+
+			if len(tt.objects) > 0 {
+				require.Equal(t, []types.NamespacedName{{Name: "ingestion-token", Namespace: "default"}}, secretWatcher.Secrets)
+			}
+
+			assertAll(t)
+		})
+	}
+}
+
+func TestReconcile_Receiver_PassedToConfigBuilder(t *testing.T) {
+	receiver := &operatorv1beta1.OTLPReceiverSpec{
+		GRPC: &operatorv1beta1.OTLPReceiverGRPCSpec{
+			MaxRecvMsgSizeMiB: new(int32(16)),
+		},
+		HTTP: &operatorv1beta1.OTLPReceiverHTTPSpec{
+			CORS: &operatorv1beta1.OTLPReceiverCORS{
+				AllowedOrigins: []string{"https://shop.example.com"},
+			},
+		},
+	}
+
+	telemetry := &operatorv1beta1.Telemetry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.DefaultTelemetry,
+			Namespace: "kyma-system",
+		},
+		Spec: operatorv1beta1.TelemetrySpec{
+			OTLPGateway: &operatorv1beta1.OTLPGatewaySpec{
+				Receiver: receiver,
+			},
+		},
+	}
+
+	pipeline := testutils.NewTracePipelineBuilder().
+		WithName("test-pipeline").
+		Build()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayCoordinationConfigMap,
+			Namespace: "kyma-system",
+		},
+		Data: map[string]string{
+			coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-pipeline\n  generation: 1",
+		},
+	}
+
+	fakeClient := newTestClient(t, telemetry, &pipeline, cm)
+
+	cb := &mocks.OTLPGatewayConfigBuilder{}
+	cb.On("Build", mock.Anything, mock.MatchedBy(func(opts otlpgateway.BuildOptions) bool {
+		return assert.ObjectsAreEqual(receiver, opts.Receiver)
+	})).Return(&common.Config{}, common.EnvVars{}, nil).Once()
+
+	gad := &mocks.GatewayApplierDeleter{}
+	gad.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	sut, assertAll := newTestReconciler(fakeClient,
+		withConfigBuilderAssert(cb),
+		withGatewayApplierDeleterAssert(gad),
+	)
+
+	_, err := sut.Reconcile(t.Context(), newReconcileRequest())
+	require.NoError(t, err)
+
+	assertAll(t)
+}
+
+func TestReconcile_TapSink(t *testing.T) {
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.ManagerWebhookCertSecret,
+			Namespace: "kyma-system",
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte("my-ca"),
+		},
+	}
+
+	openTap := tap.Tap{
+		SignalType:    pipelines.SignalTypeTrace,
+		PipelineName:  "test-pipeline",
+		Stage:         tap.StageExport,
+		SamplingRatio: 1,
+		Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/session",
+	}
+
+	tests := []struct {
+		name            string
+		tapEnabled      bool
+		openTaps        []tap.Tap
+		objects         []client.Object
+		expectedTapSink *otlpgateway.TapSink
+	}{
+		{
+			name:       "tap open",
+			tapEnabled: true,
+			openTaps:   []tap.Tap{openTap},
+			objects:    []client.Object{caSecret},
+			expectedTapSink: &otlpgateway.TapSink{
+				CAPem: "my-ca",
+				Taps:  []tap.Tap{openTap},
+			},
+		},
+		{
+			name:       "no tap open",
+			tapEnabled: true,
+			objects:    []client.Object{caSecret},
+		},
+		{
+			name:       "tap of other pipeline open",
+			tapEnabled: true,
+			openTaps: []tap.Tap{
+				{SignalType: pipelines.SignalTypeTrace, PipelineName: "other-pipeline", Stage: tap.StageExport, SamplingRatio: 1},
+				{SignalType: pipelines.SignalTypeLog, PipelineName: "test-pipeline", Stage: tap.StageExport, SamplingRatio: 1},
+			},
+			objects: []client.Object{caSecret},
+		},
+		{
+			name:       "tap disabled",
+			tapEnabled: false,
+			openTaps:   []tap.Tap{openTap},
+			objects:    []client.Object{caSecret},
+		},
+		{
+			name:       "CA secret missing disables tap",
+			tapEnabled: true,
+			openTaps:   []tap.Tap{openTap},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewTracePipelineBuilder().
+				WithName("test-pipeline").
+				WithTap(tt.tapEnabled).
+				Build()
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      names.OTLPGatewayCoordinationConfigMap,
+					Namespace: "kyma-system",
+				},
+				Data: map[string]string{
+					coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-pipeline\n  generation: 1",
+				},
+			}
+
+			fakeClient := newTestClient(t, append(tt.objects, &pipeline, cm)...)
+
+			cb := &mocks.OTLPGatewayConfigBuilder{}
+			cb.On("Build", mock.Anything, mock.MatchedBy(func(opts otlpgateway.BuildOptions) bool {
+				return assert.ObjectsAreEqual(tt.expectedTapSink, opts.TapSink)
+			})).Return(&common.Config{}, common.EnvVars{}, nil).Once()
+
+			gad := &mocks.GatewayApplierDeleter{}
+			gad.On("ApplyResources", mock.Anything, mock.Anything, mock.MatchedBy(func(opts otelcollector.GatewayApplyOptions) bool {
+				// The gateway only gets a token for the tap sink if the tap is added to its configuration
+				return slices.Contains(opts.ServiceAccountTokenAudiences, tap.SinkAudience) == (tt.expectedTapSink != nil)
+			})).Return(nil).Once()
+
+			sut, assertAll := newTestReconciler(fakeClient,
+				withConfigBuilderAssert(cb),
+				withGatewayApplierDeleterAssert(gad),
+				WithTapRegistry(&stubs.TapRegistry{OpenTaps: tt.openTaps}, types.NamespacedName{Name: names.ManagerWebhookCertSecret, Namespace: "kyma-system"}),
+			)
+
+			_, err := sut.Reconcile(t.Context(), newReconcileRequest())
+			require.NoError(t, err)
+
+			assertAll(t)
+		})
+	}
+}
+
 func TestFetchTracePipelines_NotFound(t *testing.T) {
 	ctx := context.Background()
 
@@ -342,6 +620,38 @@ func TestFetchTracePipelines_Success(t *testing.T) {
 	assertAll(t)
 }
 
+func TestFetchTracePipelines_UnresolvableProxyURL(t *testing.T) {
+	ctx := context.Background()
+
+	proxySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "proxy", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte("http://proxy:3128")},
+	}
+
+	resolvable := testutils.NewTracePipelineBuilder().
+		WithName("resolvable").
+		WithOTLPOutput(testutils.OTLPProtocol(telemetryv1beta1.OTLPProtocolHTTP), testutils.OTLPProxyURLFromSecret("proxy", "default", "url")).
+		Build()
+	resolvable.Generation = 1
+
+	unresolvable := testutils.NewTracePipelineBuilder().
+		WithName("unresolvable").
+		WithOTLPOutput(testutils.OTLPProtocol(telemetryv1beta1.OTLPProtocolHTTP), testutils.OTLPProxyURLFromSecret("missing", "default", "url")).
+		Build()
+	unresolvable.Generation = 1
+
+	sut, assertAll := newTestReconciler(newTestClient(t, proxySecret, &resolvable, &unresolvable))
+
+	pipelines, err := sut.fetchTracePipelines(ctx, []coordinationconfig.PipelineReference{
+		{Name: "resolvable", Generation: 1},
+		{Name: "unresolvable", Generation: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, pipelines, 1)
+	assert.Equal(t, "resolvable", pipelines[0].Name)
+	assertAll(t)
+}
+
 func TestFetchTracePipelines_GetError(t *testing.T) {
 	ctx := context.Background()
 
@@ -435,6 +745,39 @@ func TestReconcile_TraceAndLogPipelines_DeploysUnifiedGateway(t *testing.T) {
 	assertAll(t)
 }
 
+func TestReconcile_InvalidConfig_KeepsCurrentConfig(t *testing.T) {
+	ctx := context.Background()
+
+	tracePipeline := testutils.NewTracePipelineBuilder().
+		WithName("test-trace-pipeline").
+		Build()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayCoordinationConfigMap,
+			Namespace: "kyma-system",
+		},
+		Data: map[string]string{
+			coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-trace-pipeline\n  generation: 1",
+		},
+	}
+
+	fakeClient := newTestClient(t, &tracePipeline, cm)
+
+	gad := &mocks.GatewayApplierDeleter{}
+
+	sut, assertAll := newTestReconciler(fakeClient,
+		WithConfigValidator(&stubs.ConfigValidator{Err: &collectorconfig.ConfigInvalidError{Err: errors.New("service pipeline 'traces/test-trace-pipeline' has no exporters")}}),
+		withGatewayApplierDeleterAssert(gad),
+	)
+
+	_, err := sut.Reconcile(ctx, newReconcileRequest())
+	require.ErrorContains(t, err, "generated OTLP Gateway configuration is invalid")
+
+	gad.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+	assertAll(t)
+}
+
 // Tests for log pipeline scenarios
 func TestFetchLogPipelines_NotFound(t *testing.T) {
 	ctx := context.Background()
@@ -749,3 +1092,95 @@ func TestReconcile_MetricPipeline_DeploysGateway(t *testing.T) {
 
 	assertAll(t)
 }
+
+func TestReconcile_OnlyTelemetryRoutes_DeploysGateway(t *testing.T) {
+	route := testutils.NewTelemetryRouteBuilder().
+		WithName("backend").
+		WithNamespace("team-a").
+		Build()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayCoordinationConfigMap,
+			Namespace: "kyma-system",
+		},
+		Data: map[string]string{
+			coordinationconfig.ConfigMapDataKey: "telemetryRoutes:\n- name: backend\n  namespace: team-a\n  generation: 1",
+		},
+	}
+
+	fakeClient := newTestClient(t, &route, cm)
+
+	cb := &mocks.OTLPGatewayConfigBuilder{}
+	cb.On("Build", mock.Anything, mock.MatchedBy(func(opts otlpgateway.BuildOptions) bool {
+		return len(opts.TelemetryRoutes) == 1 && opts.TelemetryRoutes[0].Namespace == "team-a" && opts.TelemetryRoutes[0].Name == "backend"
+	})).Return(&common.Config{}, common.EnvVars{}, nil).Once()
+
+	gad := &mocks.GatewayApplierDeleter{}
+	gad.On("ApplyResources", mock.Anything, mock.Anything, mock.MatchedBy(func(opts otelcollector.GatewayApplyOptions) bool {
+		return opts.ResourceRequirementsMultiplier == 1
+	})).Return(nil).Once()
+
+	sut, assertAll := newTestReconciler(fakeClient,
+		withConfigBuilderAssert(cb),
+		withGatewayApplierDeleterAssert(gad),
+	)
+
+	_, err := sut.Reconcile(t.Context(), newReconcileRequest())
+	require.NoError(t, err)
+
+	assertAll(t)
+}
+
+func TestFetchTelemetryRoutes(t *testing.T) {
+	now := metav1.Now()
+
+	route := testutils.NewTelemetryRouteBuilder().WithName("backend").WithNamespace("team-a").Build()
+	outdatedRoute := testutils.NewTelemetryRouteBuilder().WithName("outdated").WithNamespace("team-a").Build()
+	outdatedRoute.Generation = 2
+	deletingRoute := testutils.NewTelemetryRouteBuilder().WithName("deleting").WithNamespace("team-a").Build()
+	deletingRoute.DeletionTimestamp = &now
+	deletingRoute.Finalizers = []string{"test-finalizer"}
+
+	sut, assertAll := newTestReconciler(newTestClient(t, &route, &outdatedRoute, &deletingRoute))
+
+	routes, err := sut.fetchTelemetryRoutes(t.Context(), []coordinationconfig.TelemetryRouteReference{
+		{Name: "backend", Namespace: "team-a", Generation: 1},
+		{Name: "backend", Namespace: "team-b", Generation: 1},
+		{Name: "outdated", Namespace: "team-a", Generation: 1},
+		{Name: "deleting", Namespace: "team-a", Generation: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "backend", routes[0].Name)
+	assert.Equal(t, "team-a", routes[0].Namespace)
+	assertAll(t)
+}
+
+func TestFetchTelemetryRoutes_UnresolvableProxyURL(t *testing.T) {
+	route := testutils.NewTelemetryRouteBuilder().
+		WithName("backend").
+		WithNamespace("team-a").
+		WithOTLPOutput(testutils.OTLPProtocol(telemetryv1beta1.OTLPProtocolHTTP), testutils.OTLPProxyURLFromSecret("missing", "team-a", "url")).
+		Build()
+
+	sut, assertAll := newTestReconciler(newTestClient(t, &route))
+
+	routes, err := sut.fetchTelemetryRoutes(t.Context(), []coordinationconfig.TelemetryRouteReference{
+		{Name: "backend", Namespace: "team-a", Generation: 1},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+	assertAll(t)
+}
+
+func TestFetchTelemetryRoutes_GetError(t *testing.T) {
+	sut, assertAll := newTestReconciler(newTestClient(t))
+	sut.Client = &stubs.ErrorClient{Err: assert.AnError}
+
+	_, err := sut.fetchTelemetryRoutes(t.Context(), []coordinationconfig.TelemetryRouteReference{
+		{Name: "backend", Namespace: "team-a", Generation: 1},
+	})
+	require.Error(t, err)
+	assertAll(t)
+}
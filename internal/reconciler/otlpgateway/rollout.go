@@ -0,0 +1,216 @@
+package otlpgateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/k8sclients"
+	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/resources/rollouthistory"
+)
+
+// rolloutCheckInterval is the interval in which the outcome of a progressing rollout is checked.
+const rolloutCheckInterval = 30 * time.Second
+
+// applyAndRecordRollout applies the gateway resources and records every new collector configuration as a revision in the rollout history.
+// If the latest revision crash-loops the gateway, the configuration of the last known good revision is applied instead,
+// until the pipelines change and a new configuration is generated.
+// It returns true if the outcome of the latest revision is not known yet.
+func (r *Reconciler) applyAndRecordRollout(ctx context.Context, refs *coordinationconfig.OTLPGatewayConfigMap, opts otelcollector.GatewayApplyOptions) (bool, error) {
+	if r.gatewayProber == nil {
+		return false, r.gatewayApplierDeleter.ApplyResources(ctx, r.Client, opts)
+	}
+
+	namespace := r.globals.TargetNamespace()
+	labelerClient := k8sclients.NewLabeler(r.Client, commonresources.DefaultLabels(names.OTLPGateway, commonresources.LabelValueK8sComponentGateway))
+
+	history, err := rollouthistory.ReadHistory(ctx, labelerClient, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	snapshots, err := rollouthistory.ReadSnapshots(ctx, labelerClient, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.updateRolloutOutcome(ctx, history); err != nil {
+		return false, err
+	}
+
+	desired := rollouthistory.Snapshot{
+		CollectorConfigYAML: opts.CollectorConfigYAML,
+		CollectorEnvVars:    opts.CollectorEnvVars,
+	}
+
+	applied, err := nextRevision(history, snapshots, refs, desired)
+	if err != nil {
+		return false, err
+	}
+
+	pruneSnapshots(history, snapshots)
+
+	// Persist the snapshots before applying, so that a crash-looping revision can always be rolled back
+	if err := rollouthistory.WriteSnapshots(ctx, labelerClient, namespace, snapshots); err != nil {
+		return false, err
+	}
+
+	if err := rollouthistory.WriteHistory(ctx, labelerClient, namespace, history); err != nil {
+		return false, err
+	}
+
+	opts.CollectorConfigYAML = applied.CollectorConfigYAML
+	opts.CollectorEnvVars = applied.CollectorEnvVars
+
+	if err := r.gatewayApplierDeleter.ApplyResources(ctx, r.Client, opts); err != nil {
+		return false, err
+	}
+
+	return history.Latest().Outcome == rollouthistory.OutcomeProgressing, nil
+}
+
+// updateRolloutOutcome determines the outcome of the latest revision if it is still progressing.
+func (r *Reconciler) updateRolloutOutcome(ctx context.Context, history *rollouthistory.History) error {
+	latest := history.Latest()
+	if latest == nil || latest.Outcome != rollouthistory.OutcomeProgressing {
+		return nil
+	}
+
+	name := types.NamespacedName{Name: names.OTLPGateway, Namespace: r.globals.TargetNamespace()}
+
+	crashLooping, err := r.gatewayProber.IsCrashLooping(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to check OTLP Gateway Pods: %w", err)
+	}
+
+	if crashLooping {
+		logf.FromContext(ctx).Info("OTLP Gateway Pods are crash-looping after rollout", "revision", latest.Revision)
+
+		latest.Outcome = rollouthistory.OutcomeFailed
+		latest.Message = "OTLP Gateway Pods are crash-looping with this revision"
+
+		return nil
+	}
+
+	rolledOut, err := r.gatewayProber.IsRolledOut(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to check OTLP Gateway rollout: %w", err)
+	}
+
+	if rolledOut {
+		latest.Outcome = rollouthistory.OutcomeSucceeded
+		latest.Message = ""
+		history.LastKnownGoodRevision = latest.Revision
+	}
+
+	return nil
+}
+
+// nextRevision records the desired configuration in the history if it differs from the latest revision,
+// and returns the configuration that must be applied.
+func nextRevision(history *rollouthistory.History, snapshots map[int64]rollouthistory.Snapshot, refs *coordinationconfig.OTLPGatewayConfigMap, desired rollouthistory.Snapshot) (rollouthistory.Snapshot, error) {
+	desiredChecksum := desired.Checksum()
+	latest := history.Latest()
+
+	if latest != nil {
+		switch {
+		case latest.Outcome == rollouthistory.OutcomeFailed && latest.ConfigChecksum == desiredChecksum:
+			if lastKnownGood, found := snapshots[history.LastKnownGoodRevision]; found {
+				return rollback(history, snapshots, refs, lastKnownGood)
+			}
+
+			// There is no known good configuration to roll back to, so keep the failed one
+			snapshots[latest.Revision] = desired
+
+			return desired, nil
+
+		case latest.Outcome == rollouthistory.OutcomeRolledBack && isRolledBackConfig(history, latest, desiredChecksum):
+			// Keep the rollback until the pipelines change
+			if rolledBack, found := snapshots[latest.Revision]; found {
+				return rolledBack, nil
+			}
+
+		case latest.ConfigChecksum == desiredChecksum:
+			snapshots[latest.Revision] = desired
+			return desired, nil
+		}
+	}
+
+	var previous rollouthistory.Revision
+	if latest != nil {
+		previous = *latest
+	}
+
+	previousSnapshot := snapshots[previous.Revision]
+
+	diff, err := rollouthistory.Diff(previousSnapshot.CollectorConfigYAML, desired.CollectorConfigYAML, previous.Revision, previous.Revision+1)
+	if err != nil {
+		return rollouthistory.Snapshot{}, err
+	}
+
+	revision := history.Append(rollouthistory.Revision{
+		Timestamp:        revisionTimestamp(),
+		ConfigChecksum:   desiredChecksum,
+		Pipelines:        *refs,
+		ChangedPipelines: rollouthistory.ChangedPipelines(previous.Pipelines, *refs),
+		ChangedEnvVars:   rollouthistory.ChangedEnvVars(previousSnapshot.CollectorEnvVars, desired.CollectorEnvVars),
+		Diff:             diff,
+		Outcome:          rollouthistory.OutcomeProgressing,
+	})
+	snapshots[revision.Revision] = desired
+
+	return desired, nil
+}
+
+// rollback records a revision that restores the last known good configuration after the latest revision failed.
+func rollback(history *rollouthistory.History, snapshots map[int64]rollouthistory.Snapshot, refs *coordinationconfig.OTLPGatewayConfigMap, lastKnownGood rollouthistory.Snapshot) (rollouthistory.Snapshot, error) {
+	failed := *history.Latest()
+	failedSnapshot := snapshots[failed.Revision]
+
+	diff, err := rollouthistory.Diff(failedSnapshot.CollectorConfigYAML, lastKnownGood.CollectorConfigYAML, failed.Revision, failed.Revision+1)
+	if err != nil {
+		return rollouthistory.Snapshot{}, err
+	}
+
+	revision := history.Append(rollouthistory.Revision{
+		Timestamp:      revisionTimestamp(),
+		ConfigChecksum: lastKnownGood.Checksum(),
+		Pipelines:      *refs,
+		ChangedEnvVars: rollouthistory.ChangedEnvVars(failedSnapshot.CollectorEnvVars, lastKnownGood.CollectorEnvVars),
+		Diff:           diff,
+		RolledBackFrom: failed.Revision,
+		Outcome:        rollouthistory.OutcomeRolledBack,
+		Message:        fmt.Sprintf("Rolled back to revision %d because revision %d crash-loops the OTLP Gateway", history.LastKnownGoodRevision, failed.Revision),
+	})
+	snapshots[revision.Revision] = lastKnownGood
+
+	return lastKnownGood, nil
+}
+
+// isRolledBackConfig returns true if the given checksum belongs to the failed revision that was replaced by the rollback revision.
+func isRolledBackConfig(history *rollouthistory.History, rollbackRevision *rollouthistory.Revision, checksum string) bool {
+	failed := history.Find(rollbackRevision.RolledBackFrom)
+	return failed != nil && failed.ConfigChecksum == checksum
+}
+
+// pruneSnapshots drops all snapshots that are neither needed for diffing against the latest revision nor for rolling back.
+func pruneSnapshots(history *rollouthistory.History, snapshots map[int64]rollouthistory.Snapshot) {
+	latest := history.Latest()
+
+	for revision := range snapshots {
+		if (latest == nil || revision != latest.Revision) && revision != history.LastKnownGoodRevision {
+			delete(snapshots, revision)
+		}
+	}
+}
+
+func revisionTimestamp() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
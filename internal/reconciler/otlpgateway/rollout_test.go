@@ -0,0 +1,184 @@
+package otlpgateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpgateway"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/otlpgateway/mocks"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/otlpgateway/stubs"
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+	"github.com/kyma-project/telemetry-manager/internal/resources/rollouthistory"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestReconcile_RolloutHistory(t *testing.T) {
+	ctx := context.Background()
+
+	pipeline := testutils.NewTracePipelineBuilder().WithName("test-pipeline").Build()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayCoordinationConfigMap,
+			Namespace: "kyma-system",
+		},
+		Data: map[string]string{
+			coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-pipeline\n  generation: 1",
+		},
+	}
+
+	fakeClient := newTestClient(t, &pipeline, cm)
+
+	goodConfig := &common.Config{Exporters: map[string]any{"otlp/good": map[string]any{"endpoint": "${OTLP_ENDPOINT}"}}}
+	badConfig := &common.Config{Exporters: map[string]any{"otlp/bad": map[string]any{"endpoint": "${OTLP_ENDPOINT}"}}}
+	fixedConfig := &common.Config{Exporters: map[string]any{"otlp/fixed": map[string]any{"endpoint": "${OTLP_ENDPOINT}"}}}
+	envVars := common.EnvVars{"OTLP_ENDPOINT": []byte("https://backend:4317")}
+
+	builder := &configBuilderFake{config: goodConfig, envVars: envVars}
+	prober := &stubs.GatewayProber{}
+
+	var appliedConfigs []string
+
+	gad := &mocks.GatewayApplierDeleter{}
+	gad.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		appliedConfigs = append(appliedConfigs, args.Get(2).(otelcollector.GatewayApplyOptions).CollectorConfigYAML)
+	}).Return(nil)
+
+	sut, _ := newTestReconciler(fakeClient,
+		WithConfigBuilder(builder),
+		WithGatewayProber(prober),
+		WithGatewayApplierDeleter(gad),
+	)
+
+	reconcile := func() bool {
+		t.Helper()
+
+		result, err := sut.Reconcile(ctx, newReconcileRequest())
+		require.NoError(t, err)
+
+		return result.RequeueAfter > 0
+	}
+
+	readHistory := func() *rollouthistory.History {
+		t.Helper()
+
+		history, err := rollouthistory.ReadHistory(ctx, fakeClient, "kyma-system")
+		require.NoError(t, err)
+
+		return history
+	}
+
+	// The first configuration is recorded as progressing revision
+	require.True(t, reconcile(), "the outcome of a progressing rollout must be checked again")
+
+	history := readHistory()
+	require.Len(t, history.Revisions, 1)
+	require.Equal(t, rollouthistory.OutcomeProgressing, history.Latest().Outcome)
+	require.Equal(t, []string{"TracePipeline/test-pipeline"}, history.Latest().ChangedPipelines)
+	require.Equal(t, []string{"OTLP_ENDPOINT"}, history.Latest().ChangedEnvVars)
+	require.Contains(t, history.Latest().Diff, "+  otlp/good:")
+
+	// The revision succeeds once the gateway is rolled out
+	prober.RolledOut = true
+	require.False(t, reconcile())
+
+	history = readHistory()
+	require.Len(t, history.Revisions, 1)
+	require.Equal(t, rollouthistory.OutcomeSucceeded, history.Latest().Outcome)
+	require.Equal(t, int64(1), history.LastKnownGoodRevision)
+
+	// A new configuration is recorded as new revision with the diff to the previous one
+	builder.config = badConfig
+	prober.RolledOut = false
+	require.True(t, reconcile())
+
+	history = readHistory()
+	require.Len(t, history.Revisions, 2)
+	require.Equal(t, rollouthistory.OutcomeProgressing, history.Latest().Outcome)
+	require.Contains(t, history.Latest().Diff, "-  otlp/good:")
+	require.Contains(t, history.Latest().Diff, "+  otlp/bad:")
+	require.Empty(t, history.Latest().ChangedEnvVars)
+
+	// A crash-looping revision is rolled back to the last known good revision
+	prober.CrashLooping = true
+	require.False(t, reconcile())
+
+	history = readHistory()
+	require.Len(t, history.Revisions, 3)
+	require.Equal(t, rollouthistory.OutcomeFailed, history.Revisions[1].Outcome)
+	require.Equal(t, rollouthistory.OutcomeRolledBack, history.Latest().Outcome)
+	require.Equal(t, int64(2), history.Latest().RolledBackFrom)
+	require.Contains(t, appliedConfigs[len(appliedConfigs)-1], "otlp/good")
+
+	// The rollback is kept as long as the pipelines generate the failed configuration
+	prober.CrashLooping = false
+	require.False(t, reconcile())
+
+	history = readHistory()
+	require.Len(t, history.Revisions, 3)
+	require.Contains(t, appliedConfigs[len(appliedConfigs)-1], "otlp/good")
+
+	// A changed configuration is rolled out again
+	builder.config = fixedConfig
+	require.True(t, reconcile())
+
+	history = readHistory()
+	require.Len(t, history.Revisions, 4)
+	require.Equal(t, rollouthistory.OutcomeProgressing, history.Latest().Outcome)
+	require.Contains(t, appliedConfigs[len(appliedConfigs)-1], "otlp/fixed")
+
+	// Only the snapshots of the latest and the last known good revision are kept
+	snapshots, err := rollouthistory.ReadSnapshots(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Contains(t, snapshots, int64(1))
+	require.Contains(t, snapshots, int64(4))
+}
+
+func TestReconcile_RolloutHistory_NoLastKnownGoodRevision(t *testing.T) {
+	ctx := context.Background()
+
+	pipeline := testutils.NewTracePipelineBuilder().WithName("test-pipeline").Build()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayCoordinationConfigMap,
+			Namespace: "kyma-system",
+		},
+		Data: map[string]string{
+			coordinationconfig.ConfigMapDataKey: "tracePipelines:\n- name: test-pipeline\n  generation: 1",
+		},
+	}
+
+	fakeClient := newTestClient(t, &pipeline, cm)
+
+	sut, _ := newTestReconciler(fakeClient,
+		WithGatewayProber(&stubs.GatewayProber{CrashLooping: true}),
+	)
+
+	for range 2 {
+		_, err := sut.Reconcile(ctx, newReconcileRequest())
+		require.NoError(t, err)
+	}
+
+	history, err := rollouthistory.ReadHistory(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 1, "a failed revision without a known good revision must not be rolled back")
+	require.Equal(t, rollouthistory.OutcomeFailed, history.Latest().Outcome)
+}
+
+// configBuilderFake returns the configured collector configuration, which can be changed between reconciliations.
+type configBuilderFake struct {
+	config  *common.Config
+	envVars common.EnvVars
+}
+
+func (b *configBuilderFake) Build(_ context.Context, _ otlpgateway.BuildOptions) (*common.Config, common.EnvVars, error) {
+	return b.config, b.envVars, nil
+}
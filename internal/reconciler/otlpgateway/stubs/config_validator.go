@@ -0,0 +1,13 @@
+package stubs
+
+import (
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+type ConfigValidator struct {
+	Err error
+}
+
+func (v *ConfigValidator) Validate(_ *common.Config, _ common.EnvVars) error {
+	return v.Err
+}
@@ -0,0 +1,20 @@
+package stubs
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type GatewayProber struct {
+	RolledOut    bool
+	CrashLooping bool
+}
+
+func (p *GatewayProber) IsRolledOut(_ context.Context, _ types.NamespacedName) (bool, error) {
+	return p.RolledOut, nil
+}
+
+func (p *GatewayProber) IsCrashLooping(_ context.Context, _ types.NamespacedName) (bool, error) {
+	return p.CrashLooping, nil
+}
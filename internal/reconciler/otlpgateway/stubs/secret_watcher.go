@@ -0,0 +1,18 @@
+package stubs
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SecretWatcher struct {
+	Secrets []types.NamespacedName
+	Err     error
+}
+
+func (s *SecretWatcher) SyncWatchers(ctx context.Context, object client.Object, secrets []types.NamespacedName) error {
+	s.Secrets = secrets
+	return s.Err
+}
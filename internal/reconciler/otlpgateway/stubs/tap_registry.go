@@ -0,0 +1,11 @@
+package stubs
+
+import "github.com/kyma-project/telemetry-manager/internal/tap"
+
+type TapRegistry struct {
+	OpenTaps []tap.Tap
+}
+
+func (r *TapRegistry) Taps() []tap.Tap {
+	return r.OpenTaps
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/k8sclients"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/selfmonitor"
@@ -67,6 +68,17 @@ type Reconciler struct {
 	healthCheckers            healthCheckers
 	overridesHandler          OverridesHandler
 	selfMonitorApplierDeleter SelfMonitorApplierDeleter
+	eventRecorder             commonstatus.EventRecorder
+}
+
+// Option configures the Reconciler during initialization.
+type Option func(*Reconciler)
+
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
 }
 
 func New(
@@ -75,8 +87,9 @@ func New(
 	client client.Client,
 	overridesHandler OverridesHandler,
 	selfMonitorApplierDeleter SelfMonitorApplierDeleter,
+	opts ...Option,
 ) *Reconciler {
-	return &Reconciler{
+	r := &Reconciler{
 		config: config,
 		scheme: scheme,
 		Client: client,
@@ -88,6 +101,12 @@ func New(
 		overridesHandler:          overridesHandler,
 		selfMonitorApplierDeleter: selfMonitorApplierDeleter,
 	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
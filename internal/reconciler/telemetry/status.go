@@ -17,6 +17,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
 type ComponentHealthChecker interface {
@@ -25,6 +26,7 @@ type ComponentHealthChecker interface {
 
 func (r *Reconciler) updateStatus(ctx context.Context, telemetry *operatorv1beta1.Telemetry) error {
 	telemetryInDeletion := !telemetry.GetDeletionTimestamp().IsZero()
+	oldConditions := slices.Clone(telemetry.Status.Conditions)
 
 	for _, checker := range r.enabledHealthCheckers() {
 		if err := r.updateComponentCondition(ctx, checker, telemetry, telemetryInDeletion); err != nil {
@@ -32,6 +34,10 @@ func (r *Reconciler) updateStatus(ctx context.Context, telemetry *operatorv1beta
 		}
 	}
 
+	if err := r.updateExternalIngestionCondition(ctx, telemetry); err != nil {
+		return fmt.Errorf("failed to update external ingestion condition: %w", err)
+	}
+
 	r.updateOverallState(ctx, telemetry, telemetryInDeletion)
 
 	if err := r.updateGatewayEndpoints(ctx, telemetry); err != nil {
@@ -42,6 +48,10 @@ func (r *Reconciler) updateStatus(ctx context.Context, telemetry *operatorv1beta
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
+	if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(telemetry, oldConditions, telemetry.Status.Conditions)
+	}
+
 	return nil
 }
 
@@ -61,6 +71,42 @@ func (r *Reconciler) updateComponentCondition(ctx context.Context, checker Compo
 	return nil
 }
 
+// updateExternalIngestionCondition reports whether the external ingestion of the OTLP Gateway can be enabled.
+// The OTLP Gateway keeps external ingestion disabled as long as a referenced Secret cannot be resolved, so that in-cluster ingestion keeps working.
+// Without this condition, external senders would be rejected with no visible reason.
+func (r *Reconciler) updateExternalIngestionCondition(ctx context.Context, telemetry *operatorv1beta1.Telemetry) error {
+	if telemetry.Spec.OTLPGateway == nil || telemetry.Spec.OTLPGateway.ExternalIngestion == nil {
+		meta.RemoveStatusCondition(&telemetry.Status.Conditions, conditions.TypeExternalIngestion)
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeExternalIngestion,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonExternalIngestionReady,
+		Message:            conditions.MessageForTelemetry(conditions.ReasonExternalIngestionReady),
+		ObservedGeneration: telemetry.GetGeneration(),
+	}
+
+	for _, ref := range secretref.GetSecretRefsExternalIngestion(telemetry.Spec.OTLPGateway.ExternalIngestion) {
+		if _, err := secretref.GetValue(ctx, r.Client, ref); err != nil {
+			if errors.Is(err, secretref.ErrSecretRefNotFound) || errors.Is(err, secretref.ErrSecretKeyNotFound) || errors.Is(err, secretref.ErrSecretRefMissingFields) {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = conditions.ReasonReferencedSecretMissing
+				condition.Message = conditions.ConvertErrToMsg(err) + ". External ingestion is disabled until the Secret is available"
+
+				break
+			}
+
+			return err
+		}
+	}
+
+	meta.SetStatusCondition(&telemetry.Status.Conditions, condition)
+
+	return nil
+}
+
 func (r *Reconciler) updateOverallState(ctx context.Context, telemetry *operatorv1beta1.Telemetry, telemetryInDeletion bool) {
 	if telemetryInDeletion {
 		// If the provided Telemetry CR is being deleted and dependent Telemetry CRs (LogPipeline, MetricPipeline, TracePipeline) are found, the state is set to "Warning" until they are removed from the cluster.
@@ -460,6 +460,51 @@ func TestUpdateStatus(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "external ingestion secret available",
+			config: &Config{
+				Global: config.NewGlobal(config.WithTargetNamespace("telemetry-system")),
+			},
+			telemetry:            externalIngestionTelemetry(),
+			logsCheckerReturn:    &metav1.Condition{Type: conditions.TypeLogComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			metricsCheckerReturn: &metav1.Condition{Type: conditions.TypeMetricComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			tracesCheckerReturn:  &metav1.Condition{Type: conditions.TypeTraceComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			resources: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ingestion", Namespace: "telemetry-system"},
+					Data:       map[string][]byte{"token": []byte("secret"), "tls.crt": []byte("cert"), "tls.key": []byte("key")},
+				},
+			},
+			expectedState: operatorv1beta1.StateReady,
+			expectedConditions: []metav1.Condition{
+				{Type: conditions.TypeLogComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{Type: conditions.TypeMetricComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{Type: conditions.TypeTraceComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{Type: conditions.TypeExternalIngestion, Status: metav1.ConditionTrue, Reason: conditions.ReasonExternalIngestionReady, Message: "External ingestion is enabled on the OTLP Gateway"},
+			},
+		},
+		{
+			name: "external ingestion secret missing",
+			config: &Config{
+				Global: config.NewGlobal(config.WithTargetNamespace("telemetry-system")),
+			},
+			telemetry:            externalIngestionTelemetry(),
+			logsCheckerReturn:    &metav1.Condition{Type: conditions.TypeLogComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			metricsCheckerReturn: &metav1.Condition{Type: conditions.TypeMetricComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			tracesCheckerReturn:  &metav1.Condition{Type: conditions.TypeTraceComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+			expectedState:        operatorv1beta1.StateWarning,
+			expectedConditions: []metav1.Condition{
+				{Type: conditions.TypeLogComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{Type: conditions.TypeMetricComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{Type: conditions.TypeTraceComponentsHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonComponentsRunning},
+				{
+					Type:    conditions.TypeExternalIngestion,
+					Status:  metav1.ConditionFalse,
+					Reason:  conditions.ReasonReferencedSecretMissing,
+					Message: "One or more referenced Secrets are missing: Secret 'ingestion' of Namespace 'telemetry-system'. External ingestion is disabled until the Secret is available",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -522,3 +567,23 @@ func TestUpdateStatus(t *testing.T) {
 		})
 	}
 }
+
+func externalIngestionTelemetry() *operatorv1beta1.Telemetry {
+	return &operatorv1beta1.Telemetry{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: operatorv1beta1.TelemetrySpec{
+			OTLPGateway: &operatorv1beta1.OTLPGatewaySpec{
+				ExternalIngestion: &operatorv1beta1.ExternalIngestionSpec{
+					Tenant: "edge",
+					Authentication: operatorv1beta1.ExternalIngestionAuthentication{
+						BearerToken: &operatorv1beta1.SecretKeyRef{Name: "ingestion", Namespace: "telemetry-system", Key: "token"},
+					},
+					TLS: &operatorv1beta1.ExternalIngestionTLS{
+						Cert: operatorv1beta1.SecretKeyRef{Name: "ingestion", Namespace: "telemetry-system", Key: "tls.crt"},
+						Key:  operatorv1beta1.SecretKeyRef{Name: "ingestion", Namespace: "telemetry-system", Key: "tls.key"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package telemetryroute
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
+)
+
+// EndpointValidator validates TelemetryRoute endpoint configurations.
+type EndpointValidator interface {
+	// Validate checks if the endpoint configuration is valid for the specified protocol.
+	Validate(ctx context.Context, params endpoint.EndpointValidationParams) error
+}
+
+// SecretRefValidator validates secret references in TelemetryRoute resources.
+type SecretRefValidator interface {
+	// ValidateTelemetryRoute checks if all secret references in the route are located in the namespace of the route, exist and are accessible.
+	ValidateTelemetryRoute(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error
+}
+
+// TLSCertValidator validates TLS certificate configurations.
+type TLSCertValidator interface {
+	// Validate checks if the TLS certificate bundle is valid and not expired.
+	Validate(ctx context.Context, config tlscert.TLSValidationParams) error
+}
+
+// FlowHealthProber checks the health of the data flow through the gateway for specific routes.
+type FlowHealthProber interface {
+	// Probe performs a health check for the route with the given name in the format <namespace>.<name> and returns the probe result.
+	Probe(ctx context.Context, routeName string) (prober.OTelGatewayProbeResult, error)
+}
+
+// SecretWatcher manages watches on Kubernetes secrets referenced by routes.
+type SecretWatcher interface {
+	// SyncWatchers ensures the route watches exactly the given set of secrets.
+	SyncWatchers(ctx context.Context, pipeline client.Object, secrets []types.NamespacedName) error
+}
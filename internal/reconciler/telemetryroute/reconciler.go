@@ -0,0 +1,246 @@
+package telemetryroute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/config"
+	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
+)
+
+// Reconciler reconciles TelemetryRoute resources.
+// Valid routes are written to the OTLP Gateway Coordination ConfigMap, from where the OTLP Gateway reconciler
+// merges them into the shared gateway configuration. Routes are not subject to the pipeline limit.
+type Reconciler struct {
+	client.Client
+
+	globals config.Global
+
+	// Dependencies
+	gatewayProber     commonstatus.Prober
+	flowHealthProber  FlowHealthProber
+	routeValidator    *Validator
+	errToMsgConverter commonstatus.ErrorToMessageConverter
+	secretWatcher     SecretWatcher
+	eventRecorder     commonstatus.EventRecorder
+}
+
+// Option configures the Reconciler during initialization.
+type Option func(*Reconciler)
+
+// WithGlobals sets the global configuration.
+func WithGlobals(globals config.Global) Option {
+	return func(r *Reconciler) {
+		r.globals = globals
+	}
+}
+
+// WithGatewayProber sets the gateway prober for the Reconciler.
+func WithGatewayProber(prober commonstatus.Prober) Option {
+	return func(r *Reconciler) {
+		r.gatewayProber = prober
+	}
+}
+
+// WithFlowHealthProber sets the flow health prober for the Reconciler.
+func WithFlowHealthProber(prober FlowHealthProber) Option {
+	return func(r *Reconciler) {
+		r.flowHealthProber = prober
+	}
+}
+
+// WithRouteValidator sets the route validator for the Reconciler.
+func WithRouteValidator(validator *Validator) Option {
+	return func(r *Reconciler) {
+		r.routeValidator = validator
+	}
+}
+
+// WithErrorToMessageConverter sets the error to message converter for the Reconciler.
+func WithErrorToMessageConverter(converter commonstatus.ErrorToMessageConverter) Option {
+	return func(r *Reconciler) {
+		r.errToMsgConverter = converter
+	}
+}
+
+// WithClient sets the Kubernetes client for the Reconciler.
+func WithClient(client client.Client) Option {
+	return func(r *Reconciler) {
+		r.Client = client
+	}
+}
+
+// WithSecretWatcher sets the secret watcher for the Reconciler.
+func WithSecretWatcher(watcher SecretWatcher) Option {
+	return func(r *Reconciler) {
+		r.secretWatcher = watcher
+	}
+}
+
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
+// New creates a new Reconciler with the provided options.
+func New(opts ...Option) *Reconciler {
+	r := &Reconciler{}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logf.FromContext(ctx).V(1).Info("Reconciling TelemetryRoute")
+
+	var route telemetryv1beta1.TelemetryRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Route was deleted, clean up secret watchers. Routes are namespaced, so the watchers are synced to an empty set
+		// instead of being removed by name only, which would affect routes with the same name in other namespaces.
+		deletedRoute := &telemetryv1beta1.TelemetryRoute{
+			TypeMeta:   metav1.TypeMeta{APIVersion: telemetryv1beta1.GroupVersion.String(), Kind: "TelemetryRoute"},
+			ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace},
+		}
+		if err := r.secretWatcher.SyncWatchers(ctx, deletedRoute, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Remove route reference from OTLP Gateway Coordination ConfigMap
+		if err := coordinationconfig.RemoveTelemetryRouteReference(ctx, r.Client, r.globals.TargetNamespace(), req.NamespacedName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to remove route reference from OTLP Gateway Coordination ConfigMap: %w", err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.syncSecretWatchers(ctx, &route); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var allErrors error = nil
+
+	if err := r.doReconcile(ctx, &route); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to reconcile: %w", err))
+	}
+
+	if err := r.updateStatus(ctx, req.NamespacedName); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update status: %w", err))
+	}
+
+	if allErrors != nil {
+		return ctrl.Result{}, allErrors
+	}
+
+	requeueAfter := r.calculateRequeueAfterDuration(ctx, &route)
+	if requeueAfter != nil {
+		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire", "RequeueAfter", requeueAfter.String())
+		return ctrl.Result{RequeueAfter: *requeueAfter}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// doReconcile performs the main reconciliation logic for a TelemetryRoute.
+// It validates the route and writes it to the OTLP Gateway Coordination ConfigMap if reconcilable,
+// or removes it from the OTLP Gateway Coordination ConfigMap if not reconcilable.
+func (r *Reconciler) doReconcile(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	isRouteReconcilable, err := r.isReconcilable(ctx, route)
+	if err != nil {
+		return fmt.Errorf("failed to validate route: %w", err)
+	}
+
+	routeKey := client.ObjectKeyFromObject(route)
+
+	if !isRouteReconcilable {
+		logf.FromContext(ctx).V(1).Info("Removing route reference from OTLP Gateway Coordination ConfigMap", "route", routeKey.String())
+
+		if err := coordinationconfig.RemoveTelemetryRouteReference(ctx, r.Client, r.globals.TargetNamespace(), routeKey); err != nil {
+			return fmt.Errorf("failed to remove route reference from OTLP Gateway Coordination ConfigMap: %w", err)
+		}
+
+		return nil
+	}
+
+	secretVersions, err := coordinationconfig.CollectSecretVersions(ctx, r.Client, secretref.GetSecretRefsTelemetryRoute(route))
+	if err != nil {
+		return fmt.Errorf("failed to collect secret versions: %w", err)
+	}
+
+	logf.FromContext(ctx).V(1).Info("Writing route reference to OTLP Gateway Coordination ConfigMap",
+		"route", routeKey.String(),
+		"generation", route.Generation,
+		"secretCount", len(secretVersions))
+
+	if err := coordinationconfig.AddTelemetryRouteReference(ctx, r.Client, r.globals.TargetNamespace(), coordinationconfig.TelemetryRouteReference{
+		Name:           route.Name,
+		Namespace:      route.Namespace,
+		Generation:     route.Generation,
+		SecretVersions: secretVersions,
+	}); err != nil {
+		return fmt.Errorf("failed to write route reference to ConfigMap: %w", err)
+	}
+
+	return nil
+}
+
+// isReconcilable determines whether a TelemetryRoute is ready to be reconciled.
+// A route is reconcilable if it is not being deleted and passes validation.
+// Routes with certificates about to expire are still considered reconcilable.
+func (r *Reconciler) isReconcilable(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) (bool, error) {
+	if !route.GetDeletionTimestamp().IsZero() {
+		return false, nil
+	}
+
+	err := r.routeValidator.validate(ctx, route)
+
+	// Route with a certificate that is about to expire is still considered reconcilable
+	if err == nil || tlscert.IsCertAboutToExpireError(err) {
+		return true, nil
+	}
+
+	// In case that one of the requests to the Kubernetes API server failed, the error is returned to trigger a requeue
+	if APIRequestFailed, ok := errors.AsType[*errortypes.APIRequestFailedError](err); ok {
+		return false, APIRequestFailed.Err
+	}
+
+	return false, nil
+}
+
+func (r *Reconciler) calculateRequeueAfterDuration(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) *time.Duration {
+	err := r.routeValidator.validate(ctx, route)
+
+	if errCertAboutToExpire, ok := errors.AsType[*tlscert.CertAboutToExpireError](err); ok {
+		duration := time.Until(errCertAboutToExpire.Expiry)
+		return &duration
+	}
+
+	return nil
+}
+
+func (r *Reconciler) syncSecretWatchers(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	refs := secretref.GetSecretRefsTelemetryRoute(route)
+	secrets := secretref.RefsToSecretNames(refs)
+
+	return r.secretWatcher.SyncWatchers(ctx, route, secrets)
+}
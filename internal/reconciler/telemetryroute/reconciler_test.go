@@ -0,0 +1,273 @@
+package telemetryroute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/config"
+	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/telemetryroute/stubs"
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+var testScheme = newTestScheme()
+
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = telemetryv1beta1.AddToScheme(scheme)
+
+	return scheme
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name                 string
+		outputOpts           []testutils.OTLPOutputOption
+		secretValidatorError error
+		expectWritten        bool
+		expectedStatus       metav1.ConditionStatus
+		expectedReason       string
+		expectedMessage      string
+	}{
+		{
+			name:            "valid route written to ConfigMap",
+			expectWritten:   true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonGatewayConfigured,
+			expectedMessage: "TelemetryRoute specification is successfully applied to the configuration of OTLP Gateway",
+		},
+		{
+			name:                 "referenced secret in other namespace",
+			secretValidatorError: fmt.Errorf("%w: Secret 'creds' of Namespace 'team-b' is not in Namespace 'team-a'", secretref.ErrSecretRefNamespace),
+			expectWritten:        false,
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       conditions.ReasonSecretRefNamespaceNotAllowed,
+			expectedMessage:      "Referenced Secrets must be located in the namespace of the resource: Secret 'creds' of Namespace 'team-b' is not in Namespace 'team-a'",
+		},
+		{
+			name:                 "referenced secret missing",
+			secretValidatorError: fmt.Errorf("%w: Secret 'creds' of Namespace 'team-a'", secretref.ErrSecretRefNotFound),
+			expectWritten:        false,
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       conditions.ReasonReferencedSecretMissing,
+			expectedMessage:      "One or more referenced Secrets are missing: Secret 'creds' of Namespace 'team-a'",
+		},
+		{
+			name:            "service account token authentication",
+			outputOpts:      []testutils.OTLPOutputOption{testutils.OTLPServiceAccountToken("https://backend.example.com")},
+			expectWritten:   false,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonAuthenticationNotAllowed,
+			expectedMessage: "Service account token authentication is not available for a TelemetryRoute, because the token identifies the OTLP Gateway. Use basic or OAuth2 authentication instead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := testutils.NewTelemetryRouteBuilder().WithName("backend").WithNamespace("team-a").WithOTLPOutput(tt.outputOpts...).Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&route).WithStatusSubresource(&route).Build()
+
+			sut := testReconciler(fakeClient, stubs.NewSecretRefValidator(tt.secretValidatorError))
+
+			_, err := sut.Reconcile(t.Context(), requestFor(&route))
+			require.NoError(t, err)
+
+			gatewayConfig := readCoordinationConfig(t, fakeClient)
+			if tt.expectWritten {
+				require.Len(t, gatewayConfig.TelemetryRouteReferences, 1)
+				require.Equal(t, "backend", gatewayConfig.TelemetryRouteReferences[0].Name)
+				require.Equal(t, "team-a", gatewayConfig.TelemetryRouteReferences[0].Namespace)
+				require.Equal(t, int64(1), gatewayConfig.TelemetryRouteReferences[0].Generation)
+			} else {
+				require.Empty(t, gatewayConfig.TelemetryRouteReferences)
+			}
+
+			var updatedRoute telemetryv1beta1.TelemetryRoute
+			require.NoError(t, fakeClient.Get(t.Context(), client.ObjectKeyFromObject(&route), &updatedRoute))
+
+			cond := findCondition(updatedRoute.Status.Conditions, conditions.TypeConfigurationGenerated)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.expectedStatus, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+			require.Equal(t, tt.expectedMessage, cond.Message)
+
+			gatewayCond := findCondition(updatedRoute.Status.Conditions, conditions.TypeGatewayHealthy)
+			require.NotNil(t, gatewayCond)
+
+			if tt.expectWritten {
+				require.Equal(t, metav1.ConditionTrue, gatewayCond.Status)
+				require.Equal(t, conditions.ReasonGatewayReady, gatewayCond.Reason)
+			} else {
+				require.Equal(t, metav1.ConditionFalse, gatewayCond.Status)
+				require.Equal(t, conditions.ReasonGatewayConfigurationNotGenerated, gatewayCond.Reason)
+			}
+		})
+	}
+}
+
+func TestReconcile_FlowHealth(t *testing.T) {
+	tests := []struct {
+		name                 string
+		probe                prober.OTelGatewayProbeResult
+		probeErr             error
+		secretValidatorError error
+		expectedStatus       metav1.ConditionStatus
+		expectedReason       string
+		expectedMessage      string
+		expectErr            bool
+	}{
+		{
+			name:            "flow healthy",
+			probe:           prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage: "No problems detected in the telemetry flow",
+		},
+		{
+			name:            "all data dropped",
+			probe:           prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true}},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewayAllDataDropped,
+			expectedMessage: "Backend is not reachable or rejecting telemetry data. All telemetry data is dropped in OTLP Gateway. See troubleshooting: https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#no-data-arrive-at-the-backend",
+		},
+		{
+			name:            "some data dropped",
+			probe:           prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{SomeDataDropped: true}},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewaySomeDataDropped,
+			expectedMessage: "Backend is reachable, but rejecting telemetry data. Some telemetry data is dropped in OTLP Gateway. See troubleshooting: https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#not-all-data-arrive-at-the-backend",
+		},
+		{
+			name:            "throttling",
+			probe:           prober.OTelGatewayProbeResult{Throttling: true},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewayThrottling,
+			expectedMessage: "OTLP Gateway is unable to receive telemetry data at current rate. See troubleshooting: https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#gateway-throttling",
+		},
+		{
+			name:            "probing failed",
+			probeErr:        assert.AnError,
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  conditions.ReasonSelfMonGatewayProbingFailed,
+			expectedMessage: "Could not determine the health of the telemetry flow because the self monitor probing of gateway failed",
+			expectErr:       true,
+		},
+		{
+			name:                 "configuration not generated",
+			secretValidatorError: fmt.Errorf("%w: Secret 'creds' of Namespace 'team-a'", secretref.ErrSecretRefNotFound),
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       conditions.ReasonSelfMonConfigNotGenerated,
+			expectedMessage:      "No telemetry data delivered to backend because TelemetryRoute specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := testutils.NewTelemetryRouteBuilder().WithName("backend").WithNamespace("team-a").Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&route).WithStatusSubresource(&route).Build()
+
+			flowHealthProber := stubs.NewFlowHealthProber(tt.probe, tt.probeErr)
+			sut := testReconciler(fakeClient, stubs.NewSecretRefValidator(tt.secretValidatorError))
+			sut.flowHealthProber = flowHealthProber
+
+			_, err := sut.Reconcile(t.Context(), requestFor(&route))
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var updatedRoute telemetryv1beta1.TelemetryRoute
+			require.NoError(t, fakeClient.Get(t.Context(), client.ObjectKeyFromObject(&route), &updatedRoute))
+
+			cond := findCondition(updatedRoute.Status.Conditions, conditions.TypeFlowHealthy)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.expectedStatus, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+			require.Equal(t, tt.expectedMessage, cond.Message)
+
+			if tt.secretValidatorError == nil {
+				require.Equal(t, "team-a.backend", flowHealthProber.ProbedRouteName)
+			}
+		})
+	}
+}
+
+func TestReconcile_RouteDeleted(t *testing.T) {
+	route := testutils.NewTelemetryRouteBuilder().WithName("backend").WithNamespace("team-a").Build()
+	otherRoute := testutils.NewTelemetryRouteBuilder().WithName("backend").WithNamespace("team-b").Build()
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&route, &otherRoute).WithStatusSubresource(&route, &otherRoute).Build()
+
+	sut := testReconciler(fakeClient, stubs.NewSecretRefValidator(nil))
+
+	_, err := sut.Reconcile(t.Context(), requestFor(&route))
+	require.NoError(t, err)
+	_, err = sut.Reconcile(t.Context(), requestFor(&otherRoute))
+	require.NoError(t, err)
+	require.Len(t, readCoordinationConfig(t, fakeClient).TelemetryRouteReferences, 2)
+
+	require.NoError(t, fakeClient.Delete(t.Context(), &route))
+
+	_, err = sut.Reconcile(t.Context(), requestFor(&route))
+	require.NoError(t, err)
+
+	refs := readCoordinationConfig(t, fakeClient).TelemetryRouteReferences
+	require.Len(t, refs, 1)
+	require.Equal(t, "team-b", refs[0].Namespace, "route with the same name in another namespace must be kept")
+}
+
+func testReconciler(fakeClient client.Client, secretRefValidator SecretRefValidator) *Reconciler {
+	validator := NewValidator(
+		WithEndpointValidator(stubs.NewEndpointValidator(nil)),
+		WithTLSCertValidator(stubs.NewTLSCertValidator(nil)),
+		WithSecretRefValidator(secretRefValidator),
+	)
+
+	return New(
+		WithClient(fakeClient),
+		WithGlobals(config.NewGlobal(config.WithTargetNamespace("default"))),
+		WithGatewayProber(commonStatusStubs.NewDaemonSetProber(nil)),
+		WithRouteValidator(validator),
+		WithErrorToMessageConverter(&conditions.ErrorToMessageConverter{}),
+		WithSecretWatcher(stubs.NewSecretWatcher(nil)),
+		WithFlowHealthProber(stubs.NewFlowHealthProber(prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}}, nil)),
+	)
+}
+
+func readCoordinationConfig(t *testing.T, c client.Client) coordinationconfig.OTLPGatewayConfigMap {
+	t.Helper()
+
+	gatewayConfig, err := coordinationconfig.ReadOTLPGatewayConfig(t.Context(), c, "default")
+	require.NoError(t, err)
+
+	return *gatewayConfig
+}
+
+func requestFor(route *telemetryv1beta1.TelemetryRoute) ctrl.Request {
+	return ctrl.Request{NamespacedName: client.ObjectKeyFromObject(route)}
+}
+
+func findCondition(conds []metav1.Condition, condType string) *metav1.Condition {
+	for i := range conds {
+		if conds[i].Type == condType {
+			return &conds[i]
+		}
+	}
+
+	return nil
+}
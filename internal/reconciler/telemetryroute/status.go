@@ -0,0 +1,185 @@
+package telemetryroute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+// updateStatus updates the status of a TelemetryRoute resource.
+// It sets the GatewayHealthy, ConfigurationGenerated and TelemetryFlowHealthy conditions.
+func (r *Reconciler) updateStatus(ctx context.Context, routeKey types.NamespacedName) error {
+	var route telemetryv1beta1.TelemetryRoute
+	if err := r.Get(ctx, routeKey, &route); err != nil {
+		if apierrors.IsNotFound(err) {
+			logf.FromContext(ctx).V(1).Info("Skipping status update for TelemetryRoute - not found")
+			return nil
+		}
+
+		return fmt.Errorf("failed to get TelemetryRoute: %w", err)
+	}
+
+	if route.DeletionTimestamp != nil {
+		logf.FromContext(ctx).V(1).Info("Skipping status update for TelemetryRoute - marked for deletion")
+		return nil
+	}
+
+	oldConditions := slices.Clone(route.Status.Conditions)
+
+	var allErrors error = nil
+
+	r.setGatewayHealthyCondition(ctx, &route)
+	r.setGatewayConfigGeneratedCondition(ctx, &route)
+
+	if err := r.setFlowHealthCondition(ctx, &route); err != nil {
+		allErrors = errors.Join(allErrors, err)
+	}
+
+	if err := r.Status().Update(ctx, &route); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update TelemetryRoute status: %w", err))
+	} else if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(&route, oldConditions, route.Status.Conditions)
+	}
+
+	return allErrors
+}
+
+func (r *Reconciler) setGatewayHealthyCondition(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) {
+	var condition *metav1.Condition
+
+	configStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, route)
+	if configStatus == metav1.ConditionFalse {
+		condition = &metav1.Condition{
+			Type:    conditions.TypeGatewayHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  conditions.ReasonGatewayConfigurationNotGenerated,
+			Message: conditions.MessageForTelemetryRoute(conditions.ReasonGatewayConfigurationNotGenerated),
+		}
+	} else {
+		// The gateway readiness messages are common to all signal types, so the signal type passed here is irrelevant
+		condition = commonstatus.GetGatewayHealthyCondition(ctx,
+			r.gatewayProber,
+			types.NamespacedName{
+				Name:      names.OTLPGateway,
+				Namespace: r.globals.TargetNamespace(),
+			},
+			r.errToMsgConverter,
+			pipelines.SignalTypeTrace,
+			configStatus)
+	}
+
+	condition.ObservedGeneration = route.Generation
+	meta.SetStatusCondition(&route.Status.Conditions, *condition)
+}
+
+func (r *Reconciler) setGatewayConfigGeneratedCondition(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) {
+	status, reason, message := r.evaluateConfigGeneratedCondition(ctx, route)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeConfigurationGenerated,
+		Status:             status,
+		ObservedGeneration: route.Generation,
+		Reason:             reason,
+		Message:            message,
+	}
+
+	meta.SetStatusCondition(&route.Status.Conditions, condition)
+}
+
+func (r *Reconciler) setFlowHealthCondition(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	status, reason, err := r.evaluateFlowHealthCondition(ctx, route)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeFlowHealthy,
+		Status:             status,
+		Reason:             reason,
+		Message:            conditions.MessageForTelemetryRoute(reason),
+		ObservedGeneration: route.Generation,
+	}
+
+	meta.SetStatusCondition(&route.Status.Conditions, condition)
+
+	return err
+}
+
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) (metav1.ConditionStatus, string, error) {
+	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, route)
+	if configGeneratedStatus == metav1.ConditionFalse {
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil
+	}
+
+	probeResult, err := r.flowHealthProber.Probe(ctx, pipelines.TelemetryRouteName(route))
+	if err != nil {
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, fmt.Errorf("failed to probe flow health: %w", err)
+	}
+
+	logf.FromContext(ctx).V(1).Info("Probed flow health", "result", probeResult)
+
+	reason := flowHealthReasonFor(probeResult)
+	if reason == conditions.ReasonSelfMonFlowHealthy {
+		return metav1.ConditionTrue, reason, nil
+	}
+
+	return metav1.ConditionFalse, reason, nil
+}
+
+func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
+	switch {
+	case probeResult.AllDataDropped:
+		return conditions.ReasonSelfMonGatewayAllDataDropped
+	case probeResult.SomeDataDropped:
+		return conditions.ReasonSelfMonGatewaySomeDataDropped
+	case probeResult.Throttling:
+		return conditions.ReasonSelfMonGatewayThrottling
+	default:
+		return conditions.ReasonSelfMonFlowHealthy
+	}
+}
+
+func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) (status metav1.ConditionStatus, reason string, message string) {
+	err := r.routeValidator.validate(ctx, route)
+	if err == nil {
+		return metav1.ConditionTrue, conditions.ReasonGatewayConfigured, conditions.MessageForTelemetryRoute(conditions.ReasonGatewayConfigured)
+	}
+
+	if errors.Is(err, ErrServiceAccountTokenNotAllowed) {
+		return metav1.ConditionFalse, conditions.ReasonAuthenticationNotAllowed, conditions.MessageForTelemetryRoute(conditions.ReasonAuthenticationNotAllowed)
+	}
+
+	if errors.Is(err, secretref.ErrSecretRefNamespace) {
+		return metav1.ConditionFalse, conditions.ReasonSecretRefNamespaceNotAllowed, conditions.ConvertErrToMsg(err)
+	}
+
+	if errors.Is(err, secretref.ErrSecretRefNotFound) || errors.Is(err, secretref.ErrSecretKeyNotFound) || errors.Is(err, secretref.ErrSecretRefMissingFields) {
+		return metav1.ConditionFalse, conditions.ReasonReferencedSecretMissing, conditions.ConvertErrToMsg(err)
+	}
+
+	if endpoint.IsEndpointInvalidError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonEndpointInvalid,
+			fmt.Sprintf(conditions.MessageForTelemetryRoute(conditions.ReasonEndpointInvalid), err.Error())
+	}
+
+	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
+		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForTelemetryRoute(conditions.ReasonValidationFailed)
+	}
+
+	return conditions.EvaluateTLSCertCondition(err)
+}
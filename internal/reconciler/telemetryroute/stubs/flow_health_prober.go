@@ -0,0 +1,26 @@
+package stubs
+
+import (
+	"context"
+
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+type FlowHealthProber struct {
+	result prober.OTelGatewayProbeResult
+	err    error
+
+	ProbedRouteName string
+}
+
+func NewFlowHealthProber(result prober.OTelGatewayProbeResult, err error) *FlowHealthProber {
+	return &FlowHealthProber{
+		result: result,
+		err:    err,
+	}
+}
+
+func (p *FlowHealthProber) Probe(ctx context.Context, routeName string) (prober.OTelGatewayProbeResult, error) {
+	p.ProbedRouteName = routeName
+	return p.result, p.err
+}
@@ -0,0 +1,21 @@
+package stubs
+
+import (
+	"context"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type SecretRefValidator struct {
+	err error
+}
+
+func NewSecretRefValidator(err error) *SecretRefValidator {
+	return &SecretRefValidator{
+		err: err,
+	}
+}
+
+func (s *SecretRefValidator) ValidateTelemetryRoute(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	return s.err
+}
@@ -0,0 +1,99 @@
+package telemetryroute
+
+import (
+	"context"
+	"errors"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
+)
+
+// ErrServiceAccountTokenNotAllowed is returned if the output of a TelemetryRoute authenticates with a service account token.
+// The token would identify the OTLP Gateway, so the owner of the route could obtain a token of the gateway for any audience.
+var ErrServiceAccountTokenNotAllowed = errors.New("service account token authentication is not available for a TelemetryRoute")
+
+// Validator validates TelemetryRoute resources by checking endpoints, TLS certificates and secret references.
+type Validator struct {
+	EndpointValidator  EndpointValidator
+	TLSCertValidator   TLSCertValidator
+	SecretRefValidator SecretRefValidator
+}
+
+// ValidatorOption configures the Validator during initialization.
+type ValidatorOption func(*Validator)
+
+// WithEndpointValidator sets the endpoint validator for the Validator.
+func WithEndpointValidator(validator EndpointValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.EndpointValidator = validator
+	}
+}
+
+// WithTLSCertValidator sets the TLS certificate validator for the Validator.
+func WithTLSCertValidator(validator TLSCertValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.TLSCertValidator = validator
+	}
+}
+
+// WithSecretRefValidator sets the secret reference validator for the Validator.
+func WithSecretRefValidator(validator SecretRefValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.SecretRefValidator = validator
+	}
+}
+
+// NewValidator creates a new Validator with the provided options.
+func NewValidator(opts ...ValidatorOption) *Validator {
+	v := &Validator{}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+func (v *Validator) validate(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	if err := v.SecretRefValidator.ValidateTelemetryRoute(ctx, route); err != nil {
+		return err
+	}
+
+	otlp := route.Spec.Output.OTLP
+	if otlp == nil {
+		return nil
+	}
+
+	var oauth2 *telemetryv1beta1.OAuth2Options = nil
+	if otlp.Authentication != nil {
+		// The API rejects the service account token authentication already, but routes created before the rule was introduced must not be applied either
+		if otlp.Authentication.ServiceAccountToken != nil {
+			return ErrServiceAccountTokenNotAllowed
+		}
+
+		oauth2 = otlp.Authentication.OAuth2
+	}
+
+	if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
+		Endpoint:   &otlp.Endpoint,
+		Protocol:   otlp.Protocol,
+		OutputTLS:  otlp.TLS,
+		OTLPOAuth2: oauth2,
+		ProxyURL:   otlp.ProxyURL,
+	}); err != nil {
+		return err
+	}
+
+	if otlp.TLS != nil && (otlp.TLS.Cert != nil || otlp.TLS.Key != nil || otlp.TLS.CA != nil) {
+		if err := v.TLSCertValidator.Validate(ctx, tlscert.TLSValidationParams{
+			Cert: otlp.TLS.Cert,
+			Key:  otlp.TLS.Key,
+			CA:   otlp.TLS.CA,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
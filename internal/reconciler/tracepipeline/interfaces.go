@@ -22,6 +22,8 @@ type PipelineLock interface {
 	TryAcquireLock(ctx context.Context, owner metav1.Object) error
 	// IsLockHolder checks if the given owner currently holds a lock.
 	IsLockHolder(ctx context.Context, owner metav1.Object) error
+	// ReleaseLock releases the lock held by the given owner.
+	ReleaseLock(ctx context.Context, owner metav1.Object) error
 }
 
 // PipelineSyncer synchronizes pipeline state and manages pipeline registration.
@@ -85,3 +87,11 @@ type SecretWatcher interface {
 	// RemoveFromWatchers removes a pipeline from all watchers by name and GVK.
 	RemoveFromWatchers(ctx context.Context, name string, gvk schema.GroupVersionKind) error
 }
+
+// CollectorConfigValidator validates the collector configuration generated for TracePipeline resources.
+// It detects pipelines that would make the shared collector reject its configuration and crash-loop.
+type CollectorConfigValidator interface {
+	// ValidateTracePipeline renders the collector configuration for the pipeline and validates it against the collector components.
+	// Returns a ConfigInvalidError if the collector would reject the configuration.
+	ValidateTracePipeline(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) error
+}
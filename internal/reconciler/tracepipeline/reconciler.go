@@ -34,6 +34,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -53,6 +54,8 @@ type Reconciler struct {
 	pipelineValidator *Validator
 	errToMsgConverter commonstatus.ErrorToMessageConverter
 	secretWatcher     SecretWatcher
+	eventRecorder     commonstatus.EventRecorder
+	heartbeatTracker  commonstatus.HeartbeatTracker
 }
 
 // Option configures the Reconciler during initialization.
@@ -128,6 +131,20 @@ func WithSecretWatcher(watcher SecretWatcher) Option {
 	}
 }
 
+// WithEventRecorder sets the recorder that emits Kubernetes Events for status condition transitions.
+func WithEventRecorder(recorder commonstatus.EventRecorder) Option {
+	return func(r *Reconciler) {
+		r.eventRecorder = recorder
+	}
+}
+
+// WithHeartbeatTracker sets the tracker that reports the delivery of the synthetic heartbeat telemetry.
+func WithHeartbeatTracker(tracker commonstatus.HeartbeatTracker) Option {
+	return func(r *Reconciler) {
+		r.heartbeatTracker = tracker
+	}
+}
+
 // New creates a new Reconciler with the provided options.
 func New(opts ...Option) *Reconciler {
 	r := &Reconciler{}
@@ -200,7 +217,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	requeueAfter := r.calculateRequeueAfterDuration(ctx, &tracePipeline)
 	if requeueAfter != nil {
-		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire", "RequeueAfter", requeueAfter.String())
+		logf.FromContext(ctx).V(1).Info("Requeuing reconciliation due to certificate about to expire or enabled heartbeat", "RequeueAfter", requeueAfter.String())
 		return ctrl.Result{RequeueAfter: *requeueAfter}, nil
 	}
 
@@ -211,7 +228,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 // It validates the pipeline and writes it to the OTLP Gateway Coordination ConfigMap if reconcilable,
 // or removes it from the OTLP Gateway Coordination ConfigMap if not reconcilable.
 func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) error {
-	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
+	if pipeline.Spec.Suspend {
+		// A suspended pipeline is not part of the configuration, so it must not count towards the maximum pipeline count
+		if err := r.pipelineLock.ReleaseLock(ctx, pipeline); err != nil {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+	} else if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		if errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
 			logf.FromContext(ctx).V(1).Info("Skipping reconciliation: maximum pipeline count limit exceeded")
 			return nil
@@ -278,6 +300,10 @@ func (r *Reconciler) isReconcilable(ctx context.Context, pipeline *telemetryv1be
 		return false, nil
 	}
 
+	if pipeline.Spec.Suspend {
+		return false, nil
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 
 	// Pipeline with a certificate that is about to expire is still considered reconcilable
@@ -331,13 +357,26 @@ func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1
 }
 
 func (r *Reconciler) calculateRequeueAfterDuration(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) *time.Duration {
+	if pipeline.Spec.Suspend {
+		return nil
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 
 	if errCertAboutToExpire, ok := errors.AsType[*tlscert.CertAboutToExpireError](err); ok {
 		duration := time.Until(errCertAboutToExpire.Expiry)
+		if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+			duration = min(duration, heartbeat.Interval)
+		}
+
 		return &duration
 	}
 
+	// The heartbeat delivery is reported in the status, so that it must be refreshed regularly
+	if sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		return new(heartbeat.Interval)
+	}
+
 	return nil
 }
 
@@ -13,8 +13,10 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -24,12 +26,16 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
+	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -279,6 +285,52 @@ func TestMaxPipelineLimit(t *testing.T) {
 	flowHealthProberStub.AssertExpectations(t)
 }
 
+// TestSuspendedPipeline verifies that suspended pipelines release their lock and are not written to ConfigMap
+func TestSuspendedPipeline(t *testing.T) {
+	pipeline := testutils.NewTracePipelineBuilder().WithSuspend(true).Build()
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+	pipelineLockMock := &mocks.PipelineLock{}
+	pipelineLockMock.On("ReleaseLock", mock.Anything, mock.Anything).Return(nil).Once()
+
+	flowHealthProberStub := &mocks.FlowHealthProber{}
+	flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelGatewayProbeResult{}, nil).Maybe()
+
+	validator := newTestValidator(WithValidatorPipelineLock(pipelineLockMock))
+
+	sut := testReconcilerWithPipelineLock(fakeClient, flowHealthProberStub, pipelineLockMock, validator)
+
+	_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+	require.NoError(t, err)
+
+	// Verify ConfigMap doesn't contain this pipeline
+	var configMap corev1.ConfigMap
+
+	err = fakeClient.Get(context.Background(), types.NamespacedName{
+		Name:      names.OTLPGatewayCoordinationConfigMap,
+		Namespace: "default",
+	}, &configMap)
+	if err == nil {
+		require.NotContains(t, configMap.Data["pipelines.yaml"], pipeline.Name, "ConfigMap should not contain suspended pipeline")
+	}
+
+	// Verify status
+	var updatedPipeline telemetryv1beta1.TracePipeline
+
+	err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+	require.NoError(t, err)
+
+	requireHasStatusCondition(t, &updatedPipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonSuspended,
+		"Pipeline is suspended and not part of the collector configuration. Remove the 'spec.suspend' field to resume it",
+	)
+
+	pipelineLockMock.AssertExpectations(t)
+	pipelineLockMock.AssertNotCalled(t, "TryAcquireLock", mock.Anything, mock.Anything)
+}
+
 // TestFlowHealthCondition verifies flow health status conditions
 func TestFlowHealthCondition(t *testing.T) {
 	tests := []struct {
@@ -368,6 +420,103 @@ func TestFlowHealthCondition(t *testing.T) {
 	}
 }
 
+// TestHeartbeat verifies the flow health condition and the last delivered heartbeat of pipelines with enabled heartbeat
+func TestHeartbeat(t *testing.T) {
+	lastDelivered := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                           string
+		heartbeatEnabled               bool
+		probe                          prober.OTelGatewayProbeResult
+		tracker                        commonstatus.HeartbeatTracker
+		expectedStatus                 metav1.ConditionStatus
+		expectedReason                 string
+		expectedLastHeartbeatDelivered *metav1.Time
+		expectedRequeueAfter           time.Duration
+	}{
+		{
+			name:           "heartbeat disabled",
+			probe:          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:        commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: conditions.ReasonSelfMonFlowHealthy,
+		},
+		{
+			name:                           "heartbeat delivered",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, false),
+			expectedStatus:                 metav1.ConditionTrue,
+			expectedReason:                 conditions.ReasonSelfMonFlowHealthy,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+		{
+			name:                 "heartbeat not delivered",
+			heartbeatEnabled:     true,
+			probe:                prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:              commonStatusStubs.NewHeartbeatTracker(time.Time{}, true),
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       conditions.ReasonSelfMonHeartbeatFailing,
+			expectedRequeueAfter: heartbeat.Interval,
+		},
+		{
+			name:                           "heartbeat failing after last delivery",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedReason:                 conditions.ReasonSelfMonHeartbeatFailing,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+		{
+			name:                           "all data dropped takes precedence",
+			heartbeatEnabled:               true,
+			probe:                          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true}},
+			tracker:                        commonStatusStubs.NewHeartbeatTracker(lastDelivered, true),
+			expectedStatus:                 metav1.ConditionFalse,
+			expectedReason:                 conditions.ReasonSelfMonGatewayAllDataDropped,
+			expectedLastHeartbeatDelivered: &metav1.Time{Time: lastDelivered},
+			expectedRequeueAfter:           heartbeat.Interval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewTracePipelineBuilder().WithHeartbeat(tt.heartbeatEnabled).Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+			sut := testReconciler(fakeClient, flowHealthProberStub)
+			WithHeartbeatTracker(tt.tracker)(sut)
+
+			result, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRequeueAfter, result.RequeueAfter)
+
+			var updatedPipeline telemetryv1beta1.TracePipeline
+
+			err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+			require.NoError(t, err)
+
+			flowHealthCondition := meta.FindStatusCondition(updatedPipeline.Status.Conditions, conditions.TypeFlowHealthy)
+			require.NotNil(t, flowHealthCondition)
+			require.Equal(t, tt.expectedStatus, flowHealthCondition.Status)
+			require.Equal(t, tt.expectedReason, flowHealthCondition.Reason)
+
+			if tt.expectedLastHeartbeatDelivered == nil {
+				require.Nil(t, updatedPipeline.Status.LastHeartbeatDelivered)
+			} else {
+				require.NotNil(t, updatedPipeline.Status.LastHeartbeatDelivered)
+				require.True(t, tt.expectedLastHeartbeatDelivered.Equal(updatedPipeline.Status.LastHeartbeatDelivered))
+			}
+		})
+	}
+}
+
 // TestTLSCertificateValidation verifies TLS certificate validation
 func TestTLSCertificateValidation(t *testing.T) {
 	tests := []struct {
@@ -538,6 +687,47 @@ func TestOTTLSpecValidation(t *testing.T) {
 	}
 }
 
+// TestConfigGenerationFailed verifies that a pipeline with an invalid generated configuration is excluded from the gateway and is marked
+func TestConfigGenerationFailed(t *testing.T) {
+	pipeline := testutils.NewTracePipelineBuilder().Build()
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+	flowHealthProberStub := &mocks.FlowHealthProber{}
+	flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelGatewayProbeResult{}, nil).Maybe()
+
+	sut := testReconcilerWithValidator(fakeClient, flowHealthProberStub,
+		WithCollectorConfigValidator(stubs.NewCollectorConfigValidator(
+			&collectorconfig.ConfigInvalidError{Err: fmt.Errorf("invalid configuration of processor 'transform/tracepipeline-user-defined-%s'", pipeline.Name)},
+		)),
+	)
+
+	_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+	require.NoError(t, err)
+
+	// Verify ConfigMap does not contain this pipeline, so that the gateway configuration stays valid for all other pipelines
+	var configMap corev1.ConfigMap
+
+	err = fakeClient.Get(context.Background(), types.NamespacedName{
+		Name:      names.OTLPGatewayCoordinationConfigMap,
+		Namespace: "default",
+	}, &configMap)
+	require.NoError(t, err, "ConfigMap should exist")
+	require.NotContains(t, configMap.Data["pipelines.yaml"], pipeline.Name, "ConfigMap should not contain pipeline reference")
+
+	// Verify status
+	var updatedPipeline telemetryv1beta1.TracePipeline
+
+	err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+	require.NoError(t, err)
+
+	requireHasStatusCondition(t, &updatedPipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonConfigGenerationFailed,
+		fmt.Sprintf("Generated collector configuration is invalid: invalid configuration of processor 'transform/tracepipeline-user-defined-%s'. The pipeline is excluded from the collector configuration until it is fixed. See troubleshooting: %s", pipeline.Name, conditions.LinkConfigGenerationFailed),
+	)
+}
+
 // TestAPIServerFailureHandling verifies proper handling of Kubernetes API server errors
 func TestAPIServerFailureHandling(t *testing.T) {
 	serverErr := errors.New("failed to get lock: server error")
@@ -784,6 +974,31 @@ func buildTracePipelineLabelValues(pipelineName, endpoint string, enabledFeature
 	return labelValues
 }
 
+// TestConditionTransitionEvents verifies that Events are emitted for condition transitions only
+func TestConditionTransitionEvents(t *testing.T) {
+	pipeline := testutils.NewTracePipelineBuilder().WithName("pipeline").Build()
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+	flowHealthProberStub := &mocks.FlowHealthProber{}
+	flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelGatewayProbeResult{
+		PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+	}, nil)
+
+	fakeRecorder := events.NewFakeRecorder(10)
+	sut := testReconciler(fakeClient, flowHealthProberStub)
+	WithEventRecorder(commonstatus.NewConditionEventRecorder(fakeRecorder))(sut)
+
+	_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+	require.NoError(t, err)
+	require.Contains(t, drainEvents(fakeRecorder),
+		"Normal GatewayConfigured ConfigurationGenerated: TracePipeline specification is successfully applied to the configuration of OTLP Gateway")
+
+	// Reconciling again without any change must not emit new Events
+	_, err = sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+	require.NoError(t, err)
+	require.Empty(t, drainEvents(fakeRecorder))
+}
+
 // TestDeletingPipeline verifies that deleting pipelines are properly handled
 func TestDeletingPipeline(t *testing.T) {
 	now := metav1.Now()
@@ -858,3 +1073,17 @@ func getCondition(pipeline *telemetryv1beta1.TracePipeline, condType string) *me
 
 	return nil
 }
+
+// Helper function to collect all Events emitted so far
+func drainEvents(recorder *events.FakeRecorder) []string {
+	var result []string
+
+	for {
+		select {
+		case e := <-recorder.Events:
+			result = append(result, e)
+		default:
+			return result
+		}
+	}
+}
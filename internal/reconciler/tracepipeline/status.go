@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -19,13 +20,15 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+	"github.com/kyma-project/telemetry-manager/internal/validators/collectorconfig"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
 // updateStatus updates the status of a TracePipeline resource.
-// It sets the GatewayHealthy, ConfigurationGenerated and TelemetryFlowHealthy conditions.
+// It sets the GatewayHealthy, ConfigurationGenerated and TelemetryFlowHealthy conditions, and the time of the last delivered heartbeat.
 func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) error {
 	var pipeline telemetryv1beta1.TracePipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pipelineName}, &pipeline); err != nil {
@@ -42,6 +45,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		return nil
 	}
 
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
 	var allErrors error = nil
 
 	r.setGatewayHealthyCondition(ctx, &pipeline)
@@ -51,8 +56,12 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		allErrors = errors.Join(allErrors, err)
 	}
 
+	r.setLastHeartbeatDelivered(&pipeline)
+
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update TracePipeline status: %w", err))
+	} else if r.eventRecorder != nil {
+		r.eventRecorder.RecordConditionTransitions(&pipeline, oldConditions, pipeline.Status.Conditions)
 	}
 
 	return allErrors
@@ -89,6 +98,10 @@ func (r *Reconciler) setGatewayConfigGeneratedCondition(ctx context.Context, pip
 }
 
 func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) (status metav1.ConditionStatus, reason string, message string) {
+	if pipeline.Spec.Suspend {
+		return metav1.ConditionFalse, conditions.ReasonSuspended, conditions.MessageForTracePipeline(conditions.ReasonSuspended)
+	}
+
 	err := r.pipelineValidator.validate(ctx, pipeline)
 	if err == nil {
 		return metav1.ConditionTrue, conditions.ReasonGatewayConfigured, conditions.MessageForTracePipeline(conditions.ReasonGatewayConfigured)
@@ -114,6 +127,12 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			fmt.Sprintf(conditions.MessageForTracePipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
 	}
 
+	if collectorconfig.IsConfigInvalidError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonConfigGenerationFailed,
+			fmt.Sprintf(conditions.MessageForTracePipeline(conditions.ReasonConfigGenerationFailed), err.Error())
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForTracePipeline(conditions.ReasonValidationFailed)
 	}
@@ -150,18 +169,23 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 
 	logf.FromContext(ctx).V(1).Info("Probed flow health", "result", probeResult)
 
-	reason := flowHealthReasonFor(probeResult)
-	if probeResult.Healthy {
+	heartbeatFailing := sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) &&
+		commonstatus.IsHeartbeatFailing(r.heartbeatTracker, pipelines.SignalTypeTrace, pipeline.Name)
+
+	reason := flowHealthReasonFor(probeResult, heartbeatFailing)
+	if reason == conditions.ReasonSelfMonFlowHealthy {
 		return metav1.ConditionTrue, reason, nil
 	}
 
 	return metav1.ConditionFalse, reason, nil
 }
 
-func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
+func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult, heartbeatFailing bool) string {
 	switch {
 	case probeResult.AllDataDropped:
 		return conditions.ReasonSelfMonGatewayAllDataDropped
+	case heartbeatFailing:
+		return conditions.ReasonSelfMonHeartbeatFailing
 	case probeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
 	case probeResult.Throttling:
@@ -170,3 +194,13 @@ func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
 		return conditions.ReasonSelfMonFlowHealthy
 	}
 }
+
+// setLastHeartbeatDelivered reports the time of the last heartbeat that the exporter of the pipeline sent to the backend.
+func (r *Reconciler) setLastHeartbeatDelivered(pipeline *telemetryv1beta1.TracePipeline) {
+	if !sharedtypesutils.IsHeartbeatEnabled(pipeline.Spec.Heartbeat) {
+		pipeline.Status.LastHeartbeatDelivered = nil
+		return
+	}
+
+	pipeline.Status.LastHeartbeatDelivered = commonstatus.LastHeartbeatDelivered(r.heartbeatTracker, pipelines.SignalTypeTrace, pipeline.Name, pipeline.Status.LastHeartbeatDelivered)
+}
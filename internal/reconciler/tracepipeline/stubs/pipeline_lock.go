@@ -19,3 +19,7 @@ func (p *PipelineLock) TryAcquireLock(_ context.Context, _ metav1.Object) error
 func (p *PipelineLock) IsLockHolder(_ context.Context, _ metav1.Object) error {
 	return nil
 }
+
+func (p *PipelineLock) ReleaseLock(_ context.Context, _ metav1.Object) error {
+	return nil
+}
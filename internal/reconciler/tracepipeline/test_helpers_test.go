@@ -60,12 +60,13 @@ func testReconcilerWithPipelineLock(fakeClient client.Client, flowHealthProber F
 // newTestValidator creates a validator with the provided options or defaults
 func newTestValidator(opts ...ValidatorOption) *Validator {
 	v := &Validator{
-		SecretRefValidator:     stubs.NewSecretRefValidator(nil),
-		TLSCertValidator:       stubs.NewTLSCertValidator(nil),
-		FilterSpecValidator:    stubs.NewFilterSpecValidator(nil),
-		TransformSpecValidator: stubs.NewTransformSpecValidator(nil),
-		EndpointValidator:      stubs.NewEndpointValidator(nil),
-		PipelineLock:           stubs.NewPipelineLock(),
+		SecretRefValidator:       stubs.NewSecretRefValidator(nil),
+		TLSCertValidator:         stubs.NewTLSCertValidator(nil),
+		FilterSpecValidator:      stubs.NewFilterSpecValidator(nil),
+		TransformSpecValidator:   stubs.NewTransformSpecValidator(nil),
+		EndpointValidator:        stubs.NewEndpointValidator(nil),
+		PipelineLock:             stubs.NewPipelineLock(),
+		CollectorConfigValidator: stubs.NewCollectorConfigValidator(nil),
 	}
 
 	for _, opt := range opts {
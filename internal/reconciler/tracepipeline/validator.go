@@ -10,12 +10,13 @@ import (
 
 // Validator validates TracePipeline resources by checking endpoints, TLS certificates, secret references, and pipeline locks.
 type Validator struct {
-	EndpointValidator      EndpointValidator
-	TLSCertValidator       TLSCertValidator
-	SecretRefValidator     SecretRefValidator
-	PipelineLock           PipelineLock
-	TransformSpecValidator TransformSpecValidator
-	FilterSpecValidator    FilterSpecValidator
+	EndpointValidator        EndpointValidator
+	TLSCertValidator         TLSCertValidator
+	SecretRefValidator       SecretRefValidator
+	PipelineLock             PipelineLock
+	TransformSpecValidator   TransformSpecValidator
+	FilterSpecValidator      FilterSpecValidator
+	CollectorConfigValidator CollectorConfigValidator
 }
 
 // ValidatorOption configures the Validator during initialization.
@@ -63,6 +64,13 @@ func WithFilterSpecValidator(validator FilterSpecValidator) ValidatorOption {
 	}
 }
 
+// WithCollectorConfigValidator sets the collector config validator for the Validator.
+func WithCollectorConfigValidator(validator CollectorConfigValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.CollectorConfigValidator = validator
+	}
+}
+
 // NewValidator creates a new Validator with the provided options.
 func NewValidator(opts ...ValidatorOption) *Validator {
 	v := &Validator{}
@@ -80,29 +88,13 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Tra
 	}
 
 	if pipeline.Spec.Output.OTLP != nil {
-		var oauth2 *telemetryv1beta1.OAuth2Options = nil
-		if pipeline.Spec.Output.OTLP.Authentication != nil {
-			oauth2 = pipeline.Spec.Output.OTLP.Authentication.OAuth2
-		}
-
-		if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
-			Endpoint:   &pipeline.Spec.Output.OTLP.Endpoint,
-			Protocol:   pipeline.Spec.Output.OTLP.Protocol,
-			OutputTLS:  pipeline.Spec.Output.OTLP.TLS,
-			OTLPOAuth2: oauth2,
-		}); err != nil {
+		if err := v.validateOTLPOutput(ctx, pipeline.Spec.Output.OTLP); err != nil {
 			return err
 		}
 	}
 
-	if tlsValidationRequired(pipeline) {
-		tlsConfig := tlscert.TLSValidationParams{
-			Cert: pipeline.Spec.Output.OTLP.TLS.Cert,
-			Key:  pipeline.Spec.Output.OTLP.TLS.Key,
-			CA:   pipeline.Spec.Output.OTLP.TLS.CA,
-		}
-
-		if err := v.TLSCertValidator.Validate(ctx, tlsConfig); err != nil {
+	for i := range pipeline.Spec.Routes {
+		if err := v.validateOTLPOutput(ctx, pipeline.Spec.Routes[i].Output.OTLP); err != nil {
 			return err
 		}
 	}
@@ -119,15 +111,45 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Tra
 		return err
 	}
 
+	// The generated configuration is validated last, because rendering it requires the references of the pipeline to be valid
+	if err := v.CollectorConfigValidator.ValidateTracePipeline(ctx, pipeline); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func tlsValidationRequired(pipeline *telemetryv1beta1.TracePipeline) bool {
-	otlp := pipeline.Spec.Output.OTLP
-	if otlp == nil {
-		return false
+// validateOTLPOutput validates the endpoint and the TLS certificates of an OTLP output, which is either the default output of the pipeline or the output of a route.
+func (v *Validator) validateOTLPOutput(ctx context.Context, otlp *telemetryv1beta1.OTLPOutput) error {
+	var oauth2 *telemetryv1beta1.OAuth2Options = nil
+	if otlp.Authentication != nil {
+		oauth2 = otlp.Authentication.OAuth2
+	}
+
+	if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
+		Endpoint:   &otlp.Endpoint,
+		Protocol:   otlp.Protocol,
+		OutputTLS:  otlp.TLS,
+		OTLPOAuth2: oauth2,
+		ProxyURL:   otlp.ProxyURL,
+	}); err != nil {
+		return err
 	}
 
+	if !tlsValidationRequired(otlp) {
+		return nil
+	}
+
+	return v.TLSCertValidator.Validate(ctx, tlscert.TLSValidationParams{
+		Cert: otlp.TLS.Cert,
+		Key:  otlp.TLS.Key,
+		CA:   otlp.TLS.CA,
+	})
+}
+
+// tlsValidationRequired checks if TLS validation is required for the OTLP output.
+// Returns true if the output has a TLS configuration containing cert, key, or CA.
+func tlsValidationRequired(otlp *telemetryv1beta1.OTLPOutput) bool {
 	if otlp.TLS == nil {
 		return false
 	}
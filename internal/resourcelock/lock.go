@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -99,6 +100,36 @@ func (c *Checker) IsLockHolder(ctx context.Context, obj metav1.Object) error {
 	return ErrMaxPipelinesExceeded
 }
 
+// ReleaseLock removes the given owner from the lock, so that it no longer counts towards the maximum number of owners.
+// Releasing a lock that the owner doesn't hold is a no-op.
+func (c *Checker) ReleaseLock(ctx context.Context, owner metav1.Object) error {
+	var lock corev1.ConfigMap
+	if err := c.client.Get(ctx, c.lockName, &lock); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get lock: %w", err)
+	}
+
+	refs := lock.GetOwnerReferences()
+
+	remainingRefs := slices.DeleteFunc(slices.Clone(refs), func(ref metav1.OwnerReference) bool {
+		return ref.Name == owner.GetName() && ref.UID == owner.GetUID()
+	})
+	if len(remainingRefs) == len(refs) {
+		return nil
+	}
+
+	lock.SetOwnerReferences(remainingRefs)
+
+	if err := c.client.Update(ctx, &lock); err != nil {
+		return fmt.Errorf("failed to update lock: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Checker) createLock(ctx context.Context, owner metav1.Object) error {
 	lock := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
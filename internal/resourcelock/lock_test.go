@@ -126,6 +126,57 @@ func TestTryAcquireLock_UnlimitedPipelines(t *testing.T) {
 	require.Len(t, getOwners, 6)
 }
 
+func TestReleaseLock(t *testing.T) {
+	owner1 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner1",
+			Namespace: "default",
+		},
+	}
+	owner2 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner2",
+			Namespace: "default",
+		},
+	}
+	owner3 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner3",
+			Namespace: "default",
+		},
+	}
+
+	ctx := t.Context()
+	fakeClient := fake.NewClientBuilder().Build()
+	l := NewLocker(fakeClient, lockName, 2)
+
+	// Releasing a lock that doesn't exist yet is a no-op
+	err := l.ReleaseLock(ctx, owner1)
+	require.NoError(t, err)
+
+	err = l.TryAcquireLock(ctx, owner1)
+	require.NoError(t, err)
+	err = l.TryAcquireLock(ctx, owner2)
+	require.NoError(t, err)
+	err = l.TryAcquireLock(ctx, owner3)
+	require.Equal(t, ErrMaxPipelinesExceeded, err)
+
+	err = l.ReleaseLock(ctx, owner1)
+	require.NoError(t, err)
+	err = l.IsLockHolder(ctx, owner1)
+	require.Equal(t, ErrMaxPipelinesExceeded, err)
+
+	// The released slot can be acquired by another owner
+	err = l.TryAcquireLock(ctx, owner3)
+	require.NoError(t, err)
+	err = l.IsLockHolder(ctx, owner2)
+	require.NoError(t, err)
+
+	// Releasing a lock that the owner doesn't hold is a no-op
+	err = l.ReleaseLock(ctx, owner1)
+	require.NoError(t, err)
+}
+
 func Test_new(t *testing.T) {
 	type args struct {
 		client    client.Client
@@ -22,11 +22,12 @@ const (
 )
 
 // OTLPGatewayConfigMap represents the structure of the OTLP Gateway coordination ConfigMap.
-// It contains references to pipelines that should be included in the OTLP Gateway configuration.
+// It contains references to pipelines and telemetry routes that should be included in the OTLP Gateway configuration.
 type OTLPGatewayConfigMap struct {
-	TracePipelineReferences  []PipelineReference `yaml:"tracePipelines,omitempty"`
-	LogPipelineReferences    []PipelineReference `yaml:"logPipelines,omitempty"`
-	MetricPipelineReferences []PipelineReference `yaml:"metricPipelines,omitempty"`
+	TracePipelineReferences  []PipelineReference       `yaml:"tracePipelines,omitempty"`
+	LogPipelineReferences    []PipelineReference       `yaml:"logPipelines,omitempty"`
+	MetricPipelineReferences []PipelineReference       `yaml:"metricPipelines,omitempty"`
+	TelemetryRouteReferences []TelemetryRouteReference `yaml:"telemetryRoutes,omitempty"`
 }
 
 // PipelineReference contains minimal information about a pipeline.
@@ -37,6 +38,15 @@ type PipelineReference struct {
 	SecretVersions map[string]string `yaml:"secretVersions,omitempty"`
 }
 
+// TelemetryRouteReference contains minimal information about a namespaced TelemetryRoute.
+// The controller must fetch the full route spec using the namespace and name.
+type TelemetryRouteReference struct {
+	Name           string            `yaml:"name"`
+	Namespace      string            `yaml:"namespace"`
+	Generation     int64             `yaml:"generation"`
+	SecretVersions map[string]string `yaml:"secretVersions,omitempty"`
+}
+
 // PipelineReferenceInput contains the data needed to write a pipeline reference.
 type PipelineReferenceInput struct {
 	Name           string
@@ -157,6 +167,47 @@ func RemovePipelineReference(ctx context.Context, c client.Client, namespace str
 	})
 }
 
+// AddTelemetryRouteReference adds or updates a TelemetryRoute reference.
+// Uses optimistic locking to handle concurrent updates safely.
+func AddTelemetryRouteReference(ctx context.Context, c client.Client, namespace string, input TelemetryRouteReference) error {
+	return applyConfigUpdate(ctx, c, namespace, func(config *OTLPGatewayConfigMap) error {
+		// Find existing reference
+		for i := range config.TelemetryRouteReferences {
+			ref := &config.TelemetryRouteReferences[i]
+			if ref.Namespace == input.Namespace && ref.Name == input.Name {
+				// Update generation and secret versions
+				ref.Generation = input.Generation
+				ref.SecretVersions = input.SecretVersions
+
+				return nil
+			}
+		}
+
+		// Add new reference
+		config.TelemetryRouteReferences = append(config.TelemetryRouteReferences, input)
+
+		return nil
+	})
+}
+
+// RemoveTelemetryRouteReference removes a TelemetryRoute reference.
+// Uses optimistic locking to handle concurrent updates safely.
+func RemoveTelemetryRouteReference(ctx context.Context, c client.Client, namespace string, route types.NamespacedName) error {
+	return applyConfigUpdate(ctx, c, namespace, func(config *OTLPGatewayConfigMap) error {
+		// Filter out the reference
+		filtered := make([]TelemetryRouteReference, 0, len(config.TelemetryRouteReferences))
+		for _, ref := range config.TelemetryRouteReferences {
+			if ref.Namespace != route.Namespace || ref.Name != route.Name {
+				filtered = append(filtered, ref)
+			}
+		}
+
+		config.TelemetryRouteReferences = filtered
+
+		return nil
+	})
+}
+
 // getPipelineSlice returns a pointer to the appropriate pipeline slice based on type.
 func getPipelineSlice(config *OTLPGatewayConfigMap, pipelineType pipelines.SignalType) *[]PipelineReference {
 	switch pipelineType { //nolint:exhaustive // no gateway for FluentBit
@@ -893,3 +893,35 @@ type conflictOnUpdateClient struct {
 func (c *conflictOnUpdateClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
 	return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, obj.GetName(), fmt.Errorf("resource version mismatch"))
 }
+
+func TestTelemetryRouteReference_AddUpdateRemove(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	// Routes with the same name in different namespaces are distinct
+	require.NoError(t, AddTelemetryRouteReference(ctx, fakeClient, "kyma-system", TelemetryRouteReference{Name: "route", Namespace: "team-a", Generation: 1}))
+	require.NoError(t, AddTelemetryRouteReference(ctx, fakeClient, "kyma-system", TelemetryRouteReference{Name: "route", Namespace: "team-b", Generation: 1}))
+	require.NoError(t, AddTelemetryRouteReference(ctx, fakeClient, "kyma-system", TelemetryRouteReference{
+		Name:           "route",
+		Namespace:      "team-a",
+		Generation:     2,
+		SecretVersions: map[string]string{"team-a/credentials": "42"},
+	}))
+
+	config, err := ReadOTLPGatewayConfig(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Equal(t, []TelemetryRouteReference{
+		{Name: "route", Namespace: "team-a", Generation: 2, SecretVersions: map[string]string{"team-a/credentials": "42"}},
+		{Name: "route", Namespace: "team-b", Generation: 1},
+	}, config.TelemetryRouteReferences)
+	require.Empty(t, config.TracePipelineReferences)
+
+	require.NoError(t, RemoveTelemetryRouteReference(ctx, fakeClient, "kyma-system", types.NamespacedName{Name: "route", Namespace: "team-a"}))
+
+	config, err = ReadOTLPGatewayConfig(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Equal(t, []TelemetryRouteReference{{Name: "route", Namespace: "team-b", Generation: 1}}, config.TelemetryRouteReferences)
+}
@@ -4,6 +4,7 @@ const (
 	telemetryPrefix       = "telemetry-"
 	metricsSuffix         = "-metrics"
 	exporterMetricsSuffix = "-exporter-metrics" // used for Fluent Bit directory-size exporter
+	scrapeHealthSuffix    = "-scrape-health"    // used for the scrape target health of the Metric Agent
 
 	LogAgent      = telemetryPrefix + "log-agent"
 	MetricAgent   = telemetryPrefix + "metric-agent"
@@ -16,6 +17,8 @@ const (
 	MetricAgentMetricsService = MetricAgent + metricsSuffix
 	OTLPGatewayMetricsService = OTLPGateway + metricsSuffix
 
+	MetricAgentScrapeHealthService = MetricAgent + scrapeHealthSuffix
+
 	FluentBit                       = telemetryPrefix + "fluent-bit"
 	FluentBitMetricsService         = FluentBit + metricsSuffix
 	FluentBitExporterMetricsService = FluentBit + exporterMetricsSuffix
@@ -25,12 +28,17 @@ const (
 	FluentBitEnvSecret              = FluentBit + "-env"
 	FluentBitTLSConfigSecret        = FluentBit + "-output-tls-config"
 
-	OTLPMetricsService = telemetryPrefix + "otlp-metrics"
-	OTLPTracesService  = telemetryPrefix + "otlp-traces"
-	OTLPLogsService    = telemetryPrefix + "otlp-logs"
-	OTLPService        = telemetryPrefix + "otlp"
+	OTLPMetricsService  = telemetryPrefix + "otlp-metrics"
+	OTLPTracesService   = telemetryPrefix + "otlp-traces"
+	OTLPLogsService     = telemetryPrefix + "otlp-logs"
+	OTLPService         = telemetryPrefix + "otlp"
+	OTLPExternalService = telemetryPrefix + "otlp-external"
+
+	MetricPrometheusService = telemetryPrefix + "metric-prometheus"
 
-	OTLPGatewayCoordinationConfigMap = OTLPGateway + "-coordination"
+	OTLPGatewayCoordinationConfigMap   = OTLPGateway + "-coordination"
+	OTLPGatewayRolloutHistoryConfigMap = OTLPGateway + "-rollout-history"
+	OTLPGatewayRolloutSnapshotsSecret  = OTLPGateway + "-rollout-snapshots"
 
 	SelfMonitor              = telemetryPrefix + "self-monitor"
 	SelfMonitorContainerName = "self-monitor"
@@ -64,3 +72,8 @@ const (
 func MetricsServiceName(componentName string) string {
 	return componentName + metricsSuffix
 }
+
+// ScrapeHealthServiceName returns the scrape health service name for a given component name
+func ScrapeHealthServiceName(componentName string) string {
+	return componentName + scrapeHealthSuffix
+}
@@ -36,6 +36,9 @@ const (
 	CheckpointVolumePath = "/tmp"
 	logVolumeName        = "varlogpods"
 	logVolumePath        = "/var/log/pods"
+
+	HostRootPath       = "/hostfs"
+	hostRootVolumeName = "hostfs"
 )
 
 var (
@@ -66,6 +69,14 @@ type AgentApplyOptions struct {
 	CollectorEnvVars    map[string][]byte
 	// BackendPorts is needed only for the Metric Agent to set the value of the annotation "traffic.sidecar.istio.io/includeOutboundPorts"
 	BackendPorts []string
+	// HostRootMountEnabled is needed only for the Metric Agent to mount the root filesystem of the Node for collecting host metrics
+	HostRootMountEnabled bool
+	// PrometheusExporterEnabled is needed only for the Metric Agent to expose the Prometheus exporter of a metric pipeline with a prometheus output
+	PrometheusExporterEnabled bool
+	// ScrapeHealthEnabled is needed only for the Metric Agent to expose the health of the Prometheus scrape targets to the self-monitor
+	ScrapeHealthEnabled bool
+	// ServiceAccountTokenAudiences are the audiences of the outputs with service account token authentication. A service account token is projected into the agent pods for each of them.
+	ServiceAccountTokenAudiences []string
 }
 
 func NewLogAgentApplierDeleter(globals config.Global, collectorImage, priorityClassName string) *AgentApplierDeleter {
@@ -172,7 +183,11 @@ func (aad *AgentApplierDeleter) ApplyResources(ctx context.Context, c client.Cli
 
 	configChecksum := configchecksum.Calculate([]corev1.ConfigMap{*configMap}, secretsInChecksum)
 
-	networkPolicies := makeAgentNetworkPolicies(name, opts.IstioEnabled)
+	if err := aad.applyScrapeHealthService(ctx, labelerClient, c, name, opts.ScrapeHealthEnabled); err != nil {
+		return err
+	}
+
+	networkPolicies := makeAgentNetworkPolicies(name, opts.IstioEnabled, opts.PrometheusExporterEnabled, opts.ScrapeHealthEnabled)
 
 	for _, np := range networkPolicies {
 		if err := k8sutils.CreateOrUpdateNetworkPolicy(ctx, labelerClient, np); err != nil {
@@ -235,6 +250,11 @@ func (aad *AgentApplierDeleter) DeleteResources(ctx context.Context, c client.Cl
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete configmap: %w", err))
 	}
 
+	scrapeHealthService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.ScrapeHealthServiceName(aad.baseName), Namespace: aad.globals.TargetNamespace()}}
+	if err := k8sutils.DeleteObject(ctx, c, &scrapeHealthService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete scrape health service: %w", err))
+	}
+
 	daemonSet := appsv1.DaemonSet{ObjectMeta: objectMeta}
 	if err := k8sutils.DeleteObject(ctx, c, &daemonSet); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete daemonset: %w", err))
@@ -250,6 +270,24 @@ func (aad *AgentApplierDeleter) DeleteResources(ctx context.Context, c client.Cl
 	return allErrors
 }
 
+// applyScrapeHealthService creates the service that exposes the health of the Prometheus scrape targets to the self-monitor, or deletes it if the scrape health is not exposed.
+func (aad *AgentApplierDeleter) applyScrapeHealthService(ctx context.Context, labelerClient, c client.Client, name types.NamespacedName, enabled bool) error {
+	if !enabled {
+		service := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.ScrapeHealthServiceName(name.Name), Namespace: name.Namespace}}
+		if err := k8sutils.DeleteObject(ctx, c, &service); err != nil {
+			return fmt.Errorf("failed to delete scrape health service: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := k8sutils.CreateOrUpdateService(ctx, labelerClient, makeScrapeHealthService(name)); err != nil {
+		return fmt.Errorf("failed to create scrape health service: %w", err)
+	}
+
+	return nil
+}
+
 func (aad *AgentApplierDeleter) makeAgentDaemonSet(configChecksum string, opts AgentApplyOptions) *appsv1.DaemonSet {
 	annotations := aad.makeAnnotationsFunc(configChecksum, opts)
 
@@ -262,6 +300,17 @@ func (aad *AgentApplierDeleter) makeAgentDaemonSet(configChecksum string, opts A
 	containerOpts := slices.Clone(aad.containerOpts)
 	containerOpts = append(containerOpts, commonresources.WithClusterTrustBundleVolumeMount(aad.globals.ClusterTrustBundleName()))
 
+	if opts.HostRootMountEnabled {
+		podOpts = append(podOpts, commonresources.WithVolumes([]corev1.Volume{makeHostRootVolume()}))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts([]corev1.VolumeMount{makeHostRootVolumeMount()}))
+	}
+
+	if len(opts.ServiceAccountTokenAudiences) > 0 {
+		volumes, volumeMounts := makeServiceAccountTokenVolume(opts.ServiceAccountTokenAudiences)
+		podOpts = append(podOpts, commonresources.WithVolumes(volumes))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts(volumeMounts))
+	}
+
 	// When VPA is active, override the memory limit to 2x the memory request so the VPA can scale within a tighter range.
 	// This replaces the default high memory limit (agentMemoryLimit) set during construction.
 	// For more details, check the ADR: https://github.com/kyma-project/telemetry-manager/blob/main/docs/contributor/arch/032-vertical-pod-autoscaler-VPA-architecture.md
@@ -291,7 +340,7 @@ func (aad *AgentApplierDeleter) makeAgentDaemonSet(configChecksum string, opts A
 	)
 }
 
-func makeAgentNetworkPolicies(name types.NamespacedName, istioEnabled bool) []*networkingv1.NetworkPolicy {
+func makeAgentNetworkPolicies(name types.NamespacedName, istioEnabled, prometheusExporterEnabled, scrapeHealthEnabled bool) []*networkingv1.NetworkPolicy {
 	metricsNetworkPolicy := commonresources.MakeNetworkPolicy(
 		name,
 		commonresources.DefaultSelector(name.Name),
@@ -300,12 +349,18 @@ func makeAgentNetworkPolicies(name types.NamespacedName, istioEnabled bool) []*n
 			map[string]string{
 				commonresources.LabelKeyTelemetryMetricsScraping: commonresources.LabelValueTelemetryMetricsScraping,
 			},
-			agentIngressMetricsPorts(istioEnabled)),
+			agentIngressMetricsPorts(istioEnabled, scrapeHealthEnabled)),
 	)
+	// The backends and the proxies of the pipeline outputs are user-defined, so egress is allowed to any destination
+	agentNetworkPolicyOpts := []commonresources.NetworkPolicyOption{commonresources.WithEgressToAny()}
+	if prometheusExporterEnabled {
+		agentNetworkPolicyOpts = append(agentNetworkPolicyOpts, commonresources.WithIngressFromAny(ports.PrometheusExporter))
+	}
+
 	agentNetworkPolicy := commonresources.MakeNetworkPolicy(
 		name,
 		commonresources.DefaultSelector(name.Name),
-		commonresources.WithEgressToAny(),
+		agentNetworkPolicyOpts...,
 	)
 
 	return []*networkingv1.NetworkPolicy{metricsNetworkPolicy, agentNetworkPolicy}
@@ -325,7 +380,16 @@ func makeMetricAgentAnnotations(configChecksum string, opts AgentApplyOptions) m
 	annotations := map[string]string{commonresources.AnnotationKeyChecksumConfig: configChecksum}
 
 	if opts.IstioEnabled {
-		annotations[commonresources.AnnotationKeyIstioExcludeInboundPorts] = strconv.Itoa(int(ports.Metrics))
+		excludeInboundPorts := []string{strconv.Itoa(int(ports.Metrics))}
+		if opts.PrometheusExporterEnabled {
+			excludeInboundPorts = append(excludeInboundPorts, strconv.Itoa(int(ports.PrometheusExporter)))
+		}
+
+		if opts.ScrapeHealthEnabled {
+			excludeInboundPorts = append(excludeInboundPorts, strconv.Itoa(int(ports.ScrapeHealth)))
+		}
+
+		annotations[commonresources.AnnotationKeyIstioExcludeInboundPorts] = strings.Join(excludeInboundPorts, ",")
 		// Provision Istio certificates for Prometheus Receiver running as a part of MetricAgent by injecting a sidecar which will rotate SDS certificates and output them to a volume.
 		annotations[commonresources.AnnotationKeyIstioProxyConfig] = fmt.Sprintf(`# configure an env variable OUTPUT_CERTS to write certificates to the given folder
 proxyMetadata:
@@ -399,9 +463,34 @@ func makeFileLogCheckPointVolumeMount() corev1.VolumeMount {
 	}
 }
 
-func agentIngressMetricsPorts(istioEnabled bool) []int32 {
+func makeHostRootVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: hostRootVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: "/",
+				Type: ptr.To(corev1.HostPathDirectory),
+			},
+		},
+	}
+}
+
+func makeHostRootVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:             hostRootVolumeName,
+		MountPath:        HostRootPath,
+		ReadOnly:         true,
+		MountPropagation: ptr.To(corev1.MountPropagationHostToContainer),
+	}
+}
+
+func agentIngressMetricsPorts(istioEnabled, scrapeHealthEnabled bool) []int32 {
 	metricsPorts := []int32{ports.Metrics}
 
+	if scrapeHealthEnabled {
+		metricsPorts = append(metricsPorts, ports.ScrapeHealth)
+	}
+
 	if istioEnabled {
 		metricsPorts = append(metricsPorts, ports.IstioEnvoyTelemetry)
 	}
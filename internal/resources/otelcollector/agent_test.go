@@ -46,6 +46,10 @@ func TestAgent_ApplyResources(t *testing.T) {
 		vpaCRDExists        bool
 		vpaEnabled          bool
 		vpaMaxAllowedMemory resource.Quantity
+		hostRootMount       bool
+		prometheusExporter  bool
+		scrapeHealth        bool
+		audiences           []string
 	}{
 		{
 			name:           "Metric Agent",
@@ -59,6 +63,34 @@ func TestAgent_ApplyResources(t *testing.T) {
 			backendPorts:   []string{"4317", "9090"},
 			goldenFilePath: "testdata/metric-agent-istio.yaml",
 		},
+		{
+			name:               "Metric Agent with prometheus exporter and istio",
+			sut:                NewMetricAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			istioEnabled:       true,
+			prometheusExporter: true,
+			backendPorts:       []string{"4317", "9090"},
+			goldenFilePath:     "testdata/metric-agent-prometheus-exporter.yaml",
+		},
+		{
+			name:           "Metric Agent with scrape health and istio",
+			sut:            NewMetricAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			istioEnabled:   true,
+			scrapeHealth:   true,
+			backendPorts:   []string{"4317", "9090"},
+			goldenFilePath: "testdata/metric-agent-scrape-health.yaml",
+		},
+		{
+			name:           "Metric Agent with host root mount",
+			sut:            NewMetricAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			hostRootMount:  true,
+			goldenFilePath: "testdata/metric-agent-host-root.yaml",
+		},
+		{
+			name:           "Metric Agent with service account tokens",
+			sut:            NewMetricAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			audiences:      []string{"https://metrics.example.com", "https://traces.example.com"},
+			goldenFilePath: "testdata/metric-agent-serviceaccount-token.yaml",
+		},
 		{
 			name:           "Metric Agent with FIPS mode enabled",
 			sut:            NewMetricAgentApplierDeleter(globalsWithFIPS, collectorImage, priorityClassName),
@@ -136,13 +168,17 @@ func TestAgent_ApplyResources(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.sut.ApplyResources(t.Context(), fakeClient, AgentApplyOptions{
-				IstioEnabled:        tt.istioEnabled,
-				CollectorConfigYAML: "dummy",
-				CollectorEnvVars:    tt.collectorEnvVars,
-				BackendPorts:        tt.backendPorts,
-				VpaCRDExists:        tt.vpaCRDExists,
-				VpaEnabled:          tt.vpaEnabled,
-				VPAMaxAllowedMemory: tt.vpaMaxAllowedMemory,
+				IstioEnabled:                 tt.istioEnabled,
+				CollectorConfigYAML:          "dummy",
+				CollectorEnvVars:             tt.collectorEnvVars,
+				BackendPorts:                 tt.backendPorts,
+				VpaCRDExists:                 tt.vpaCRDExists,
+				VpaEnabled:                   tt.vpaEnabled,
+				VPAMaxAllowedMemory:          tt.vpaMaxAllowedMemory,
+				HostRootMountEnabled:         tt.hostRootMount,
+				PrometheusExporterEnabled:    tt.prometheusExporter,
+				ScrapeHealthEnabled:          tt.scrapeHealth,
+				ServiceAccountTokenAudiences: tt.audiences,
 			})
 			require.NoError(t, err)
 
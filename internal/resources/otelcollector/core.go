@@ -181,6 +181,37 @@ func makeMetricsService(name types.NamespacedName) *corev1.Service {
 	}
 }
 
+// makeScrapeHealthService creates the service through which the self-monitor scrapes the health of the Prometheus scrape targets from the Metric Agent.
+// A dedicated service is needed, because the self-monitor scrapes only the port given by the prometheus.io/port annotation of a service.
+func makeScrapeHealthService(name types.NamespacedName) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.ScrapeHealthServiceName(name.Name),
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				commonresources.LabelKeyTelemetrySelfMonitor: commonresources.LabelValueTelemetrySelfMonitor,
+			},
+			Annotations: map[string]string{
+				commonresources.AnnotationKeyPrometheusScrape: "true",
+				commonresources.AnnotationKeyPrometheusPort:   strconv.Itoa(int(ports.ScrapeHealth)),
+				commonresources.AnnotationKeyPrometheusScheme: "http",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http-scrape-health",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.ScrapeHealth,
+					TargetPort: intstr.FromInt32(ports.ScrapeHealth),
+				},
+			},
+			Selector: commonresources.DefaultSelector(name.Name),
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
 func makeVPA(name types.NamespacedName, minAllowedMemory, maxAllowedMemory resource.Quantity) *autoscalingvpav1.VerticalPodAutoscaler {
 	if maxAllowedMemory.Cmp(minAllowedMemory) < 0 {
 		maxAllowedMemory = minAllowedMemory
@@ -3,7 +3,9 @@ package otelcollector
 import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 )
@@ -13,6 +15,8 @@ const (
 	containerName  = "collector"
 )
 
+const serviceAccountTokenVolumeName = "serviceaccount-tokens"
+
 const (
 	fieldPathPodIP    = "status.podIP"
 	fieldPathNodeName = "spec.nodeName"
@@ -64,3 +68,40 @@ func makePodSpec(
 
 	return commonresources.MakePodSpec(baseName, podOpts...)
 }
+
+// makeServiceAccountTokenVolume projects one service account token per audience into the collector,
+// so that the outputs with service account token authentication can read the token of their audience from a file.
+// The kubelet rotates the tokens before they expire.
+func makeServiceAccountTokenVolume(audiences []string) ([]corev1.Volume, []corev1.VolumeMount) {
+	sources := make([]corev1.VolumeProjection, 0, len(audiences))
+	for _, audience := range audiences {
+		sources = append(sources, corev1.VolumeProjection{
+			ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+				Audience:          audience,
+				ExpirationSeconds: ptr.To(common.ServiceAccountTokenExpirationSeconds),
+				Path:              common.ServiceAccountTokenFileName(audience),
+			},
+		})
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name: serviceAccountTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: sources,
+				},
+			},
+		},
+	}
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      serviceAccountTokenVolumeName,
+			MountPath: common.ServiceAccountTokenDir,
+			ReadOnly:  true,
+		},
+	}
+
+	return volumes, volumeMounts
+}
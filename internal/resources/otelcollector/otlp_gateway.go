@@ -119,7 +119,7 @@ func (o *OTLPGatewayApplierDeleter) ApplyResources(ctx context.Context, c client
 
 	configChecksum := configchecksum.Calculate([]corev1.ConfigMap{*configMap}, []corev1.Secret{*secret})
 
-	networkPolicies := makeGatewayNetworkPolicies(name, opts.IstioEnabled)
+	networkPolicies := makeGatewayNetworkPolicies(name, opts.IstioEnabled, opts.ExternalIngestion != nil, opts.PrometheusExporterEnabled)
 
 	for _, np := range networkPolicies {
 		if err := k8sutils.CreateOrUpdateNetworkPolicy(ctx, labelerClient, np); err != nil {
@@ -139,6 +139,14 @@ func (o *OTLPGatewayApplierDeleter) ApplyResources(ctx context.Context, c client
 		return fmt.Errorf("failed to create otlp service: %w", err)
 	}
 
+	if err := o.applyExternalService(ctx, c, labelerClient, opts); err != nil {
+		return err
+	}
+
+	if err := o.applyPrometheusService(ctx, c, labelerClient, opts); err != nil {
+		return err
+	}
+
 	// Create the legacy services for backward compatibility
 	// These services use the old names but point to the new DaemonSet
 	legacyLogService := o.makeLegacyOTLPService(names.OTLPLogsService)
@@ -222,6 +230,16 @@ func (o *OTLPGatewayApplierDeleter) DeleteResources(ctx context.Context, c clien
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete otlp service: %w", err))
 	}
 
+	externalService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPExternalService, Namespace: o.globals.TargetNamespace()}}
+	if err := k8sutils.DeleteObject(ctx, c, &externalService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete external otlp service: %w", err))
+	}
+
+	prometheusService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.MetricPrometheusService, Namespace: o.globals.TargetNamespace()}}
+	if err := k8sutils.DeleteObject(ctx, c, &prometheusService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete prometheus service: %w", err))
+	}
+
 	legacyLogService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPLogsService, Namespace: o.globals.TargetNamespace()}}
 	if err := k8sutils.DeleteObject(ctx, c, &legacyLogService); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete legacy log otlp service: %w", err))
@@ -285,6 +303,52 @@ func (o *OTLPGatewayApplierDeleter) applyVPA(ctx context.Context, c client.Clien
 	return nil
 }
 
+// applyExternalService creates or updates the service for external ingestion, or deletes it if external ingestion is disabled.
+func (o *OTLPGatewayApplierDeleter) applyExternalService(ctx context.Context, c client.Client, labelerClient client.Client, opts GatewayApplyOptions) error {
+	if opts.ExternalIngestion != nil {
+		if err := k8sutils.CreateOrUpdateService(ctx, labelerClient, o.makeExternalService(opts.ExternalIngestion.ServiceType)); err != nil {
+			return fmt.Errorf("failed to create external otlp service: %w", err)
+		}
+
+		return nil
+	}
+
+	externalService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPExternalService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+	}
+	if err := k8sutils.DeleteObject(ctx, c, externalService); err != nil {
+		return fmt.Errorf("failed to delete external otlp service: %w", err)
+	}
+
+	return nil
+}
+
+// applyPrometheusService creates or updates the service exposing the Prometheus exporters of the metric pipelines, or deletes it if no pipeline has a prometheus output.
+func (o *OTLPGatewayApplierDeleter) applyPrometheusService(ctx context.Context, c client.Client, labelerClient client.Client, opts GatewayApplyOptions) error {
+	if opts.PrometheusExporterEnabled {
+		if err := k8sutils.CreateOrUpdateService(ctx, labelerClient, o.makePrometheusService()); err != nil {
+			return fmt.Errorf("failed to create prometheus service: %w", err)
+		}
+
+		return nil
+	}
+
+	prometheusService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.MetricPrometheusService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+	}
+	if err := k8sutils.DeleteObject(ctx, c, prometheusService); err != nil {
+		return fmt.Errorf("failed to delete prometheus service: %w", err)
+	}
+
+	return nil
+}
+
 func (o *OTLPGatewayApplierDeleter) makeDestinationRule(name string) *istionetworkingclientv1.DestinationRule {
 	return &istionetworkingclientv1.DestinationRule{
 		ObjectMeta: metav1.ObjectMeta{
@@ -343,6 +407,61 @@ func (o *OTLPGatewayApplierDeleter) makeOTLPService() *corev1.Service {
 	return service
 }
 
+// makeExternalService creates the service exposing the authenticated OTLP receiver for senders outside the cluster
+func (o *OTLPGatewayApplierDeleter) makeExternalService(serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPExternalService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "grpc-collector",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.OTLPExternalGRPC,
+					TargetPort: intstr.FromInt32(ports.OTLPExternalGRPC),
+				},
+				{
+					Name:       "http-collector",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.OTLPExternalHTTP,
+					TargetPort: intstr.FromInt32(ports.OTLPExternalHTTP),
+				},
+			},
+			Selector: commonresources.DefaultSelector(o.baseName),
+			Type:     serviceType,
+		},
+	}
+}
+
+// makePrometheusService creates a headless service for the Prometheus exporters of the metric pipelines.
+// The metrics of a pipeline are processed by both the OTLP Gateway and the Metric Agent, so the service selects the Pods of both.
+// It is headless, so that a Prometheus server discovers and scrapes every Pod instead of a random one.
+func (o *OTLPGatewayApplierDeleter) makePrometheusService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.MetricPrometheusService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http-prometheus",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.PrometheusExporter,
+					TargetPort: intstr.FromInt32(ports.PrometheusExporter),
+				},
+			},
+			Selector: map[string]string{
+				commonresources.LabelKeyTelemetryMetricExport: commonresources.LabelValueTrue,
+			},
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+		},
+	}
+}
+
 // makeLegacyOTLPService creates a service with a legacy name that points to the unified OTLP Gateway
 func (o *OTLPGatewayApplierDeleter) makeLegacyOTLPService(legacyServiceName string) *corev1.Service {
 	return &corev1.Service{
@@ -403,6 +522,18 @@ func (o *OTLPGatewayApplierDeleter) makeGatewayPodSpec(opts GatewayApplyOptions)
 		commonresources.WithClusterTrustBundleVolume(o.globals.ClusterTrustBundleName()),
 	)
 
+	if opts.ExternalIngestion != nil && opts.ExternalIngestion.MTLSEnabled {
+		volumes, volumeMounts := o.makeExternalIngestionClientCAVolume()
+		podOptions = append(podOptions, commonresources.WithVolumes(volumes))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts(volumeMounts))
+	}
+
+	if len(opts.ServiceAccountTokenAudiences) > 0 {
+		volumes, volumeMounts := makeServiceAccountTokenVolume(opts.ServiceAccountTokenAudiences)
+		podOptions = append(podOptions, commonresources.WithVolumes(volumes))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts(volumeMounts))
+	}
+
 	return makePodSpec(
 		o.baseName,
 		o.image,
@@ -411,6 +542,39 @@ func (o *OTLPGatewayApplierDeleter) makeGatewayPodSpec(opts GatewayApplyOptions)
 	)
 }
 
+// makeExternalIngestionClientCAVolume mounts the client CA for mTLS of external ingestion from the env secret,
+// because the OTLP receiver can only read the client CA from a file
+func (o *OTLPGatewayApplierDeleter) makeExternalIngestionClientCAVolume() ([]corev1.Volume, []corev1.VolumeMount) {
+	const volumeName = "external-ingestion-client-ca"
+
+	volumes := []corev1.Volume{
+		{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: o.baseName,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  common.EnvVarExternalIngestionClientCA,
+							Path: common.ExternalIngestionClientCAFileName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      volumeName,
+			MountPath: common.ExternalIngestionClientCADir,
+			ReadOnly:  true,
+		},
+	}
+
+	return volumes, volumeMounts
+}
+
 func (o *OTLPGatewayApplierDeleter) makeGatewayResourceRequirements(opts GatewayApplyOptions) corev1.ResourceRequirements {
 	memoryRequest := o.baseMemoryRequest.DeepCopy()
 	memoryLimit := o.baseMemoryLimit.DeepCopy()
@@ -493,6 +657,19 @@ type GatewayApplyOptions struct {
 	VpaCRDExists                   bool
 	VpaEnabled                     bool
 	VPAMaxAllowedMemory            resource.Quantity
+	// ExternalIngestion exposes the authenticated OTLP receiver for senders outside the cluster. Nil disables it.
+	ExternalIngestion *ExternalIngestionOptions
+	// PrometheusExporterEnabled exposes the Prometheus exporter of a metric pipeline with a prometheus output.
+	PrometheusExporterEnabled bool
+	// ServiceAccountTokenAudiences are the audiences of the outputs with service account token authentication. A service account token is projected into the gateway pods for each of them.
+	ServiceAccountTokenAudiences []string
+}
+
+type ExternalIngestionOptions struct {
+	// ServiceType is the type of the service exposing the external OTLP receiver, for example, ClusterIP or LoadBalancer.
+	ServiceType corev1.ServiceType
+	// MTLSEnabled indicates whether the client CA for mTLS must be mounted into the gateway pods.
+	MTLSEnabled bool
 }
 
 func makePodAffinity(labels map[string]string) corev1.Affinity {
@@ -522,9 +699,9 @@ func makePodAffinity(labels map[string]string) corev1.Affinity {
 	}
 }
 
-func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled bool) []*networkingv1.NetworkPolicy {
+func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled, externalIngestionEnabled, prometheusExporterEnabled bool) []*networkingv1.NetworkPolicy {
 	var (
-		otlpPorts    = gatewayIngressOTLPPorts()
+		ingressPorts = gatewayIngressPorts(externalIngestionEnabled, prometheusExporterEnabled)
 		metricsPorts = gatewayIngressMetricsPorts(istioEnabled)
 	)
 
@@ -540,21 +717,31 @@ func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled bool) []
 		),
 	)
 
+	// The backends and the proxies of the pipeline outputs are user-defined, so egress is allowed to any destination
 	gatewayNetworkPolicies := commonresources.MakeNetworkPolicy(
 		name,
 		commonresources.DefaultSelector(name.Name),
-		commonresources.WithIngressFromAny(otlpPorts...),
+		commonresources.WithIngressFromAny(ingressPorts...),
 		commonresources.WithEgressToAny(),
 	)
 
 	return []*networkingv1.NetworkPolicy{metricsNetworkPolicy, gatewayNetworkPolicies}
 }
 
-func gatewayIngressOTLPPorts() []int32 {
-	return []int32{
+func gatewayIngressPorts(externalIngestionEnabled, prometheusExporterEnabled bool) []int32 {
+	ingressPorts := []int32{
 		ports.OTLPHTTP,
 		ports.OTLPGRPC,
 	}
+	if externalIngestionEnabled {
+		ingressPorts = append(ingressPorts, ports.OTLPExternalHTTP, ports.OTLPExternalGRPC)
+	}
+
+	if prometheusExporterEnabled {
+		ingressPorts = append(ingressPorts, ports.PrometheusExporter)
+	}
+
+	return ingressPorts
 }
 
 func gatewayIngressMetricsPorts(istioEnabled bool) []int32 {
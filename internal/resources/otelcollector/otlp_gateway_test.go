@@ -56,6 +56,9 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 		vpaMaxAllowedMemory            resource.Quantity
 		goldenFilePath                 string
 		resourceRequirementsMultiplier int
+		externalIngestion              *ExternalIngestionOptions
+		prometheusExporter             bool
+		audiences                      []string
 	}{
 		{
 			name:           "OTLP Gateway",
@@ -98,6 +101,27 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 			vpaMaxAllowedMemory:            resource.MustParse("1Gi"),
 			resourceRequirementsMultiplier: 3,
 		},
+		{
+			name:           "OTLP Gateway with external ingestion",
+			sut:            NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			goldenFilePath: "testdata/otlp-gateway-external-ingestion.yaml",
+			externalIngestion: &ExternalIngestionOptions{
+				ServiceType: corev1.ServiceTypeLoadBalancer,
+				MTLSEnabled: true,
+			},
+		},
+		{
+			name:               "OTLP Gateway with prometheus exporter",
+			sut:                NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			goldenFilePath:     "testdata/otlp-gateway-prometheus-exporter.yaml",
+			prometheusExporter: true,
+		},
+		{
+			name:           "OTLP Gateway with service account tokens",
+			sut:            NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			goldenFilePath: "testdata/otlp-gateway-serviceaccount-token.yaml",
+			audiences:      []string{"https://backend.example.com"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -130,6 +154,9 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 				VpaEnabled:                     tt.vpaEnabled,
 				VPAMaxAllowedMemory:            tt.vpaMaxAllowedMemory,
 				ResourceRequirementsMultiplier: tt.resourceRequirementsMultiplier,
+				ExternalIngestion:              tt.externalIngestion,
+				PrometheusExporterEnabled:      tt.prometheusExporter,
+				ServiceAccountTokenAudiences:   tt.audiences,
 			})
 			require.NoError(t, err)
 
@@ -198,6 +225,9 @@ func TestOTLPGateway_DeleteResources(t *testing.T) {
 				IstioEnabled: tt.istioEnabled,
 				VpaCRDExists: true,
 				VpaEnabled:   true,
+				ExternalIngestion: &ExternalIngestionOptions{
+					ServiceType: corev1.ServiceTypeClusterIP,
+				},
 			})
 			require.NoError(t, err)
 
@@ -0,0 +1,204 @@
+package rollouthistory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	k8sutils "github.com/kyma-project/telemetry-manager/internal/utils/k8s"
+)
+
+const (
+	// ConfigMapDataKey is the key in the ConfigMap data that contains the rollout history
+	ConfigMapDataKey = "history.yaml"
+
+	// MaxRevisions is the number of revisions kept in the rollout history. Older revisions are dropped.
+	MaxRevisions = 10
+)
+
+// Outcome describes the result of rolling out a revision of the collector configuration.
+type Outcome string
+
+const (
+	// OutcomeProgressing indicates that the revision was applied, but the gateway Pods are not ready yet.
+	OutcomeProgressing Outcome = "Progressing"
+	// OutcomeSucceeded indicates that all gateway Pods became ready with the revision.
+	OutcomeSucceeded Outcome = "Succeeded"
+	// OutcomeFailed indicates that the gateway Pods crash-loop with the revision.
+	OutcomeFailed Outcome = "Failed"
+	// OutcomeRolledBack indicates that the revision restores the last known good configuration after a failed revision.
+	OutcomeRolledBack Outcome = "RolledBack"
+)
+
+// History is the bounded list of collector configuration revisions rolled out to the OTLP Gateway.
+type History struct {
+	// LastKnownGoodRevision is the latest revision that was rolled out successfully. It is used as rollback target.
+	LastKnownGoodRevision int64      `yaml:"lastKnownGoodRevision,omitempty"`
+	Revisions             []Revision `yaml:"revisions,omitempty"`
+}
+
+// Revision describes a single rollout of the collector configuration.
+type Revision struct {
+	Revision       int64     `yaml:"revision"`
+	Timestamp      time.Time `yaml:"timestamp"`
+	ConfigChecksum string    `yaml:"configChecksum"`
+	// Pipelines are the pipeline references of the coordination ConfigMap the revision was generated from.
+	Pipelines coordinationconfig.OTLPGatewayConfigMap `yaml:"pipelines"`
+	// ChangedPipelines lists the pipelines whose reference changed compared to the previous revision, for example, "TracePipeline/backend".
+	ChangedPipelines []string `yaml:"changedPipelines,omitempty"`
+	// ChangedEnvVars lists the environment variables whose value changed compared to the previous revision. The values are never recorded.
+	ChangedEnvVars []string `yaml:"changedEnvVars,omitempty"`
+	// Diff is the unified diff of the generated collector configuration compared to the previous revision, with sensitive values redacted.
+	Diff string `yaml:"diff,omitempty"`
+	// RolledBackFrom is the failed revision that was replaced by this revision. Only set for rollback revisions.
+	RolledBackFrom int64   `yaml:"rolledBackFrom,omitempty"`
+	Outcome        Outcome `yaml:"outcome"`
+	Message        string  `yaml:"message,omitempty"`
+}
+
+// Latest returns the most recent revision, or nil if the history is empty.
+func (h *History) Latest() *Revision {
+	if len(h.Revisions) == 0 {
+		return nil
+	}
+
+	return &h.Revisions[len(h.Revisions)-1]
+}
+
+// Find returns the revision with the given number, or nil if it is not part of the history anymore.
+func (h *History) Find(revision int64) *Revision {
+	for i := range h.Revisions {
+		if h.Revisions[i].Revision == revision {
+			return &h.Revisions[i]
+		}
+	}
+
+	return nil
+}
+
+// Append adds a revision with the next revision number to the history and drops the oldest revisions exceeding MaxRevisions.
+// It returns the appended revision.
+func (h *History) Append(revision Revision) *Revision {
+	revision.Revision = 1
+	if latest := h.Latest(); latest != nil {
+		revision.Revision = latest.Revision + 1
+	}
+
+	h.Revisions = append(h.Revisions, revision)
+	if len(h.Revisions) > MaxRevisions {
+		h.Revisions = slices.Clone(h.Revisions[len(h.Revisions)-MaxRevisions:])
+	}
+
+	return h.Latest()
+}
+
+// ReadHistory reads and parses the rollout history ConfigMap.
+// Returns an empty history if the ConfigMap doesn't exist.
+func ReadHistory(ctx context.Context, c client.Client, namespace string) (*History, error) {
+	var cm corev1.ConfigMap
+	if err := c.Get(ctx, types.NamespacedName{Name: names.OTLPGatewayRolloutHistoryConfigMap, Namespace: namespace}, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &History{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get rollout history ConfigMap: %w", err)
+	}
+
+	var history History
+	if err := yaml.Unmarshal([]byte(cm.Data[ConfigMapDataKey]), &history); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rollout history: %w", err)
+	}
+
+	return &history, nil
+}
+
+// WriteHistory creates or updates the rollout history ConfigMap.
+func WriteHistory(ctx context.Context, c client.Client, namespace string, history *History) error {
+	yamlData, err := yaml.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rollout history: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayRolloutHistoryConfigMap,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			ConfigMapDataKey: string(yamlData),
+		},
+	}
+
+	if err := k8sutils.CreateOrUpdateConfigMap(ctx, c, cm); err != nil {
+		return fmt.Errorf("failed to write rollout history ConfigMap: %w", err)
+	}
+
+	return nil
+}
+
+// ChangedPipelines returns the pipelines whose reference differs between the old and the new coordination ConfigMap.
+// A reference differs if the pipeline was added or removed, or if its generation or referenced Secret versions changed.
+func ChangedPipelines(oldRefs, newRefs coordinationconfig.OTLPGatewayConfigMap) []string {
+	oldKeys := referenceKeys(oldRefs)
+	newKeys := referenceKeys(newRefs)
+
+	var changed []string
+
+	for pipeline, key := range newKeys {
+		if oldKeys[pipeline] != key {
+			changed = append(changed, pipeline)
+		}
+	}
+
+	for pipeline := range oldKeys {
+		if _, found := newKeys[pipeline]; !found {
+			changed = append(changed, pipeline)
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
+
+// referenceKeys maps every referenced pipeline to a string that changes whenever the reference changes.
+func referenceKeys(refs coordinationconfig.OTLPGatewayConfigMap) map[string]string {
+	keys := make(map[string]string)
+
+	addPipelineRefs := func(kind string, pipelineRefs []coordinationconfig.PipelineReference) {
+		for _, ref := range pipelineRefs {
+			keys[kind+"/"+ref.Name] = referenceKey(ref.Generation, ref.SecretVersions)
+		}
+	}
+
+	addPipelineRefs("TracePipeline", refs.TracePipelineReferences)
+	addPipelineRefs("LogPipeline", refs.LogPipelineReferences)
+	addPipelineRefs("MetricPipeline", refs.MetricPipelineReferences)
+
+	for _, ref := range refs.TelemetryRouteReferences {
+		keys["TelemetryRoute/"+ref.Namespace+"/"+ref.Name] = referenceKey(ref.Generation, ref.SecretVersions)
+	}
+
+	return keys
+}
+
+func referenceKey(generation int64, secretVersions map[string]string) string {
+	secrets := make([]string, 0, len(secretVersions))
+	for secret, version := range secretVersions {
+		secrets = append(secrets, secret+"@"+version)
+	}
+
+	slices.Sort(secrets)
+
+	return fmt.Sprintf("%d:%v", generation, secrets)
+}
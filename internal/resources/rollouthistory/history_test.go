@@ -0,0 +1,86 @@
+package rollouthistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/telemetry-manager/internal/resources/coordinationconfig"
+)
+
+func TestAppend(t *testing.T) {
+	var history History
+
+	require.Nil(t, history.Latest())
+
+	for range MaxRevisions + 2 {
+		history.Append(Revision{Outcome: OutcomeSucceeded})
+	}
+
+	require.Len(t, history.Revisions, MaxRevisions)
+	require.Equal(t, int64(3), history.Revisions[0].Revision, "the oldest revisions must be dropped")
+	require.Equal(t, int64(MaxRevisions+2), history.Latest().Revision)
+	require.NotNil(t, history.Find(5))
+	require.Nil(t, history.Find(1))
+}
+
+func TestReadWriteHistory(t *testing.T) {
+	ctx := t.Context()
+	fakeClient := fake.NewClientBuilder().Build()
+
+	history, err := ReadHistory(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Empty(t, history.Revisions, "a missing ConfigMap must result in an empty history")
+
+	history.Append(Revision{
+		Timestamp:      metav1.Now().UTC().Truncate(time.Second),
+		ConfigChecksum: "abc",
+		Pipelines: coordinationconfig.OTLPGatewayConfigMap{
+			TracePipelineReferences: []coordinationconfig.PipelineReference{{Name: "backend", Generation: 1}},
+		},
+		Diff:    "--- revision-0\n+++ revision-1\n",
+		Outcome: OutcomeProgressing,
+	})
+	history.LastKnownGoodRevision = 1
+
+	require.NoError(t, WriteHistory(ctx, fakeClient, "kyma-system", history))
+
+	readHistory, err := ReadHistory(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Equal(t, history, readHistory)
+}
+
+func TestChangedPipelines(t *testing.T) {
+	oldRefs := coordinationconfig.OTLPGatewayConfigMap{
+		TracePipelineReferences: []coordinationconfig.PipelineReference{
+			{Name: "unchanged", Generation: 1},
+			{Name: "updated", Generation: 1},
+			{Name: "removed", Generation: 1},
+		},
+		LogPipelineReferences: []coordinationconfig.PipelineReference{
+			{Name: "rotated-secret", Generation: 1, SecretVersions: map[string]string{"default/creds": "1"}},
+		},
+	}
+	newRefs := coordinationconfig.OTLPGatewayConfigMap{
+		TracePipelineReferences: []coordinationconfig.PipelineReference{
+			{Name: "unchanged", Generation: 1},
+			{Name: "updated", Generation: 2},
+		},
+		LogPipelineReferences: []coordinationconfig.PipelineReference{
+			{Name: "rotated-secret", Generation: 1, SecretVersions: map[string]string{"default/creds": "2"}},
+		},
+		TelemetryRouteReferences: []coordinationconfig.TelemetryRouteReference{
+			{Name: "route", Namespace: "team-a", Generation: 1},
+		},
+	}
+
+	require.Equal(t, []string{
+		"LogPipeline/rotated-secret",
+		"TelemetryRoute/team-a/route",
+		"TracePipeline/removed",
+		"TracePipeline/updated",
+	}, ChangedPipelines(oldRefs, newRefs))
+}
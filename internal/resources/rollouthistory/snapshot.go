@@ -0,0 +1,112 @@
+package rollouthistory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/telemetry-manager/internal/configchecksum"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	k8sutils "github.com/kyma-project/telemetry-manager/internal/utils/k8s"
+)
+
+const snapshotKeyPrefix = "revision-"
+
+// Snapshot is the complete collector configuration of a revision, which is needed to roll back to it.
+// Because the environment variables contain credentials, snapshots are stored in a Secret and never in the rollout history.
+type Snapshot struct {
+	CollectorConfigYAML string            `json:"collectorConfig"`
+	CollectorEnvVars    map[string][]byte `json:"collectorEnvVars,omitempty"`
+}
+
+// Checksum returns a checksum of the collector configuration and environment variables of the snapshot.
+func (s Snapshot) Checksum() string {
+	return configchecksum.Calculate(
+		[]corev1.ConfigMap{{Data: map[string]string{"config": s.CollectorConfigYAML}}},
+		[]corev1.Secret{{Data: s.CollectorEnvVars}},
+	)
+}
+
+// ReadSnapshots reads the snapshots Secret and returns the snapshots by revision number.
+// Returns an empty map if the Secret doesn't exist.
+func ReadSnapshots(ctx context.Context, c client.Client, namespace string) (map[int64]Snapshot, error) {
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: names.OTLPGatewayRolloutSnapshotsSecret, Namespace: namespace}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[int64]Snapshot{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get rollout snapshots Secret: %w", err)
+	}
+
+	snapshots := make(map[int64]Snapshot, len(secret.Data))
+
+	for key, data := range secret.Data {
+		revision, err := strconv.ParseInt(strings.TrimPrefix(key, snapshotKeyPrefix), 10, 64)
+		if err != nil {
+			// Ignore keys that were not written by WriteSnapshots
+			continue
+		}
+
+		var snapshot Snapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal snapshot of revision %d: %w", revision, err)
+		}
+
+		snapshots[revision] = snapshot
+	}
+
+	return snapshots, nil
+}
+
+// WriteSnapshots creates or updates the snapshots Secret so that it contains exactly the given snapshots.
+func WriteSnapshots(ctx context.Context, c client.Client, namespace string, snapshots map[int64]Snapshot) error {
+	data := make(map[string][]byte, len(snapshots))
+
+	for revision, snapshot := range snapshots {
+		snapshotJSON, err := json.Marshal(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to marshal snapshot of revision %d: %w", revision, err)
+		}
+
+		data[snapshotKeyPrefix+strconv.FormatInt(revision, 10)] = snapshotJSON
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayRolloutSnapshotsSecret,
+			Namespace: namespace,
+		},
+		Data: data,
+	}
+
+	if err := k8sutils.CreateOrUpdateSecret(ctx, c, secret); err != nil {
+		return fmt.Errorf("failed to write rollout snapshots Secret: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshots deletes the snapshots Secret. It is a no-op if the Secret doesn't exist.
+func DeleteSnapshots(ctx context.Context, c client.Client, namespace string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayRolloutSnapshotsSecret,
+			Namespace: namespace,
+		},
+	}
+
+	if err := c.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete rollout snapshots Secret: %w", err)
+	}
+
+	return nil
+}
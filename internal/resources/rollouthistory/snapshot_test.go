@@ -0,0 +1,43 @@
+package rollouthistory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReadWriteSnapshots(t *testing.T) {
+	ctx := t.Context()
+	fakeClient := fake.NewClientBuilder().Build()
+
+	snapshots, err := ReadSnapshots(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Empty(t, snapshots, "a missing Secret must result in no snapshots")
+
+	snapshots = map[int64]Snapshot{
+		1: {CollectorConfigYAML: "exporters: {}\n", CollectorEnvVars: map[string][]byte{"OTLP_ENDPOINT": []byte("https://backend:4317")}},
+		3: {CollectorConfigYAML: "receivers: {}\n"},
+	}
+
+	require.NoError(t, WriteSnapshots(ctx, fakeClient, "kyma-system", snapshots))
+
+	readSnapshots, err := ReadSnapshots(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Equal(t, snapshots, readSnapshots)
+
+	require.NoError(t, DeleteSnapshots(ctx, fakeClient, "kyma-system"))
+	require.NoError(t, DeleteSnapshots(ctx, fakeClient, "kyma-system"), "deleting a missing Secret must succeed")
+
+	readSnapshots, err = ReadSnapshots(ctx, fakeClient, "kyma-system")
+	require.NoError(t, err)
+	require.Empty(t, readSnapshots)
+}
+
+func TestSnapshotChecksum(t *testing.T) {
+	snapshot := Snapshot{CollectorConfigYAML: "exporters: {}\n", CollectorEnvVars: map[string][]byte{"A": []byte("1")}}
+	changedEnvVar := Snapshot{CollectorConfigYAML: "exporters: {}\n", CollectorEnvVars: map[string][]byte{"A": []byte("2")}}
+
+	require.Equal(t, snapshot.Checksum(), snapshot.Checksum())
+	require.NotEqual(t, snapshot.Checksum(), changedEnvVar.Checksum())
+}
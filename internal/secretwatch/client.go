@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
 )
@@ -44,7 +45,10 @@ type Client struct {
 // - TracePipeline events go to traceEventChan
 // - MetricPipeline events go to metricEventChan
 // - LogPipeline events go to logEventChan
-func NewClient(cfg *rest.Config, traceEventChan, metricEventChan, logEventChan chan<- event.GenericEvent) (*Client, error) {
+// - TelemetryRoute events go to telemetryRouteEventChan
+// - Telemetry events go to otlpGatewayEventChan, because secrets referenced by the Telemetry CR are consumed by the OTLP Gateway,
+// and to telemetryEventChan, because the Telemetry CR reports in its status whether the secrets can be resolved
+func NewClient(cfg *rest.Config, traceEventChan, metricEventChan, logEventChan, telemetryRouteEventChan, otlpGatewayEventChan, telemetryEventChan chan<- event.GenericEvent) (*Client, error) {
 	clientset, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
@@ -60,6 +64,11 @@ func NewClient(cfg *rest.Config, traceEventChan, metricEventChan, logEventChan c
 			metricEventChan <- ev
 		case *telemetryv1beta1.LogPipeline:
 			logEventChan <- ev
+		case *telemetryv1beta1.TelemetryRoute:
+			telemetryRouteEventChan <- ev
+		case *operatorv1beta1.Telemetry:
+			otlpGatewayEventChan <- ev
+			telemetryEventChan <- ev
 		default:
 			logf.Log.Error(nil, "Unknown pipeline type, cannot route event", "pipelineType", fmt.Sprintf("%T", pipeline))
 		}
@@ -115,13 +124,14 @@ func (c *Client) SyncWatchers(ctx context.Context, pipeline client.Object, secre
 	}
 
 	// Remove pipeline from watchers not in the current set
-	c.unlinkPipelineFromWatchers(ctx, pipeline.GetName(), pipeline.GetObjectKind().GroupVersionKind(), secretSet)
+	c.unlinkPipelineFromWatchers(ctx, client.ObjectKeyFromObject(pipeline), pipeline.GetObjectKind().GroupVersionKind(), secretSet)
 
 	return nil
 }
 
-// RemoveFromWatchers removes a pipeline from all watchers by name and GVK.
+// RemoveFromWatchers removes a cluster-scoped pipeline from all watchers by name and GVK.
 // This should be called when a pipeline is deleted to clean up any lingering watchers.
+// Namespaced objects are removed by calling SyncWatchers with an empty set of secrets.
 // Watchers that have no remaining linked pipelines after removal are stopped and deleted.
 // Returns ErrClientStopped if the client has been stopped.
 func (c *Client) RemoveFromWatchers(ctx context.Context, name string, gvk schema.GroupVersionKind) error {
@@ -132,7 +142,7 @@ func (c *Client) RemoveFromWatchers(ctx context.Context, name string, gvk schema
 		return ErrClientStopped
 	}
 
-	c.unlinkPipelineFromWatchers(ctx, name, gvk, nil)
+	c.unlinkPipelineFromWatchers(ctx, types.NamespacedName{Name: name}, gvk, nil)
 
 	return nil
 }
@@ -160,7 +170,7 @@ func (c *Client) startWatcher(ctx context.Context, w *watcher) {
 // unlinkPipelineFromWatchers removes a pipeline from watchers not in the excludeSet.
 // If excludeSet is nil, the pipeline is removed from all watchers.
 // Watchers with no remaining linked pipelines are stopped and deleted.
-func (c *Client) unlinkPipelineFromWatchers(ctx context.Context, name string, gvk schema.GroupVersionKind, excludeSet map[types.NamespacedName]struct{}) {
+func (c *Client) unlinkPipelineFromWatchers(ctx context.Context, key types.NamespacedName, gvk schema.GroupVersionKind, excludeSet map[types.NamespacedName]struct{}) {
 	pipelineKind := gvk.Kind
 
 	for watchedSecret, w := range c.watchers {
@@ -170,11 +180,11 @@ func (c *Client) unlinkPipelineFromWatchers(ctx context.Context, name string, gv
 			}
 		}
 
-		if !w.isLinked(name, gvk) {
+		if !w.isLinked(key, gvk) {
 			continue
 		}
 
-		hasPipelines := w.unlink(name, gvk)
+		hasPipelines := w.unlink(key, gvk)
 		logf.FromContext(ctx).V(1).Info("Unlinked pipeline from watcher",
 			"secret", watchedSecret.String(),
 			"watcherHasRemainingPipelines", hasPipelines)
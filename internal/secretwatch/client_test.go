@@ -370,8 +370,8 @@ func TestSyncWatchersMultipleCalls(t *testing.T) {
 		w, exists := c.watchers[testSecret1]
 		c.mu.RUnlock()
 		require.True(t, exists, "watcher should still exist when one pipeline remains")
-		require.False(t, w.isLinked(pipelineA.Name, pipelineA.GroupVersionKind()), "pipeline-a should not be linked")
-		require.True(t, w.isLinked(pipelineB.Name, pipelineB.GroupVersionKind()), "pipeline-b should still be linked")
+		require.False(t, w.isLinked(client.ObjectKeyFromObject(pipelineA), pipelineA.GroupVersionKind()), "pipeline-a should not be linked")
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(pipelineB), pipelineB.GroupVersionKind()), "pipeline-b should still be linked")
 	})
 
 	t.Run("should switch pipeline from one secret to another", func(t *testing.T) {
@@ -679,7 +679,7 @@ func TestSyncWatchersMultipleCalls(t *testing.T) {
 		w, exists := c.watchers[testSecret1]
 		c.mu.RUnlock()
 		require.True(t, exists)
-		require.True(t, w.isLinked(pipeline.Name, pipeline.GroupVersionKind()))
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(pipeline), pipeline.GroupVersionKind()))
 
 		// Verify only one linked pipeline (not duplicated)
 		linkedPipelines := w.getLinkedPipelines()
@@ -795,6 +795,42 @@ func TestPipelinesWithSameNameButDifferentTypes(t *testing.T) {
 	})
 }
 
+func TestTelemetryRoutesWithSameNameInDifferentNamespaces(t *testing.T) {
+	t.Run("should keep watching for a route when a route with the same name in another namespace is unlinked", func(t *testing.T) {
+		ctx := context.Background()
+		eventChan := make(chan event.GenericEvent, 10)
+
+		c := &Client{
+			clientset:   fake.NewClientset(),
+			watchers:    make(map[types.NamespacedName]*watcher),
+			eventRouter: createTestEventRouter(eventChan),
+		}
+
+		t.Cleanup(func() {
+			c.stopWithTimeout(ctx, testShutdownTimeout)
+		})
+
+		routeA := new(testutils.NewTelemetryRouteBuilder().WithName("my-route").WithNamespace("team-a").Build())
+		routeB := new(testutils.NewTelemetryRouteBuilder().WithName("my-route").WithNamespace("team-b").Build())
+
+		require.NoError(t, c.SyncWatchers(ctx, routeA, []types.NamespacedName{testSecret1}))
+		require.NoError(t, c.SyncWatchers(ctx, routeB, []types.NamespacedName{testSecret1}))
+
+		w := c.watchers[testSecret1]
+		require.NotNil(t, w)
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(routeA), routeA.GetObjectKind().GroupVersionKind()))
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(routeB), routeB.GetObjectKind().GroupVersionKind()))
+
+		// Unlink route in team-a only
+		require.NoError(t, c.SyncWatchers(ctx, routeA, nil))
+
+		w = c.watchers[testSecret1]
+		require.NotNil(t, w, "watcher should still exist for the route in team-b")
+		require.False(t, w.isLinked(client.ObjectKeyFromObject(routeA), routeA.GetObjectKind().GroupVersionKind()))
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(routeB), routeB.GetObjectKind().GroupVersionKind()))
+	})
+}
+
 func TestWatcherErrorHandling(t *testing.T) {
 	t.Run("should handle watch error event and continue", func(t *testing.T) {
 		ctx := context.Background()
@@ -1024,8 +1060,8 @@ func TestRemoveFromWatchers(t *testing.T) {
 		w, exists := c.watchers[testSecret1]
 		c.mu.RUnlock()
 		require.True(t, exists, "watcher should still exist when another pipeline remains")
-		require.False(t, w.isLinked(pipelineA.Name, pipelineA.GroupVersionKind()), "pipeline-a should not be linked")
-		require.True(t, w.isLinked(pipelineB.Name, pipelineB.GroupVersionKind()), "pipeline-b should still be linked")
+		require.False(t, w.isLinked(client.ObjectKeyFromObject(pipelineA), pipelineA.GroupVersionKind()), "pipeline-a should not be linked")
+		require.True(t, w.isLinked(client.ObjectKeyFromObject(pipelineB), pipelineB.GroupVersionKind()), "pipeline-b should still be linked")
 	})
 
 	t.Run("should return error when called after Stop", func(t *testing.T) {
@@ -9,7 +9,8 @@
 //
 // When a watched secret changes, the client routes a GenericEvent to the
 // appropriate pipeline-type channel (trace, metric, or log) to trigger
-// reconciliation via controller-runtime.
+// reconciliation via controller-runtime. Secrets referenced by the Telemetry CR
+// are routed to the OTLP Gateway channel.
 //
 // # Usage
 //
@@ -19,9 +20,10 @@
 //	traceEventChan := make(chan event.GenericEvent)
 //	metricEventChan := make(chan event.GenericEvent)
 //	logEventChan := make(chan event.GenericEvent)
+//	otlpGatewayEventChan := make(chan event.GenericEvent)
 //
 //	// Create the client - events are routed to channels based on pipeline type
-//	client, err := secretwatch.NewClient(cfg, traceEventChan, metricEventChan, logEventChan)
+//	client, err := secretwatch.NewClient(cfg, traceEventChan, metricEventChan, logEventChan, telemetryRouteEventChan, otlpGatewayEventChan, telemetryEventChan)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
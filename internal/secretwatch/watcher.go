@@ -49,11 +49,12 @@ func newWatcher(
 	}
 }
 
-// samePipeline checks if two pipelines are the same by comparing both name and GVK.
+// samePipeline checks if two pipelines are the same by comparing namespace, name and GVK.
 // This is necessary because different pipeline types (LogPipeline, MetricPipeline, TracePipeline)
-// can have the same name but are distinct objects.
+// can have the same name but are distinct objects, and namespaced objects (TelemetryRoute)
+// can have the same name in different namespaces.
 func samePipeline(a, b client.Object) bool {
-	return a.GetName() == b.GetName() &&
+	return client.ObjectKeyFromObject(a) == client.ObjectKeyFromObject(b) &&
 		a.GetObjectKind().GroupVersionKind() == b.GetObjectKind().GroupVersionKind()
 }
 
@@ -74,23 +75,23 @@ func (w *watcher) link(pipeline client.Object) bool {
 	return true
 }
 
-func (w *watcher) isLinked(name string, gvk schema.GroupVersionKind) bool {
+func (w *watcher) isLinked(key types.NamespacedName, gvk schema.GroupVersionKind) bool {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
 	return slices.ContainsFunc(w.linked, func(p client.Object) bool {
-		return p.GetName() == name && p.GetObjectKind().GroupVersionKind() == gvk
+		return client.ObjectKeyFromObject(p) == key && p.GetObjectKind().GroupVersionKind() == gvk
 	})
 }
 
-// unlink removes a pipeline from the watcher's linked pipelines by name and GVK.
+// unlink removes a pipeline from the watcher's linked pipelines by namespaced name and GVK.
 // It returns true if there are still pipelines linked after removal, or false if the list is now empty.
-func (w *watcher) unlink(name string, gvk schema.GroupVersionKind) bool {
+func (w *watcher) unlink(key types.NamespacedName, gvk schema.GroupVersionKind) bool {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	w.linked = slices.DeleteFunc(w.linked, func(p client.Object) bool {
-		return p.GetName() == name && p.GetObjectKind().GroupVersionKind() == gvk
+		return client.ObjectKeyFromObject(p) == key && p.GetObjectKind().GroupVersionKind() == gvk
 	})
 
 	return len(w.linked) > 0
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"slices"
 	"strings"
 	"time"
 )
@@ -100,6 +101,13 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 					Action:       Keep,
 					Regex:        scrapableMetricsRegex(),
 				},
+				// The exporters of open pipeline taps (otlp_http/tap_<signaltype>pipeline-<pipeline_name>) send the data to the manager for diagnostics only,
+				// so their metrics are dropped to not affect the health of the tapped pipeline.
+				{
+					SourceLabels: []string{"__name__", "exporter"},
+					Action:       Drop,
+					Regex:        `otelcol_.+;.+/tap_.+`,
+				},
 				// The following relabel configs add artificial pipeline_name and pipeline_type labels to the Fluent Bit and OTel Collector metrics to simplify pipeline matching.
 				// For Fluent Bit metrics, the pipeline_name is based on the name label. Note that a regex group matching Kubernetes resource names (alphanumerical chars and hyphens) is used to extract the pipeline name.
 				// It allows to filter out timeseries with technical names (storage_backend.0, tail.0, etc.)
@@ -107,6 +115,10 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 				// For OTel Collector metrics, the exporter label has the format [otlp_grpc|otlp_http]/<signaltype>pipeline-<pipeline_name>.
 				// The pipeline_type label captures the <signaltype>pipeline part (e.g. metricpipeline, tracepipeline, logpipeline).
 				// The pipeline_name label captures the bare pipeline name, with the <signaltype>pipeline- prefix stripped.
+				// Exporters of TelemetryRoutes have the format [otlp_grpc|otlp_http]/<signaltype>route-<namespace>.<route_name>, and are labeled with the <signaltype>route
+				// pipeline_type and the <namespace>.<route_name> pipeline_name, so that they are attributed to the route and not to a pipeline of the same name.
+				// Exporters of output routes have the suffix _output-<route_name>, which is stripped as well, so that they are attributed to their pipeline.
+				// Receiver metrics of the pipeline-specific file log receivers of the Log Agent have the receiver label file_log/<pipeline_name>, and are labeled as logpipeline.
 				{
 					SourceLabels: []string{"__name__", "name"},
 					Action:       Replace,
@@ -123,16 +135,29 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 				{
 					SourceLabels: []string{"__name__", "exporter"},
 					Action:       Replace,
-					Regex:        `otelcol_.+;.+/(?:(?:metric|trace|log)pipeline-)?([a-zA-Z0-9-]+)`,
+					Regex:        `otelcol_.+;.+/(?:(?:metric|trace|log)(?:pipeline|route)-)?([a-zA-Z0-9.-]+)(?:_output-[a-zA-Z0-9-]+)?`,
 					TargetLabel:  "pipeline_name",
 				},
 				{
 					SourceLabels: []string{"__name__", "exporter"},
 					Action:       Replace,
-					Regex:        `otelcol_.+;.+/((metric|trace|log)pipeline)-.+`,
+					Regex:        `otelcol_.+;.+/((metric|trace|log)(pipeline|route))-.+`,
 					TargetLabel:  "pipeline_type",
 					Replacement:  "$1",
 				},
+				{
+					SourceLabels: []string{"__name__", "receiver"},
+					Action:       Replace,
+					Regex:        `otelcol_receiver_.+;file_log/([a-zA-Z0-9-]+)`,
+					TargetLabel:  "pipeline_name",
+				},
+				{
+					SourceLabels: []string{"__name__", "receiver"},
+					Action:       Replace,
+					Regex:        `otelcol_receiver_.+;file_log/([a-zA-Z0-9-]+)`,
+					TargetLabel:  "pipeline_type",
+					Replacement:  "logpipeline",
+				},
 			},
 			KubernetesDiscoveryConfigs: []KubernetesDiscoveryConfig{{
 				Role:       RoleEndpoints,
@@ -155,12 +180,22 @@ func scrapableMetricsRegex() string {
 		otelExporterSendFailed,
 		otelExporterEnqueueFailed,
 		otelReceiverRefused,
+		otelReceiverAccepted,
 	}
 
 	for i := range otelCollectorMetrics {
 		otelCollectorMetrics[i] += "_.*"
 	}
 
-	return strings.Join(append(fluentBitMetrics,
-		otelCollectorMetrics...), "|")
+	otelCollectorQueueMetrics := []string{
+		otelExporterQueueSize,
+		otelExporterQueueCapacity,
+	}
+
+	scrapeHealthMetrics := []string{
+		scrapeTargetUp,
+		scrapeTargetSamplesPostMetricRelabeling,
+	}
+
+	return strings.Join(slices.Concat(fluentBitMetrics, otelCollectorMetrics, otelCollectorQueueMetrics, scrapeHealthMetrics), "|")
 }
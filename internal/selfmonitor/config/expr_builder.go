@@ -20,6 +20,13 @@ func selectService(serviceName string) labelSelector {
 	}
 }
 
+// selectServiceAndPipelineType selects the metrics of the given service that belong to pipelines of the given pipeline type.
+func selectServiceAndPipelineType(serviceName, pipelineType string) labelSelector {
+	return func(metric string) string {
+		return fmt.Sprintf("%s{%s=\"%s\",%s=\"%s\"}", metric, labelService, serviceName, labelPipelineType, pipelineType)
+	}
+}
+
 func instant(metric string, selectors ...labelSelector) *exprBuilder {
 	for _, s := range selectors {
 		metric = s(metric)
@@ -44,6 +51,20 @@ func rate(metric string, selectors ...labelSelector) *exprBuilder {
 	return eb
 }
 
+// group wraps an already built expression, for example a combination of expressions by a logical operator, to apply further operations to it.
+func group(expr string) *exprBuilder {
+	return &exprBuilder{
+		expr: expr,
+	}
+}
+
+// ratio divides the numerator by the denominator expression. Both expressions must have matching label sets.
+func ratio(numerator, denominator string) *exprBuilder {
+	return &exprBuilder{
+		expr: fmt.Sprintf("%s / %s", numerator, denominator),
+	}
+}
+
 func (eb *exprBuilder) sumBy(labels ...string) *exprBuilder {
 	eb.expr = fmt.Sprintf("sum by (%s) (%s)", strings.Join(labels, ","), eb.expr)
 	return eb
@@ -54,16 +75,43 @@ func (eb *exprBuilder) maxBy(labels ...string) *exprBuilder {
 	return eb
 }
 
+func (eb *exprBuilder) countBy(labels ...string) *exprBuilder {
+	eb.expr = fmt.Sprintf("count by (%s) (%s)", strings.Join(labels, ","), eb.expr)
+	return eb
+}
+
+// labelJoin joins the values of the source labels with the separator and writes the result to the destination label.
+func (eb *exprBuilder) labelJoin(dst, separator string, src ...string) *exprBuilder {
+	quoted := make([]string, len(src))
+	for i, label := range src {
+		quoted[i] = strconv.Quote(label)
+	}
+
+	eb.expr = fmt.Sprintf("label_join(%s, %s, %s, %s)", eb.expr, strconv.Quote(dst), strconv.Quote(separator), strings.Join(quoted, ", "))
+
+	return eb
+}
+
 func (eb *exprBuilder) greaterThan(value float64) *exprBuilder {
 	eb.expr = fmt.Sprintf("%s > %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
 	return eb
 }
 
+func (eb *exprBuilder) greaterThanOrEqual(value float64) *exprBuilder {
+	eb.expr = fmt.Sprintf("%s >= %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
+	return eb
+}
+
 func (eb *exprBuilder) equal(value float64) *exprBuilder {
 	eb.expr = fmt.Sprintf("%s == %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
 	return eb
 }
 
+func (eb *exprBuilder) multiply(value float64) *exprBuilder {
+	eb.expr = fmt.Sprintf("%s * %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
+	return eb
+}
+
 func (eb *exprBuilder) build() string {
 	return eb.expr
 }
@@ -44,7 +44,7 @@ func (rb fluentBitRuleBuilder) someDataDroppedExpr() string {
 // Checks if the exporter drop rate is greater than 0.
 func (rb fluentBitRuleBuilder) exporterDroppedExpr() string {
 	return rate(fluentBitOutputDroppedRecordsTotal, selectService(names.FluentBitMetricsService)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName).
 		greaterThan(0).
 		build()
 }
@@ -52,7 +52,7 @@ func (rb fluentBitRuleBuilder) exporterDroppedExpr() string {
 // Check if the exporter send rate is greater than 0.
 func (rb fluentBitRuleBuilder) exporterSentExpr() string {
 	return rate(fluentBitOutputProcBytesTotal, selectService(names.FluentBitMetricsService)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName).
 		greaterThan(0).
 		build()
 }
@@ -60,7 +60,7 @@ func (rb fluentBitRuleBuilder) exporterSentExpr() string {
 // Check if the buffer usage is significant.
 func (rb fluentBitRuleBuilder) bufferInUseExpr() string {
 	return instant(fluentBitInputStorageChunksDown, selectService(names.FluentBitMetricsService)).
-		maxBy(labelPipelineName).
+		maxBy(LabelPipelineName).
 		greaterThan(inputStorageChunksDown300Chunks).
 		build()
 }
@@ -68,12 +68,12 @@ func (rb fluentBitRuleBuilder) bufferInUseExpr() string {
 // Checks if logs are read but not sent by the exporter.
 func (rb fluentBitRuleBuilder) noLogsDeliveredExpr() string {
 	receiverReadExpr := rate(fluentBitInputBytesTotal, selectService(names.FluentBitMetricsService)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName).
 		greaterThan(0).
 		build()
 
 	exporterNotSentExpr := rate(fluentBitOutputProcBytesTotal, selectService(names.FluentBitMetricsService)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName).
 		equal(0).
 		build()
 
@@ -12,6 +12,18 @@ const (
 	otelExporterSendFailed    = "otelcol_exporter_send_failed"
 	otelExporterEnqueueFailed = "otelcol_exporter_enqueue_failed"
 	otelReceiverRefused       = "otelcol_receiver_refused"
+	otelReceiverAccepted      = "otelcol_receiver_accepted"
+
+	// following metrics are used without data type suffixes
+	otelExporterQueueSize     = "otelcol_exporter_queue_size"
+	otelExporterQueueCapacity = "otelcol_exporter_queue_capacity"
+
+	// LogAgentCheckpointLagBytes is recorded for the Log Agent. It is the size of the logs in bytes, which are read from the log files and checkpointed,
+	// but not yet delivered. The logs are buffered in the in-memory exporter queue and are lost if the Log Agent restarts.
+	LogAgentCheckpointLagBytes = "kyma_log_agent_checkpoint_lag_bytes"
+
+	// exporterQueueFillThreshold is the ratio of the exporter queue size to the queue capacity above which the queue is considered filling up
+	exporterQueueFillThreshold = 0.8
 )
 
 type otelCollectorRuleBuilder struct {
@@ -35,6 +47,15 @@ func (rb otelCollectorRuleBuilder) agentRules() []Rule {
 	}
 }
 
+// logAgentRules returns the rules that only apply to the Log Agent, which buffers the logs read from the node in a large exporter queue.
+func (rb otelCollectorRuleBuilder) logAgentRules() []Rule {
+	return []Rule{
+		rb.makeRecordingRule(LogAgentCheckpointLagBytes, rb.checkpointLagExpr()),
+		rb.makeRule(RuleNameAgentBufferFillingUp, rb.bufferFillingUpExpr()),
+		rb.makeRule(RuleNameAgentNoLogsDelivered, rb.noLogsDeliveredExpr()),
+	}
+}
+
 // Checks if all data is dropped due to a full buffer or exporter issues, with nothing successfully sent.
 func (rb otelCollectorRuleBuilder) allDataDroppedExpr() string {
 	return unless(
@@ -56,7 +77,7 @@ func (rb otelCollectorRuleBuilder) exporterSentExpr() string {
 	metricName := rb.appendDataType(otelExporterSent)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName, labelPipelineType).
 		greaterThan(0).
 		build()
 }
@@ -66,7 +87,7 @@ func (rb otelCollectorRuleBuilder) exporterDroppedExpr() string {
 	metricName := rb.appendDataType(otelExporterSendFailed)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName, labelPipelineType).
 		greaterThan(0).
 		build()
 }
@@ -76,7 +97,7 @@ func (rb otelCollectorRuleBuilder) exporterEnqueueFailedExpr() string {
 	metricName := rb.appendDataType(otelExporterEnqueueFailed)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(LabelPipelineName, labelPipelineType).
 		greaterThan(0).
 		build()
 }
@@ -91,10 +112,60 @@ func (rb otelCollectorRuleBuilder) throttlingExpr() string {
 		build()
 }
 
+// Check if the exporter queue of a pipeline is filled above the threshold.
+func (rb otelCollectorRuleBuilder) bufferFillingUpExpr() string {
+	return ratio(
+		instant(otelExporterQueueSize, selectService(rb.serviceName)).build(),
+		instant(otelExporterQueueCapacity, selectService(rb.serviceName)).build(),
+	).
+		maxBy(LabelPipelineName).
+		greaterThan(exporterQueueFillThreshold).
+		build()
+}
+
+// Returns the size of the logs per pipeline, which are read and checkpointed by the receiver but still wait in the exporter queue.
+func (rb otelCollectorRuleBuilder) checkpointLagExpr() string {
+	return instant(otelExporterQueueSize, selectService(rb.serviceName)).
+		sumBy(LabelPipelineName).
+		build()
+}
+
+// Checks if logs are read by the receiver but not sent by the exporter, so that the file checkpoints fall behind the written logs.
+// An exporter that never sent any logs has no sent series, so a sent rate of 0 is assumed for every pipeline that reads logs.
+func (rb otelCollectorRuleBuilder) noLogsDeliveredExpr() string {
+	receiverReadExpr := rate(rb.appendDataType(otelReceiverAccepted), selectService(rb.serviceName)).
+		sumBy(LabelPipelineName).
+		greaterThan(0).
+		build()
+
+	exporterSentRateExpr := rate(rb.appendDataType(otelExporterSent), selectService(rb.serviceName)).
+		sumBy(LabelPipelineName).
+		build()
+
+	receiverReadAsZeroExpr := rate(rb.appendDataType(otelReceiverAccepted), selectService(rb.serviceName)).
+		sumBy(LabelPipelineName).
+		multiply(0).
+		build()
+
+	exporterNotSentExpr := group(fmt.Sprintf("(%s)", or(exporterSentRateExpr, receiverReadAsZeroExpr))).
+		equal(0).
+		build()
+
+	return and(receiverReadExpr, exporterNotSentExpr)
+}
+
 func (rb otelCollectorRuleBuilder) appendDataType(baseMetricName string) string {
 	return fmt.Sprintf("%s_%s", baseMetricName, rb.dataType)
 }
 
+// makeRecordingRule records the result of the expression as a new metric, for example to expose a derived value for troubleshooting.
+func (rb otelCollectorRuleBuilder) makeRecordingRule(metricName, expr string) Rule {
+	return Rule{
+		Record: metricName,
+		Expr:   expr,
+	}
+}
+
 func (rb otelCollectorRuleBuilder) makeRule(baseName, expr string) Rule {
 	return Rule{
 		Alert: rb.namePrefix + baseName,
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+)
+
+// GatewayExporterSentQuery returns the query for the total number of items that the OTLP Gateway exporters sent to the backends,
+// per pipeline of the given signal type. The exporters only count the items that are acknowledged by the backend.
+// The result has one sample per pipeline, identified by the LabelPipelineName label.
+func GatewayExporterSentQuery(signalType pipelines.SignalType) (string, error) {
+	var t pipelineType
+
+	switch signalType {
+	case pipelines.SignalTypeTrace:
+		t = typeTracePipeline
+	case pipelines.SignalTypeMetric:
+		t = typeMetricPipeline
+	case pipelines.SignalTypeLog:
+		t = typeLogPipeline
+	default:
+		return "", fmt.Errorf("unsupported signal type: %s", signalType)
+	}
+
+	metricName := fmt.Sprintf("%s_%s", otelExporterSent, ruleDataType(t))
+
+	return instant(metricName, selectServiceAndPipelineType(names.OTLPGatewayMetricsService, pipelineComponentType(t))).
+		sumBy(LabelPipelineName).
+		build(), nil
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+func TestGatewayExporterSentQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		signalType    pipelines.SignalType
+		expectedQuery string
+		expectError   bool
+	}{
+		{
+			name:          "traces",
+			signalType:    pipelines.SignalTypeTrace,
+			expectedQuery: `sum by (pipeline_name) (otelcol_exporter_sent_spans_total{service="telemetry-otlp-gateway-metrics",pipeline_type="tracepipeline"})`,
+		},
+		{
+			name:          "metrics",
+			signalType:    pipelines.SignalTypeMetric,
+			expectedQuery: `sum by (pipeline_name) (otelcol_exporter_sent_metric_points_total{service="telemetry-otlp-gateway-metrics",pipeline_type="metricpipeline"})`,
+		},
+		{
+			name:          "logs",
+			signalType:    pipelines.SignalTypeLog,
+			expectedQuery: `sum by (pipeline_name) (otelcol_exporter_sent_log_records_total{service="telemetry-otlp-gateway-metrics",pipeline_type="logpipeline"})`,
+		},
+		{
+			name:        "fluent bit logs are not exported by the gateway",
+			signalType:  pipelines.SignalTypeLogFluentBit,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := GatewayExporterSentQuery(tt.signalType)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedQuery, query)
+		})
+	}
+}
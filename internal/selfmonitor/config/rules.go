@@ -17,11 +17,20 @@ const (
 	RuleNameGatewaySomeDataDropped = "GatewaySomeDataDropped"
 	RuleNameGatewayThrottling      = "GatewayThrottling"
 
+	// Rule name for the Prometheus scrape targets of the Metric Agent. Note that the actual full name will be prefixed with Metric
+
+	RuleNameAgentScrapeTargetsFailing = "AgentScrapeTargetsFailing"
+
 	// OTel Collector rule names for agents. Note that the actual full names will be prefixed with Log
 
 	RuleNameAgentAllDataDropped  = "AgentAllDataDropped"
 	RuleNameAgentSomeDataDropped = "AgentSomeDataDropped"
 
+	// OTel Collector rule names for the Log Agent. Note that the actual full names will be prefixed with Log
+
+	RuleNameAgentBufferFillingUp = "AgentBufferFillingUp"
+	RuleNameAgentNoLogsDelivered = "AgentNoLogsDelivered"
+
 	// Fluent Bit rule names. Note that the actual full names will be prefixed with Log
 
 	RuleNameLogFluentBitAllDataDropped  = "FluentBitAllDataDropped"
@@ -31,13 +40,23 @@ const (
 
 	// Common rule labels
 
-	labelService      = "service"
-	labelPipelineName = "pipeline_name"
+	labelService = "service"
+	// LabelPipelineName is the label of the OTel Collector metrics and alerts that identifies the pipeline
+	LabelPipelineName = "pipeline_name"
 	labelPipelineType = "pipeline_type"
 
 	// OTel Collector rule labels
 
 	labelReceiver = "receiver"
+
+	// LabelK8sNamespaceName is the label of the scrape health metrics that identifies the namespace of a scrape target
+	LabelK8sNamespaceName = "k8s_namespace_name"
+
+	// LabelK8sWorkloadName is the label of the scrape health metrics that identifies the workload of a scrape target
+	LabelK8sWorkloadName = "k8s_workload_name"
+
+	// LabelScrapeTarget is the label of the scrape targets failing alert that identifies the failing workload in the format <namespace>/<workload>
+	LabelScrapeTarget = "scrape_target"
 )
 
 // RuleGroups is a set of rule groups that are typically exposed in a file.
@@ -51,8 +70,9 @@ type RuleGroup struct {
 	Rules []Rule `yaml:"rules"`
 }
 
-// Rule describes an alerting rule.
+// Rule describes an alerting or a recording rule.
 type Rule struct {
+	Record      string            `yaml:"record,omitempty"`
 	Alert       string            `yaml:"alert,omitempty"`
 	Expr        string            `yaml:"expr"`
 	For         time.Duration     `yaml:"for,omitempty"`
@@ -86,6 +106,9 @@ func MakeRules() RuleGroups {
 	}
 	rules = append(rules, metricAgentRuleBuilder.agentRules()...)
 
+	scrapeHealthRuleBuilder := scrapeHealthRuleBuilder{}
+	rules = append(rules, scrapeHealthRuleBuilder.rules()...)
+
 	// OTLP Gateway - Trace pipelines
 	traceGatewayRuleBuilder := otelCollectorRuleBuilder{
 		dataType:    ruleDataType(typeTracePipeline),
@@ -109,6 +132,7 @@ func MakeRules() RuleGroups {
 	}
 
 	rules = append(rules, logAgentRuleBuilder.agentRules()...)
+	rules = append(rules, logAgentRuleBuilder.logAgentRules()...)
 
 	FluentBitLogRuleBuilder := fluentBitRuleBuilder{}
 	rules = append(rules, FluentBitLogRuleBuilder.rules()...)
@@ -166,6 +190,21 @@ func pipelineComponentType(t pipelineType) string {
 	return ""
 }
 
+// routeComponentType returns the value of the pipeline_type Prometheus label for the TelemetryRoute part that handles the given signal type.
+// It matches the <signaltype>route prefix extracted from OTel exporter component IDs by the selfmonitor relabeling.
+func routeComponentType(t pipelineType) string {
+	switch t {
+	case typeMetricPipeline:
+		return "metricroute"
+	case typeTracePipeline:
+		return "traceroute"
+	case typeLogPipeline:
+		return "logroute"
+	}
+
+	return ""
+}
+
 const (
 	RulesAny = "any"
 )
@@ -188,12 +227,32 @@ func MatchesLogPipelineRule(labelSet map[string]string, unprefixedRuleName strin
 	return matchesRule(labelSet, unprefixedRuleName, pipelineName, typeLogPipeline)
 }
 
+// MatchesTelemetryRouteRule checks if the given alert label set matches the expected rule name (or RulesAny) and route name for a TelemetryRoute
+// of any signal type. The route name has the format <namespace>.<name>.
+// If the alert does not have a pipeline_name label, it should be matched by all routes.
+func MatchesTelemetryRouteRule(labelSet map[string]string, unprefixedRuleName string, routeName string) bool {
+	for _, t := range []pipelineType{typeMetricPipeline, typeTracePipeline, typeLogPipeline} {
+		if !matchesRuleName(labelSet, unprefixedRuleName, t) {
+			continue
+		}
+
+		pipelineNameLabel, hasNameLabel := labelSet[LabelPipelineName]
+		if !hasNameLabel {
+			return true
+		}
+
+		return labelSet[labelPipelineType] == routeComponentType(t) && pipelineNameLabel == routeName
+	}
+
+	return false
+}
+
 func matchesRule(labelSet map[string]string, unprefixedRuleName string, pipelineName string, t pipelineType) bool {
 	if !matchesRuleName(labelSet, unprefixedRuleName, t) {
 		return false
 	}
 
-	pipelineNameLabel, hasNameLabel := labelSet[labelPipelineName]
+	pipelineNameLabel, hasNameLabel := labelSet[LabelPipelineName]
 	if !hasNameLabel {
 		// If the alert does not have a pipeline_name label, it should be matched by all pipelines
 		return true
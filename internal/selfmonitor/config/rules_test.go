@@ -320,6 +320,99 @@ func TestMatchesTracePipelineRule(t *testing.T) {
 	}
 }
 
+func TestMatchesTelemetryRouteRule(t *testing.T) {
+	tests := []struct {
+		name               string
+		labelSet           map[string]string
+		unprefixedRuleName string
+		routeName          string
+		expectedResult     bool
+	}{
+		{
+			name: "rule name matches and route name matches",
+			labelSet: map[string]string{
+				"alertname":     "LogGatewayAllDataDropped",
+				"pipeline_name": "team-a.backend",
+				"pipeline_type": "logroute",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     true,
+		},
+		{
+			name: "rule name of another signal type matches and route name matches",
+			labelSet: map[string]string{
+				"alertname":     "MetricGatewaySomeDataDropped",
+				"pipeline_name": "team-a.backend",
+				"pipeline_type": "metricroute",
+			},
+			unprefixedRuleName: "GatewaySomeDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     true,
+		},
+		{
+			name: "rule name matches and route name does not match",
+			labelSet: map[string]string{
+				"alertname":     "LogGatewayAllDataDropped",
+				"pipeline_name": "team-b.backend",
+				"pipeline_type": "logroute",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     false,
+		},
+		{
+			name: "rule name matches but pipeline_type is for a pipeline",
+			labelSet: map[string]string{
+				"alertname":     "LogGatewayAllDataDropped",
+				"pipeline_name": "team-a.backend",
+				"pipeline_type": "logpipeline",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     false,
+		},
+		{
+			name: "rule name matches but pipeline_type is for a route of a different signal",
+			labelSet: map[string]string{
+				"alertname":     "LogGatewayAllDataDropped",
+				"pipeline_name": "team-a.backend",
+				"pipeline_type": "traceroute",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     false,
+		},
+		{
+			name: "rule name matches and name label is missing",
+			labelSet: map[string]string{
+				"alertname": "TraceGatewayThrottling",
+			},
+			unprefixedRuleName: "GatewayThrottling",
+			routeName:          "team-a.backend",
+			expectedResult:     true,
+		},
+		{
+			name: "rule name does not match",
+			labelSet: map[string]string{
+				"alertname":     "LogFluentBitBufferInUse",
+				"pipeline_name": "team-a.backend",
+				"pipeline_type": "logroute",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			routeName:          "team-a.backend",
+			expectedResult:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := MatchesTelemetryRouteRule(test.labelSet, test.unprefixedRuleName, test.routeName)
+			require.Equal(t, test.expectedResult, result)
+		})
+	}
+}
+
 func TestMatchesOtelLogPipelineRule(t *testing.T) {
 	tests := []struct {
 		name               string
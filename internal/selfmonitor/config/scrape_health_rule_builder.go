@@ -0,0 +1,47 @@
+package config
+
+import (
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metricagent"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+)
+
+const (
+	// Scrape health metrics, exposed by the Metric Agent for the Prometheus scrape targets of the applications
+	scrapeTargetUp                          = "kyma_scrape_target_up"
+	scrapeTargetSamplesPostMetricRelabeling = "kyma_scrape_target_scrape_samples_post_metric_relabeling"
+)
+
+type scrapeHealthRuleBuilder struct {
+}
+
+func (rb scrapeHealthRuleBuilder) rules() []Rule {
+	return []Rule{
+		rb.makeRule(RuleNameAgentScrapeTargetsFailing, rb.scrapeTargetsFailingExpr()),
+	}
+}
+
+// Checks if scrape targets of the Metric Agent cannot be scraped, for example because they are not reachable or exceed the body size limit, or if they hit the sample limit.
+// The failing targets are counted per namespace and workload, and the scrape target label of the alert identifies the workload in the format <namespace>/<workload>.
+// The alert value is used to rank the workloads with the most failing targets.
+func (rb scrapeHealthRuleBuilder) scrapeTargetsFailingExpr() string {
+	scrapeFailedExpr := instant(scrapeTargetUp, selectService(names.MetricAgentScrapeHealthService)).
+		equal(0).
+		build()
+
+	sampleLimitHitExpr := instant(scrapeTargetSamplesPostMetricRelabeling, selectService(names.MetricAgentScrapeHealthService)).
+		greaterThanOrEqual(metricagent.SampleLimit).
+		build()
+
+	return group(or(scrapeFailedExpr, sampleLimitHitExpr)).
+		countBy(LabelK8sNamespaceName, LabelK8sWorkloadName).
+		labelJoin(LabelScrapeTarget, "/", LabelK8sNamespaceName, LabelK8sWorkloadName).
+		build()
+}
+
+func (rb scrapeHealthRuleBuilder) makeRule(baseName, expr string) Rule {
+	return Rule{
+		Alert: ruleNamePrefix(typeMetricPipeline) + baseName,
+		Expr:  expr,
+		For:   alertWaitTime,
+	}
+}
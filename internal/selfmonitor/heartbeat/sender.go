@@ -0,0 +1,419 @@
+package heartbeat
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+)
+
+const (
+	// Interval is the interval in which the heartbeat is sent into the OTLP Gateway.
+	Interval = time.Minute
+
+	// failureThreshold is the number of consecutive heartbeats that were not accepted by the gateway, or not delivered by the exporter of a pipeline,
+	// after which the heartbeat of the pipeline is considered failing.
+	failureThreshold = 3
+
+	requestTimeout = 10 * time.Second
+	serviceName    = "telemetry-heartbeat"
+	heartbeatName  = "heartbeat"
+	metricName     = "telemetry.heartbeat"
+)
+
+// exporterQuerier returns the number of items per pipeline name that the OTLP Gateway exporters of a signal type sent to the backends.
+type exporterQuerier interface {
+	SentItems(ctx context.Context, signalType pipelines.SignalType) (map[string]float64, error)
+}
+
+type pipelineState struct {
+	lastDelivered time.Time
+	// pendingSince is the time of the last heartbeat that was accepted by the gateway, but whose delivery is not evaluated yet.
+	pendingSince time.Time
+	// sentBefore is the number of items that the exporter of the pipeline had sent when the pending heartbeat was accepted.
+	sentBefore  float64
+	notAccepted int
+	undelivered int
+}
+
+// Sender regularly sends synthetic heartbeat telemetry into the OTLP Gateway for every signal type that has at least one active pipeline with enabled heartbeat.
+// The heartbeat is tagged with a dedicated instrumentation scope, so that the gateway forwards it only to the pipelines with enabled heartbeat.
+//
+// A heartbeat is considered delivered by a pipeline if the number of items that the exporter of the pipeline sent to the backend increased
+// until the next heartbeat. The exporter only counts the items that the backend acknowledged, so the heartbeat, or data that was accepted
+// together with it, reached the backend.
+type Sender struct {
+	reader          client.Reader
+	exporterQuerier exporterQuerier
+	httpClient      *http.Client
+	logger          logr.Logger
+	endpoints       map[pipelines.SignalType]string
+	interval        time.Duration
+	now             func() time.Time
+
+	mu     sync.RWMutex
+	states map[pipelines.SignalType]map[string]*pipelineState
+}
+
+type Option func(*Sender)
+
+// WithEndpoints overrides the OTLP/HTTP endpoints of the OTLP Gateway, per signal type.
+func WithEndpoints(endpoints map[pipelines.SignalType]string) Option {
+	return func(s *Sender) {
+		s.endpoints = endpoints
+	}
+}
+
+func NewSender(reader client.Reader, exporterQuerier exporterQuerier, logger logr.Logger, gatewayNamespace string, opts ...Option) *Sender {
+	s := &Sender{
+		reader:          reader,
+		exporterQuerier: exporterQuerier,
+		httpClient:      &http.Client{Timeout: requestTimeout},
+		logger:          logger.WithName("heartbeat-sender"),
+		endpoints: map[pipelines.SignalType]string{
+			pipelines.SignalTypeTrace:  gatewayEndpoint(names.OTLPTracesService, gatewayNamespace, "traces"),
+			pipelines.SignalTypeMetric: gatewayEndpoint(names.OTLPMetricsService, gatewayNamespace, "metrics"),
+			pipelines.SignalTypeLog:    gatewayEndpoint(names.OTLPLogsService, gatewayNamespace, "logs"),
+		},
+		interval: Interval,
+		now:      time.Now,
+		states:   make(map[pipelines.SignalType]map[string]*pipelineState),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func gatewayEndpoint(service, namespace, path string) string {
+	return fmt.Sprintf("http://%s.%s:%d/v1/%s", service, namespace, ports.OTLPHTTP, path)
+}
+
+// Start sends the heartbeat in the configured interval until the context is cancelled.
+func (s *Sender) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.SendAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendAll evaluates the delivery of the previous heartbeats and sends one heartbeat for every signal type that has at least one active pipeline with enabled heartbeat.
+func (s *Sender) SendAll(ctx context.Context) {
+	for _, signalType := range []pipelines.SignalType{pipelines.SignalTypeTrace, pipelines.SignalTypeMetric, pipelines.SignalTypeLog} {
+		pipelineNames, err := s.heartbeatPipelines(ctx, signalType)
+		if err != nil {
+			s.logger.Error(err, "Failed to list pipelines", "signalType", signalType)
+			continue
+		}
+
+		s.retain(signalType, pipelineNames)
+
+		if len(pipelineNames) == 0 {
+			continue
+		}
+
+		// Without the sent items, the delivery can't be evaluated in this round. The pending heartbeats are evaluated in the next round instead.
+		sentItems, err := s.exporterQuerier.SentItems(ctx, signalType)
+		if err != nil {
+			s.logger.V(1).Info("Failed to query the exporter metrics", "signalType", signalType, "error", err.Error())
+			sentItems = nil
+		} else {
+			s.recordDeliveries(signalType, pipelineNames, sentItems)
+		}
+
+		if err := s.send(ctx, signalType); err != nil {
+			s.logger.V(1).Info("Heartbeat not accepted by the OTLP Gateway", "signalType", signalType, "error", err.Error())
+			s.recordNotAccepted(signalType, pipelineNames)
+
+			continue
+		}
+
+		s.recordAccepted(signalType, pipelineNames, sentItems)
+	}
+}
+
+// LastDelivered returns the time of the last heartbeat that the exporter of the given pipeline sent to the backend.
+// It returns the zero time if no heartbeat was delivered yet.
+func (s *Sender) LastDelivered(signalType pipelines.SignalType, pipelineName string) time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if state, ok := s.states[signalType][pipelineName]; ok {
+		return state.lastDelivered
+	}
+
+	return time.Time{}
+}
+
+// IsFailing returns true if the last heartbeats of the given pipeline were not accepted by the OTLP Gateway, or not delivered by the exporter of the pipeline.
+func (s *Sender) IsFailing(signalType pipelines.SignalType, pipelineName string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if state, ok := s.states[signalType][pipelineName]; ok {
+		return state.notAccepted >= failureThreshold || state.undelivered >= failureThreshold
+	}
+
+	return false
+}
+
+// recordDeliveries evaluates the pending heartbeats. A heartbeat is delivered if the exporter of the pipeline sent more items than before the heartbeat.
+func (s *Sender) recordDeliveries(signalType pipelines.SignalType, pipelineNames []string, sentItems map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range pipelineNames {
+		state, ok := s.states[signalType][name]
+		if !ok || state.pendingSince.IsZero() {
+			continue
+		}
+
+		if sentItems[name] > state.sentBefore {
+			state.lastDelivered = state.pendingSince
+			state.undelivered = 0
+		} else {
+			state.undelivered++
+		}
+
+		state.pendingSince = time.Time{}
+	}
+}
+
+// recordAccepted starts the delivery evaluation of the accepted heartbeat for all pipelines, unless the sent items are unknown.
+func (s *Sender) recordAccepted(signalType pipelines.SignalType, pipelineNames []string, sentItems map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	for _, name := range pipelineNames {
+		state := s.stateFor(signalType, name)
+		state.notAccepted = 0
+
+		if sentItems != nil {
+			state.pendingSince = now
+			state.sentBefore = sentItems[name]
+		}
+	}
+}
+
+func (s *Sender) recordNotAccepted(signalType pipelines.SignalType, pipelineNames []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range pipelineNames {
+		s.stateFor(signalType, name).notAccepted++
+	}
+}
+
+func (s *Sender) stateFor(signalType pipelines.SignalType, pipelineName string) *pipelineState {
+	if s.states[signalType] == nil {
+		s.states[signalType] = make(map[string]*pipelineState)
+	}
+
+	state, ok := s.states[signalType][pipelineName]
+	if !ok {
+		state = &pipelineState{}
+		s.states[signalType][pipelineName] = state
+	}
+
+	return state
+}
+
+// retain drops the state of all pipelines of the given signal type that no longer have an enabled heartbeat.
+func (s *Sender) retain(signalType pipelines.SignalType, pipelineNames []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name := range s.states[signalType] {
+		if !slices.Contains(pipelineNames, name) {
+			delete(s.states[signalType], name)
+		}
+	}
+}
+
+// heartbeatPipelines returns the names of all active pipelines of the given signal type with enabled heartbeat.
+func (s *Sender) heartbeatPipelines(ctx context.Context, signalType pipelines.SignalType) ([]string, error) {
+	var pipelineNames []string
+
+	switch signalType {
+	case pipelines.SignalTypeTrace:
+		var list telemetryv1beta1.TracePipelineList
+		if err := s.reader.List(ctx, &list); err != nil {
+			return nil, err
+		}
+
+		for i := range list.Items {
+			p := &list.Items[i]
+			if isActive(p.Spec.Suspend, p.Spec.Heartbeat, p.Status.Conditions) {
+				pipelineNames = append(pipelineNames, p.Name)
+			}
+		}
+	case pipelines.SignalTypeMetric:
+		var list telemetryv1beta1.MetricPipelineList
+		if err := s.reader.List(ctx, &list); err != nil {
+			return nil, err
+		}
+
+		for i := range list.Items {
+			p := &list.Items[i]
+			if isActive(p.Spec.Suspend, p.Spec.Heartbeat, p.Status.Conditions) {
+				pipelineNames = append(pipelineNames, p.Name)
+			}
+		}
+	case pipelines.SignalTypeLog:
+		var list telemetryv1beta1.LogPipelineList
+		if err := s.reader.List(ctx, &list); err != nil {
+			return nil, err
+		}
+
+		for i := range list.Items {
+			p := &list.Items[i]
+			if p.Spec.Output.OTLP != nil && isActive(p.Spec.Suspend, p.Spec.Heartbeat, p.Status.Conditions) {
+				pipelineNames = append(pipelineNames, p.Name)
+			}
+		}
+	}
+
+	return pipelineNames, nil
+}
+
+func isActive(suspend bool, heartbeat *telemetryv1beta1.Heartbeat, pipelineConditions []metav1.Condition) bool {
+	return !suspend && sharedtypesutils.IsHeartbeatEnabled(heartbeat) && meta.IsStatusConditionTrue(pipelineConditions, conditions.TypeConfigurationGenerated)
+}
+
+func (s *Sender) send(ctx context.Context, signalType pipelines.SignalType) error {
+	body, err := s.payload(signalType)
+	if err != nil {
+		return fmt.Errorf("failed to marshal heartbeat: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoints[signalType], bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *Sender) payload(signalType pipelines.SignalType) ([]byte, error) {
+	timestamp := pcommon.NewTimestampFromTime(s.now())
+
+	switch signalType {
+	case pipelines.SignalTypeTrace:
+		return ptraceotlp.NewExportRequestFromTraces(heartbeatTraces(timestamp)).MarshalProto()
+	case pipelines.SignalTypeMetric:
+		return pmetricotlp.NewExportRequestFromMetrics(heartbeatMetrics(timestamp)).MarshalProto()
+	case pipelines.SignalTypeLog:
+		return plogotlp.NewExportRequestFromLogs(heartbeatLogs(timestamp)).MarshalProto()
+	}
+
+	return nil, fmt.Errorf("unsupported signal type: %s", signalType)
+}
+
+func heartbeatTraces(timestamp pcommon.Timestamp) ptrace.Traces {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", serviceName)
+
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName(common.InstrumentationScopeHeartbeat)
+
+	var traceID [16]byte
+
+	var spanID [8]byte
+
+	_, _ = rand.Read(traceID[:])
+	_, _ = rand.Read(spanID[:])
+
+	span := ss.Spans().AppendEmpty()
+	span.SetName(heartbeatName)
+	span.SetTraceID(traceID)
+	span.SetSpanID(spanID)
+	span.SetKind(ptrace.SpanKindInternal)
+	span.SetStartTimestamp(timestamp)
+	span.SetEndTimestamp(timestamp)
+
+	return traces
+}
+
+func heartbeatMetrics(timestamp pcommon.Timestamp) pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", serviceName)
+
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(common.InstrumentationScopeHeartbeat)
+
+	metric := sm.Metrics().AppendEmpty()
+	metric.SetName(metricName)
+
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(timestamp)
+	dp.SetIntValue(1)
+
+	return metrics
+}
+
+func heartbeatLogs(timestamp pcommon.Timestamp) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", serviceName)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(common.InstrumentationScopeHeartbeat)
+
+	record := sl.LogRecords().AppendEmpty()
+	record.SetTimestamp(timestamp)
+	record.SetObservedTimestamp(timestamp)
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.Body().SetStr(heartbeatName)
+
+	return logs
+}
@@ -0,0 +1,328 @@
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+var configGenerated = metav1.Condition{
+	Type:   conditions.TypeConfigurationGenerated,
+	Status: metav1.ConditionTrue,
+	Reason: conditions.ReasonGatewayConfigured,
+}
+
+type fakeGateway struct {
+	mu         sync.Mutex
+	statusCode int
+	requests   map[string][][]byte
+}
+
+func newFakeGateway(t *testing.T, statusCode int) (*fakeGateway, *httptest.Server) {
+	t.Helper()
+
+	gw := &fakeGateway{statusCode: statusCode, requests: make(map[string][][]byte)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+
+		gw.mu.Lock()
+		gw.requests[r.URL.Path] = append(gw.requests[r.URL.Path], body)
+		gw.mu.Unlock()
+
+		w.WriteHeader(gw.statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	return gw, server
+}
+
+type fakeExporterQuerier struct {
+	mu        sync.Mutex
+	sentItems map[pipelines.SignalType]map[string]float64
+	err       error
+}
+
+func (q *fakeExporterQuerier) SentItems(_ context.Context, signalType pipelines.SignalType) (map[string]float64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	return maps.Clone(q.sentItems[signalType]), nil
+}
+
+func (q *fakeExporterQuerier) setSentItems(signalType pipelines.SignalType, pipelineName string, value float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.sentItems == nil {
+		q.sentItems = make(map[pipelines.SignalType]map[string]float64)
+	}
+
+	if q.sentItems[signalType] == nil {
+		q.sentItems[signalType] = make(map[string]float64)
+	}
+
+	q.sentItems[signalType][pipelineName] = value
+}
+
+func newSender(t *testing.T, serverURL string, objs ...client.Object) *Sender {
+	t.Helper()
+
+	return newSenderWithQuerier(t, serverURL, &fakeExporterQuerier{}, objs...)
+}
+
+func newSenderWithQuerier(t *testing.T, serverURL string, querier exporterQuerier, objs ...client.Object) *Sender {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, telemetryv1beta1.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	return NewSender(fakeClient, querier, logr.Discard(), "kyma-system", WithEndpoints(map[pipelines.SignalType]string{
+		pipelines.SignalTypeTrace:  serverURL + "/v1/traces",
+		pipelines.SignalTypeMetric: serverURL + "/v1/metrics",
+		pipelines.SignalTypeLog:    serverURL + "/v1/logs",
+	}))
+}
+
+func TestSendAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		objs          []client.Object
+		expectedPaths []string
+	}{
+		{
+			name: "no pipelines",
+		},
+		{
+			name: "heartbeat disabled",
+			objs: []client.Object{
+				new(testutils.NewTracePipelineBuilder().WithName("trace").WithStatusCondition(configGenerated).Build()),
+			},
+		},
+		{
+			name: "heartbeat enabled for all signal types",
+			objs: []client.Object{
+				new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+				new(testutils.NewMetricPipelineBuilder().WithName("metric").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+				new(testutils.NewLogPipelineBuilder().WithName("log").WithOTLPOutput().WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+			},
+			expectedPaths: []string{"/v1/traces", "/v1/metrics", "/v1/logs"},
+		},
+		{
+			name: "suspended pipeline",
+			objs: []client.Object{
+				new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithSuspend(true).WithStatusCondition(configGenerated).Build()),
+			},
+		},
+		{
+			name: "configuration not generated",
+			objs: []client.Object{
+				new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).Build()),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw, server := newFakeGateway(t, http.StatusOK)
+			sut := newSender(t, server.URL, tt.objs...)
+
+			sut.SendAll(t.Context())
+
+			require.Len(t, gw.requests, len(tt.expectedPaths))
+
+			for _, path := range tt.expectedPaths {
+				require.Len(t, gw.requests[path], 1)
+			}
+
+			for _, signalType := range []pipelines.SignalType{pipelines.SignalTypeTrace, pipelines.SignalTypeMetric, pipelines.SignalTypeLog} {
+				require.False(t, sut.IsFailing(signalType, "trace"))
+			}
+		})
+	}
+}
+
+func TestSendAll_Payload(t *testing.T) {
+	gw, server := newFakeGateway(t, http.StatusOK)
+	sut := newSender(t, server.URL,
+		new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+		new(testutils.NewMetricPipelineBuilder().WithName("metric").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+		new(testutils.NewLogPipelineBuilder().WithName("log").WithOTLPOutput().WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+	)
+
+	sut.SendAll(t.Context())
+
+	traces := ptraceotlp.NewExportRequest()
+	require.NoError(t, traces.UnmarshalProto(gw.requests["/v1/traces"][0]))
+	require.Equal(t, 1, traces.Traces().SpanCount())
+	scopeSpans := traces.Traces().ResourceSpans().At(0).ScopeSpans().At(0)
+	require.Equal(t, common.InstrumentationScopeHeartbeat, scopeSpans.Scope().Name())
+	require.Equal(t, "heartbeat", scopeSpans.Spans().At(0).Name())
+
+	metrics := pmetricotlp.NewExportRequest()
+	require.NoError(t, metrics.UnmarshalProto(gw.requests["/v1/metrics"][0]))
+	require.Equal(t, 1, metrics.Metrics().DataPointCount())
+	scopeMetrics := metrics.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, common.InstrumentationScopeHeartbeat, scopeMetrics.Scope().Name())
+	require.Equal(t, "telemetry.heartbeat", scopeMetrics.Metrics().At(0).Name())
+
+	logs := plogotlp.NewExportRequest()
+	require.NoError(t, logs.UnmarshalProto(gw.requests["/v1/logs"][0]))
+	require.Equal(t, 1, logs.Logs().LogRecordCount())
+	scopeLogs := logs.Logs().ResourceLogs().At(0).ScopeLogs().At(0)
+	require.Equal(t, common.InstrumentationScopeHeartbeat, scopeLogs.Scope().Name())
+	require.Equal(t, "heartbeat", scopeLogs.LogRecords().At(0).Body().Str())
+}
+
+func TestSendAll_NotAccepted(t *testing.T) {
+	gw, server := newFakeGateway(t, http.StatusServiceUnavailable)
+	querier := &fakeExporterQuerier{}
+	sut := newSenderWithQuerier(t, server.URL, querier,
+		new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+	)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut.now = func() time.Time { return now }
+
+	for range failureThreshold - 1 {
+		sut.SendAll(t.Context())
+		require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"))
+	}
+
+	sut.SendAll(t.Context())
+	require.True(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"))
+	require.True(t, sut.LastDelivered(pipelines.SignalTypeTrace, "trace").IsZero())
+
+	gw.mu.Lock()
+	gw.statusCode = http.StatusOK
+	gw.mu.Unlock()
+
+	sut.SendAll(t.Context())
+	require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"))
+	require.True(t, sut.LastDelivered(pipelines.SignalTypeTrace, "trace").IsZero(), "accepted heartbeat must not count as delivered")
+}
+
+func TestSendAll_Delivery(t *testing.T) {
+	_, server := newFakeGateway(t, http.StatusOK)
+	querier := &fakeExporterQuerier{}
+	sut := newSenderWithQuerier(t, server.URL, querier,
+		new(testutils.NewTracePipelineBuilder().WithName("delivering").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+		new(testutils.NewTracePipelineBuilder().WithName("broken").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+	)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	sut.now = func() time.Time { return now }
+
+	querier.setSentItems(pipelines.SignalTypeTrace, "delivering", 10)
+	querier.setSentItems(pipelines.SignalTypeTrace, "broken", 10)
+
+	sut.SendAll(t.Context())
+	require.True(t, sut.LastDelivered(pipelines.SignalTypeTrace, "delivering").IsZero(), "delivery is evaluated in the next round")
+
+	for i := range failureThreshold {
+		now = now.Add(time.Minute)
+
+		querier.setSentItems(pipelines.SignalTypeTrace, "delivering", float64(11+i))
+
+		sut.SendAll(t.Context())
+		require.Equal(t, now.Add(-time.Minute), sut.LastDelivered(pipelines.SignalTypeTrace, "delivering"))
+		require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "delivering"))
+		require.True(t, sut.LastDelivered(pipelines.SignalTypeTrace, "broken").IsZero())
+	}
+
+	require.True(t, sut.IsFailing(pipelines.SignalTypeTrace, "broken"))
+
+	querier.setSentItems(pipelines.SignalTypeTrace, "broken", 11)
+	now = now.Add(time.Minute)
+
+	sut.SendAll(t.Context())
+	require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "broken"))
+	require.Equal(t, now.Add(-time.Minute), sut.LastDelivered(pipelines.SignalTypeTrace, "broken"))
+}
+
+func TestSendAll_QueryFails(t *testing.T) {
+	_, server := newFakeGateway(t, http.StatusOK)
+	querier := &fakeExporterQuerier{err: errors.New("self-monitor unavailable")}
+	sut := newSenderWithQuerier(t, server.URL, querier,
+		new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+	)
+
+	for range failureThreshold {
+		sut.SendAll(t.Context())
+	}
+
+	require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"), "unknown delivery must not be reported as failing")
+	require.True(t, sut.LastDelivered(pipelines.SignalTypeTrace, "trace").IsZero())
+}
+
+func TestSendAll_HeartbeatDisabled(t *testing.T) {
+	pipeline := testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()
+
+	_, server := newFakeGateway(t, http.StatusServiceUnavailable)
+	sut := newSender(t, server.URL, &pipeline)
+
+	for range failureThreshold {
+		sut.SendAll(t.Context())
+	}
+
+	require.True(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"))
+
+	pipeline.Spec.Heartbeat = nil
+	require.NoError(t, sut.reader.(client.Client).Update(t.Context(), &pipeline))
+
+	sut.SendAll(t.Context())
+	require.False(t, sut.IsFailing(pipelines.SignalTypeTrace, "trace"))
+}
+
+func TestStart(t *testing.T) {
+	gw, server := newFakeGateway(t, http.StatusOK)
+	sut := newSender(t, server.URL,
+		new(testutils.NewTracePipelineBuilder().WithName("trace").WithHeartbeat(true).WithStatusCondition(configGenerated).Build()),
+	)
+	sut.interval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	done := make(chan error)
+	go func() { done <- sut.Start(ctx) }()
+
+	require.Eventually(t, func() bool {
+		gw.mu.Lock()
+		defer gw.mu.Unlock()
+
+		return len(gw.requests["/v1/traces"]) >= 2
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -1,8 +1,11 @@
 package prober
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
@@ -42,6 +45,52 @@ func isFiringWithMatcher(alerts []promv1.Alert, ruleName, pipelineName string, m
 	return false
 }
 
+// topFiringLabelValuesWithMatcher returns at most limit unique values of the given label of all firing alerts matching the rule and pipeline name.
+// The values are ordered by descending alert value, so that the values of the most severe alerts come first. A limit of 0 returns all values.
+func topFiringLabelValuesWithMatcher(alerts []promv1.Alert, ruleName, pipelineName, labelName string, limit int, mf matcherFunc) []string {
+	severities := make(map[string]float64)
+
+	for _, alert := range alerts {
+		if alert.State != promv1.AlertStateFiring || !mf(toRawLabels(alert.Labels), ruleName, pipelineName) {
+			continue
+		}
+
+		value := string(alert.Labels[model.LabelName(labelName)])
+		if value == "" {
+			continue
+		}
+
+		// Alerts without a parsable value are ranked last
+		severity, _ := strconv.ParseFloat(alert.Value, 64)
+		if current, found := severities[value]; !found || severity > current {
+			severities[value] = severity
+		}
+	}
+
+	values := make([]string, 0, len(severities))
+	for value := range severities {
+		values = append(values, value)
+	}
+
+	slices.SortFunc(values, func(a, b string) int {
+		if c := cmp.Compare(severities[b], severities[a]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	if limit > 0 && len(values) > limit {
+		values = values[:limit]
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
+
 func toRawLabels(ls model.LabelSet) map[string]string {
 	rawLabels := make(map[string]string, len(ls))
 	for k, v := range ls {
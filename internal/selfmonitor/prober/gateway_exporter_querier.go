@@ -0,0 +1,65 @@
+package prober
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	selfmonitorconfig "github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+)
+
+type instantQuerier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// GatewayExporterQuerier queries the self-monitor for the number of items that the OTLP Gateway exporters sent to the backends.
+type GatewayExporterQuerier struct {
+	querier instantQuerier
+}
+
+func NewGatewayExporterQuerier(selfMonitorName types.NamespacedName) (*GatewayExporterQuerier, error) {
+	promClient, err := newPrometheusClient(selfMonitorName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GatewayExporterQuerier{
+		querier: promClient,
+	}, nil
+}
+
+// SentItems returns the total number of items per pipeline name that the OTLP Gateway exporters of the given signal type sent to the backends.
+// The exporters only count items that are acknowledged by the backend. Pipelines whose exporters never sent anything are missing in the result.
+func (q *GatewayExporterQuerier) SentItems(ctx context.Context, signalType pipelines.SignalType) (map[string]float64, error) {
+	query, err := selfmonitorconfig.GatewayExporterSentQuery(signalType)
+	if err != nil {
+		return nil, err
+	}
+
+	result, warnings, err := q.querier.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		logf.FromContext(ctx).V(1).Info("Prometheus query returned warnings", "query", query, "warnings", warnings)
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Prometheus query result type: %s", result.Type())
+	}
+
+	sentItems := make(map[string]float64, len(vector))
+	for _, sample := range vector {
+		sentItems[string(sample.Metric[selfmonitorconfig.LabelPipelineName])] = float64(sample.Value)
+	}
+
+	return sentItems, nil
+}
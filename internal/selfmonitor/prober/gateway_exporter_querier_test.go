@@ -0,0 +1,87 @@
+package prober
+
+import (
+	"testing"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober/mocks"
+)
+
+func TestGatewayExporterQuerier(t *testing.T) {
+	testCases := []struct {
+		name       string
+		signalType pipelines.SignalType
+		result     model.Value
+		queryErr   error
+		expected   map[string]float64
+		expectErr  bool
+	}{
+		{
+			name:       "query fails",
+			signalType: pipelines.SignalTypeTrace,
+			queryErr:   assert.AnError,
+			expectErr:  true,
+		},
+		{
+			name:       "unexpected result type",
+			signalType: pipelines.SignalTypeTrace,
+			result:     &model.Scalar{Value: 1},
+			expectErr:  true,
+		},
+		{
+			name:       "sent items per pipeline",
+			signalType: pipelines.SignalTypeTrace,
+			result: model.Vector{
+				{Metric: model.Metric{"pipeline_name": "cls"}, Value: 42},
+				{Metric: model.Metric{"pipeline_name": "dynatrace"}, Value: 0},
+			},
+			expected: map[string]float64{
+				"cls":       42,
+				"dynatrace": 0,
+			},
+		},
+		{
+			name:       "no exporter sent anything yet",
+			signalType: pipelines.SignalTypeLog,
+			result:     model.Vector{},
+			expected:   map[string]float64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut, err := NewGatewayExporterQuerier(types.NamespacedName{Name: "test"})
+			require.NoError(t, err)
+
+			querierMock := &mocks.InstantQuerier{}
+			querierMock.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(tc.result, promv1.Warnings(nil), tc.queryErr)
+
+			sut.querier = querierMock
+
+			sentItems, err := sut.SentItems(t.Context(), tc.signalType)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, sentItems)
+			}
+
+			querierMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGatewayExporterQuerier_UnsupportedSignalType(t *testing.T) {
+	sut, err := NewGatewayExporterQuerier(types.NamespacedName{Name: "test"})
+	require.NoError(t, err)
+
+	_, err = sut.SentItems(t.Context(), pipelines.SignalTypeLogFluentBit)
+	require.Error(t, err)
+}
@@ -18,6 +18,16 @@ type OTelAgentProber struct {
 
 type OTelAgentProbeResult struct {
 	PipelineProbeResult
+
+	// FailingScrapeTargets lists the workloads with failing Prometheus scrape targets in the format <namespace>/<workload>, the workloads with the most failing targets first.
+	// The scrape targets are shared by all pipelines with the prometheus input, so the list is not limited to a pipeline.
+	FailingScrapeTargets []string
+
+	// BufferFillingUp is true if the exporter queue of the pipeline is nearly full. Only reported for the Log Agent.
+	BufferFillingUp bool
+
+	// NoLogsDelivered is true if logs are read from the node but not delivered by the pipeline. Only reported for the Log Agent.
+	NoLogsDelivered bool
 }
 
 func NewOTelLogAgentProber(selfMonitorName types.NamespacedName) (*OTelAgentProber, error) {
@@ -44,7 +54,10 @@ func (p *OTelAgentProber) Probe(ctx context.Context, pipelineName string) (OTelA
 
 	allDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentAllDataDropped, pipelineName)
 	someDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentSomeDataDropped, pipelineName)
-	healthy := !allDropped && !someDropped
+	failingScrapeTargets := topFiringLabelValuesWithMatcher(alerts, selfmonitorconfig.RuleNameAgentScrapeTargetsFailing, pipelineName, selfmonitorconfig.LabelScrapeTarget, 0, p.matcher)
+	bufferFillingUp := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentBufferFillingUp, pipelineName)
+	noLogsDelivered := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentNoLogsDelivered, pipelineName)
+	healthy := !allDropped && !someDropped && len(failingScrapeTargets) == 0 && !bufferFillingUp && !noLogsDelivered
 
 	return OTelAgentProbeResult{
 		PipelineProbeResult: PipelineProbeResult{
@@ -52,6 +65,9 @@ func (p *OTelAgentProber) Probe(ctx context.Context, pipelineName string) (OTelA
 			SomeDataDropped: someDropped,
 			Healthy:         healthy,
 		},
+		FailingScrapeTargets: failingScrapeTargets,
+		BufferFillingUp:      bufferFillingUp,
+		NoLogsDelivered:      noLogsDelivered,
 	}, nil
 }
 
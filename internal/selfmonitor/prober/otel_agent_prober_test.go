@@ -206,6 +206,42 @@ func TestOTelLogAgentProber(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "buffer filling up alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogAgentBufferFillingUp",
+							"pipeline_name": "cls",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				BufferFillingUp: true,
+			},
+		},
+		{
+			name:         "no logs delivered alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogAgentNoLogsDelivered",
+							"pipeline_name": "cls",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				NoLogsDelivered: true,
+			},
+		},
 		{
 			name:         "healthy",
 			pipelineName: "cls",
@@ -247,3 +283,84 @@ func TestOTelLogAgentProber(t *testing.T) {
 		})
 	}
 }
+
+func TestOTelMetricAgentProber(t *testing.T) {
+	testCases := []struct {
+		name         string
+		alerts       promv1.AlertsResult
+		pipelineName string
+		expected     OTelAgentProbeResult
+	}{
+		{
+			name:         "scrape targets failing alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":          "MetricAgentScrapeTargetsFailing",
+							"k8s_namespace_name": "shop",
+							"k8s_workload_name":  "checkout",
+							"scrape_target":      "shop/checkout",
+						},
+						State: promv1.AlertStateFiring,
+						Value: "1",
+					},
+					{
+						Labels: model.LabelSet{
+							"alertname":          "MetricAgentScrapeTargetsFailing",
+							"k8s_namespace_name": "shop",
+							"k8s_workload_name":  "cart",
+							"scrape_target":      "shop/cart",
+						},
+						State: promv1.AlertStateFiring,
+						Value: "3",
+					},
+					{
+						Labels: model.LabelSet{
+							"alertname":          "MetricAgentScrapeTargetsFailing",
+							"k8s_namespace_name": "default",
+							"k8s_workload_name":  "backend",
+							"scrape_target":      "default/backend",
+						},
+						State: promv1.AlertStatePending,
+						Value: "5",
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				FailingScrapeTargets: []string{"shop/cart", "shop/checkout"},
+			},
+		},
+		{
+			name:         "healthy",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					Healthy: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut, err := NewOTelMetricAgentProber(types.NamespacedName{Name: "test"})
+			require.NoError(t, err)
+
+			alertGetterMock := &mocks.AlertGetter{}
+			alertGetterMock.On("Alerts", mock.Anything).Return(tc.alerts, nil)
+
+			sut.getter = alertGetterMock
+
+			result, err := sut.Probe(t.Context(), tc.pipelineName)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
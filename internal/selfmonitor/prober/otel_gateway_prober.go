@@ -38,6 +38,12 @@ func NewOTelLogGatewayProber(selfMonitorName types.NamespacedName) (*OTelGateway
 	return newOTelGatewayProber(selfMonitorName, selfmonitorconfig.MatchesLogPipelineRule)
 }
 
+// NewOTelTelemetryRouteGatewayProber creates a prober for the TelemetryRoutes hosted by the OTLP Gateway.
+// The name passed to Probe must have the format <namespace>.<name>.
+func NewOTelTelemetryRouteGatewayProber(selfMonitorName types.NamespacedName) (*OTelGatewayProber, error) {
+	return newOTelGatewayProber(selfMonitorName, selfmonitorconfig.MatchesTelemetryRouteRule)
+}
+
 func newOTelGatewayProber(selfMonitorName types.NamespacedName, matcher matcherFunc) (*OTelGatewayProber, error) {
 	promClient, err := newPrometheusClient(selfMonitorName)
 	if err != nil {
@@ -389,3 +389,121 @@ func TestOTelLogGatewayProber(t *testing.T) {
 		})
 	}
 }
+
+func TestOTelTelemetryRouteGatewayProber(t *testing.T) {
+	testCases := []struct {
+		name      string
+		alerts    promv1.AlertsResult
+		alertsErr error
+		routeName string
+		expected  OTelGatewayProbeResult
+		expectErr bool
+	}{
+		{
+			name:      "alert getter fails",
+			routeName: "team-a.backend",
+			alertsErr: assert.AnError,
+			expectErr: true,
+		},
+		{
+			name:      "no alerts firing",
+			routeName: "team-a.backend",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{},
+			},
+			expected: OTelGatewayProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					Healthy: true,
+				},
+			},
+		},
+		{
+			name:      "all data dropped by the log exporter of the route",
+			routeName: "team-a.backend",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogGatewayAllDataDropped",
+							"pipeline_name": "team-a.backend",
+							"pipeline_type": "logroute",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelGatewayProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					AllDataDropped: true,
+				},
+			},
+		},
+		{
+			name:      "some data dropped by a log pipeline with the same name",
+			routeName: "team-a.backend",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogGatewaySomeDataDropped",
+							"pipeline_name": "team-a.backend",
+							"pipeline_type": "logpipeline",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelGatewayProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					Healthy: true,
+				},
+			},
+		},
+		{
+			name:      "some data dropped by the metric exporter of another route",
+			routeName: "team-a.backend",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "MetricGatewaySomeDataDropped",
+							"pipeline_name": "team-b.backend",
+							"pipeline_type": "metricroute",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelGatewayProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					Healthy: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut, err := NewOTelTelemetryRouteGatewayProber(types.NamespacedName{Name: "test"})
+			require.NoError(t, err)
+
+			alertGetterMock := &mocks.AlertGetter{}
+			if tc.alertsErr != nil {
+				alertGetterMock.On("Alerts", mock.Anything).Return(promv1.AlertsResult{}, tc.alertsErr)
+			} else {
+				alertGetterMock.On("Alerts", mock.Anything).Return(tc.alerts, nil)
+			}
+
+			sut.getter = alertGetterMock
+
+			result, err := sut.Probe(t.Context(), tc.routeName)
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	selfmonitorconfig "github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
 )
 
@@ -29,6 +30,7 @@ const (
 	subscriberMetricPipeline subscriberType = iota
 	subscriberTracePipeline
 	subscriberLogPipeline
+	subscriberTelemetryRoute
 )
 
 func WithMetricPipelineSubscriber(subscriber chan<- event.GenericEvent) Option {
@@ -43,6 +45,10 @@ func WithLogPipelineSubscriber(subscriber chan<- event.GenericEvent) Option {
 	return withSubscriber(subscriber, subscriberLogPipeline)
 }
 
+func WithTelemetryRouteSubscriber(subscriber chan<- event.GenericEvent) Option {
+	return withSubscriber(subscriber, subscriberTelemetryRoute)
+}
+
 func withSubscriber(sub chan<- event.GenericEvent, subType subscriberType) Option {
 	return func(h *Handler) {
 		h.subscribers[subType] = sub
@@ -104,11 +110,13 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	metricPipelineEvents := h.toMetricPipelineReconcileEvents(r.Context(), alerts)
 	tracePipelineEvents := h.toTracePipelineReconcileEvents(r.Context(), alerts)
 	logPipelineEvents := h.toLogPipelineReconcileEvents(r.Context(), alerts)
+	telemetryRouteEvents := h.toTelemetryRouteReconcileEvents(r.Context(), alerts)
 	h.logger.V(1).Info("Webhook called. Notifying the subscribers.",
 		"request", alerts,
 		"metricPipelines", retrieveNames(metricPipelineEvents),
 		"tracePipelines", retrieveNames(tracePipelineEvents),
 		"logPipelines", retrieveNames(logPipelineEvents),
+		"telemetryRoutes", retrieveNames(telemetryRouteEvents),
 	)
 
 	for _, ev := range metricPipelineEvents {
@@ -123,6 +131,10 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.subscribers[subscriberLogPipeline] <- ev
 	}
 
+	for _, ev := range telemetryRouteEvents {
+		h.subscribers[subscriberTelemetryRoute] <- ev
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -186,6 +198,30 @@ func (h *Handler) toLogPipelineReconcileEvents(ctx context.Context, alerts []Ale
 	return events
 }
 
+func (h *Handler) toTelemetryRouteReconcileEvents(ctx context.Context, alerts []Alert) []event.GenericEvent {
+	var events []event.GenericEvent
+
+	if _, ok := h.subscribers[subscriberTelemetryRoute]; !ok {
+		return events
+	}
+
+	var telemetryRoutes telemetryv1beta1.TelemetryRouteList
+	if err := h.c.List(ctx, &telemetryRoutes); err != nil {
+		return events
+	}
+
+	for i := range telemetryRoutes.Items {
+		routeName := pipelines.TelemetryRouteName(&telemetryRoutes.Items[i])
+		for _, alert := range alerts {
+			if selfmonitorconfig.MatchesTelemetryRouteRule(alert.Labels, selfmonitorconfig.RulesAny, routeName) {
+				events = append(events, event.GenericEvent{Object: &telemetryRoutes.Items[i]})
+			}
+		}
+	}
+
+	return events
+}
+
 func retrieveNames(events []event.GenericEvent) []string {
 	var names []string
 	for _, ev := range events {
@@ -75,6 +75,7 @@ func TestHandler(t *testing.T) {
 		metricPipelinesToReconcile []string
 		tracePipelinesToReconcile  []string
 		logPipelinesToReconcile    []string
+		telemetryRoutesToReconcile []string
 	}{
 		{
 			name:          "alert matches metric pipeline with same name",
@@ -157,6 +158,27 @@ func TestHandler(t *testing.T) {
 			expectedStatus:          http.StatusOK,
 			logPipelinesToReconcile: []string{"cls", "dynatrace"},
 		},
+		{
+			name:          "alert matches telemetry route with same namespace and name",
+			requestMethod: http.MethodPost,
+			requestBody:   bytes.NewBuffer([]byte(`[{"labels":{"alertname":"LogGatewayAllDataDropped","pipeline_name":"team-a.backend","pipeline_type":"logroute"}}]`)),
+			resources: []client.Object{
+				new(testutils.NewTelemetryRouteBuilder().WithNamespace("team-a").WithName("backend").Build()),
+				new(testutils.NewTelemetryRouteBuilder().WithNamespace("team-b").WithName("backend").Build()),
+				new(testutils.NewLogPipelineBuilder().WithName("backend").Build()),
+			},
+			expectedStatus:             http.StatusOK,
+			telemetryRoutesToReconcile: []string{"backend"},
+		},
+		{
+			name:          "alert of a pipeline does not match telemetry route",
+			requestMethod: http.MethodPost,
+			requestBody:   bytes.NewBuffer([]byte(`[{"labels":{"alertname":"LogGatewayAllDataDropped","pipeline_name":"team-a.backend","pipeline_type":"logpipeline"}}]`)),
+			resources: []client.Object{
+				new(testutils.NewTelemetryRouteBuilder().WithNamespace("team-a").WithName("backend").Build()),
+			},
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "invalid method",
 			requestMethod:  http.MethodGet,
@@ -182,6 +204,7 @@ func TestHandler(t *testing.T) {
 			metricPipelineEvents := make(chan event.GenericEvent, 1024)
 			tracePipelineEvents := make(chan event.GenericEvent, 1024)
 			logPipelineEvents := make(chan event.GenericEvent, 1024)
+			telemetryRouteEvents := make(chan event.GenericEvent, 1024)
 
 			noopLogger := logr.New(logf.NullLogSink{})
 
@@ -193,6 +216,7 @@ func TestHandler(t *testing.T) {
 				WithMetricPipelineSubscriber(metricPipelineEvents),
 				WithTracePipelineSubscriber(tracePipelineEvents),
 				WithLogPipelineSubscriber(logPipelineEvents),
+				WithTelemetryRouteSubscriber(telemetryRouteEvents),
 				WithLogger(noopLogger))
 
 			req, err := http.NewRequestWithContext(t.Context(), tc.requestMethod, "/", tc.requestBody)
@@ -224,6 +248,13 @@ func TestHandler(t *testing.T) {
 				require.Empty(t, logPipelineEvents)
 			}
 
+			if tc.telemetryRoutesToReconcile != nil {
+				require.NotEmpty(t, telemetryRouteEvents)
+				require.ElementsMatch(t, tc.telemetryRoutesToReconcile, readAllNamesFromChannel(telemetryRouteEvents))
+			} else {
+				require.Empty(t, telemetryRouteEvents)
+			}
+
 			require.Equal(t, rr.Header().Get("Content-Security-Policy"), "default-src 'self'")
 		})
 	}
@@ -0,0 +1,133 @@
+package tap
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The split functions decode an OTLP protobuf export request and split it into records, each being an OTLP JSON export request with a single span,
+// log record, or metric. The resource and scope of the record are kept, so that every record can be understood on its own.
+
+func splitTraces(body []byte) ([][]byte, error) {
+	var unmarshaler ptrace.ProtoUnmarshaler
+
+	traces, err := unmarshaler.UnmarshalTraces(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		marshaler ptrace.JSONMarshaler
+		records   [][]byte
+	)
+
+	for i := range traces.ResourceSpans().Len() {
+		rs := traces.ResourceSpans().At(i)
+		for j := range rs.ScopeSpans().Len() {
+			ss := rs.ScopeSpans().At(j)
+			for k := range ss.Spans().Len() {
+				record := ptrace.NewTraces()
+				recordRS := record.ResourceSpans().AppendEmpty()
+				rs.Resource().CopyTo(recordRS.Resource())
+				recordRS.SetSchemaUrl(rs.SchemaUrl())
+
+				recordSS := recordRS.ScopeSpans().AppendEmpty()
+				ss.Scope().CopyTo(recordSS.Scope())
+				recordSS.SetSchemaUrl(ss.SchemaUrl())
+				ss.Spans().At(k).CopyTo(recordSS.Spans().AppendEmpty())
+
+				b, err := marshaler.MarshalTraces(record)
+				if err != nil {
+					return nil, err
+				}
+
+				records = append(records, b)
+			}
+		}
+	}
+
+	return records, nil
+}
+
+func splitLogs(body []byte) ([][]byte, error) {
+	var unmarshaler plog.ProtoUnmarshaler
+
+	logs, err := unmarshaler.UnmarshalLogs(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		marshaler plog.JSONMarshaler
+		records   [][]byte
+	)
+
+	for i := range logs.ResourceLogs().Len() {
+		rl := logs.ResourceLogs().At(i)
+		for j := range rl.ScopeLogs().Len() {
+			sl := rl.ScopeLogs().At(j)
+			for k := range sl.LogRecords().Len() {
+				record := plog.NewLogs()
+				recordRL := record.ResourceLogs().AppendEmpty()
+				rl.Resource().CopyTo(recordRL.Resource())
+				recordRL.SetSchemaUrl(rl.SchemaUrl())
+
+				recordSL := recordRL.ScopeLogs().AppendEmpty()
+				sl.Scope().CopyTo(recordSL.Scope())
+				recordSL.SetSchemaUrl(sl.SchemaUrl())
+				sl.LogRecords().At(k).CopyTo(recordSL.LogRecords().AppendEmpty())
+
+				b, err := marshaler.MarshalLogs(record)
+				if err != nil {
+					return nil, err
+				}
+
+				records = append(records, b)
+			}
+		}
+	}
+
+	return records, nil
+}
+
+func splitMetrics(body []byte) ([][]byte, error) {
+	var unmarshaler pmetric.ProtoUnmarshaler
+
+	metrics, err := unmarshaler.UnmarshalMetrics(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		marshaler pmetric.JSONMarshaler
+		records   [][]byte
+	)
+
+	for i := range metrics.ResourceMetrics().Len() {
+		rm := metrics.ResourceMetrics().At(i)
+		for j := range rm.ScopeMetrics().Len() {
+			sm := rm.ScopeMetrics().At(j)
+			for k := range sm.Metrics().Len() {
+				record := pmetric.NewMetrics()
+				recordRM := record.ResourceMetrics().AppendEmpty()
+				rm.Resource().CopyTo(recordRM.Resource())
+				recordRM.SetSchemaUrl(rm.SchemaUrl())
+
+				recordSM := recordRM.ScopeMetrics().AppendEmpty()
+				sm.Scope().CopyTo(recordSM.Scope())
+				recordSM.SetSchemaUrl(sm.SchemaUrl())
+				sm.Metrics().At(k).CopyTo(recordSM.Metrics().AppendEmpty())
+
+				b, err := marshaler.MarshalMetrics(record)
+				if err != nil {
+					return nil, err
+				}
+
+				records = append(records, b)
+			}
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,199 @@
+package tap
+
+import (
+	"cmp"
+	"crypto/rand"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+)
+
+const (
+	// StreamPath is the path prefix of the endpoint that users call to open a tap and stream the tapped records.
+	StreamPath = "/api/v1/taps/"
+	// SinkPath is the path prefix of the endpoint that receives the tapped data from the OTLP Gateway.
+	SinkPath = "/api/v1/tap-sink/"
+)
+
+var ErrPipelineTapped = errors.New("pipeline is already tapped")
+
+// Session is an open tap. It buffers the records received from the OTLP Gateway until they are streamed to the user.
+type Session struct {
+	id  string
+	tap Tap
+	// captured is the number of records handed over to the session
+	captured int
+	records  chan []byte
+}
+
+// Records returns the channel of the tapped records, each being a single span, log record, or metric encoded as OTLP JSON.
+func (s *Session) Records() <-chan []byte {
+	return s.records
+}
+
+// Registry keeps track of the open sessions. Opening or closing a session notifies the subscriber,
+// typically the OTLP Gateway controller, so that the tap is attached to or removed from the gateway configuration.
+type Registry struct {
+	sinkURL    string
+	subscriber chan<- event.GenericEvent
+	trigger    client.Object
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+type Option = func(*Registry)
+
+// WithOTLPGatewaySubscriber sets the channel that is notified whenever the open taps change.
+func WithOTLPGatewaySubscriber(subscriber chan<- event.GenericEvent, gatewayNamespace string) Option {
+	return func(r *Registry) {
+		r.subscriber = subscriber
+		r.trigger = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      names.OTLPGatewayCoordinationConfigMap,
+				Namespace: gatewayNamespace,
+			},
+		}
+	}
+}
+
+// NewRegistry creates a new Registry. The sinkURL is the base URL under which the OTLP Gateway reaches the sink endpoint of the manager.
+func NewRegistry(sinkURL string, opts ...Option) *Registry {
+	r := &Registry{
+		sinkURL:  strings.TrimSuffix(sinkURL, "/"),
+		sessions: make(map[string]*Session),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Open opens a session that taps the given stage of a pipeline. The gateway sends the given ratio of the records passing the stage,
+// and the session captures limit records before it closes itself. Only one session per pipeline can be open at a time,
+// otherwise ErrPipelineTapped is returned.
+func (r *Registry) Open(signalType pipelines.SignalType, pipelineName string, stage Stage, limit int, samplingRatio float64) (*Session, error) {
+	r.mu.Lock()
+
+	for _, s := range r.sessions {
+		if s.tap.SignalType == signalType && s.tap.PipelineName == pipelineName {
+			r.mu.Unlock()
+			return nil, ErrPipelineTapped
+		}
+	}
+
+	id := strings.ToLower(rand.Text())
+	s := &Session{
+		id: id,
+		tap: Tap{
+			SignalType:    signalType,
+			PipelineName:  pipelineName,
+			Stage:         stage,
+			SamplingRatio: samplingRatio,
+			Endpoint:      r.sinkURL + SinkPath + id,
+		},
+		records: make(chan []byte, limit),
+	}
+	r.sessions[id] = s
+
+	r.mu.Unlock()
+
+	r.notify()
+
+	return s, nil
+}
+
+// Close closes the session, so that the tap is removed from the gateway configuration. Closing a session that is already closed has no effect.
+func (r *Registry) Close(s *Session) {
+	r.mu.Lock()
+
+	if _, found := r.sessions[s.id]; !found {
+		r.mu.Unlock()
+		return
+	}
+
+	delete(r.sessions, s.id)
+
+	r.mu.Unlock()
+
+	r.notify()
+}
+
+// Taps returns the taps of all open sessions, sorted by signal type and pipeline name.
+func (r *Registry) Taps() []Tap {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	taps := make([]Tap, 0, len(r.sessions))
+	for _, s := range r.sessions {
+		taps = append(taps, s.tap)
+	}
+
+	slices.SortFunc(taps, func(a, b Tap) int {
+		return cmp.Or(strings.Compare(string(a.SignalType), string(b.SignalType)), strings.Compare(a.PipelineName, b.PipelineName))
+	})
+
+	return taps
+}
+
+// isOpen returns true if a session with the given ID and signal type is open.
+func (r *Registry) isOpen(id string, signalType pipelines.SignalType) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, found := r.sessions[id]
+
+	return found && s.tap.SignalType == signalType
+}
+
+// deliver hands the records over to the session with the given ID. Once the session has captured as many records as it buffers,
+// it is closed, so that the tap is removed from the gateway configuration while the buffered records are still streamed.
+// Records received after that are dropped, as are the records of sessions that are not open.
+func (r *Registry) deliver(id string, signalType pipelines.SignalType, records [][]byte) {
+	r.mu.Lock()
+
+	s, found := r.sessions[id]
+	if !found || s.tap.SignalType != signalType {
+		r.mu.Unlock()
+		return
+	}
+
+	for _, record := range records {
+		if s.captured == cap(s.records) {
+			break
+		}
+
+		s.records <- record
+		s.captured++
+	}
+
+	completed := s.captured == cap(s.records)
+	if completed {
+		delete(r.sessions, id)
+	}
+
+	r.mu.Unlock()
+
+	if completed {
+		r.notify()
+	}
+}
+
+func (r *Registry) notify() {
+	if r.subscriber == nil {
+		return
+	}
+
+	r.subscriber <- event.GenericEvent{Object: r.trigger}
+}
@@ -0,0 +1,125 @@
+package tap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+)
+
+func TestRegistry(t *testing.T) {
+	subscriber := make(chan event.GenericEvent, 10)
+	sut := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444/", WithOTLPGatewaySubscriber(subscriber, "kyma-system"))
+
+	require.Empty(t, sut.Taps())
+
+	traceSession, err := sut.Open(pipelines.SignalTypeTrace, "my-pipeline", StageInput, 5, 0.5)
+	require.NoError(t, err)
+
+	logSession, err := sut.Open(pipelines.SignalTypeLog, "my-pipeline", StageExport, 5, 1)
+	require.NoError(t, err)
+
+	// Only one session per pipeline can be open at a time
+	_, err = sut.Open(pipelines.SignalTypeTrace, "my-pipeline", StageExport, 5, 1)
+	require.ErrorIs(t, err, ErrPipelineTapped)
+
+	require.Equal(t, []Tap{
+		{
+			SignalType:    pipelines.SignalTypeLog,
+			PipelineName:  "my-pipeline",
+			Stage:         StageExport,
+			SamplingRatio: 1,
+			Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/" + logSession.id,
+		},
+		{
+			SignalType:    pipelines.SignalTypeTrace,
+			PipelineName:  "my-pipeline",
+			Stage:         StageInput,
+			SamplingRatio: 0.5,
+			Endpoint:      "https://telemetry-manager-webhook.kyma-system.svc:9444/api/v1/tap-sink/" + traceSession.id,
+		},
+	}, sut.Taps())
+
+	sut.Close(traceSession)
+	// Closing a session twice has no effect
+	sut.Close(traceSession)
+	sut.Close(logSession)
+
+	require.Empty(t, sut.Taps())
+
+	// Every open and close notifies the subscriber to reconcile the OTLP Gateway
+	require.Len(t, subscriber, 4)
+
+	e := <-subscriber
+	require.Equal(t, names.OTLPGatewayCoordinationConfigMap, e.Object.GetName())
+	require.Equal(t, "kyma-system", e.Object.GetNamespace())
+}
+
+func TestRegistryDeliver(t *testing.T) {
+	subscriber := make(chan event.GenericEvent, 10)
+	sut := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444", WithOTLPGatewaySubscriber(subscriber, "kyma-system"))
+
+	session, err := sut.Open(pipelines.SignalTypeTrace, "my-pipeline", StageExport, 3, 1)
+	require.NoError(t, err)
+	<-subscriber
+
+	require.True(t, sut.isOpen(session.id, pipelines.SignalTypeTrace))
+	require.False(t, sut.isOpen(session.id, pipelines.SignalTypeLog))
+
+	sut.deliver(session.id, pipelines.SignalTypeTrace, [][]byte{[]byte("1"), []byte("2")})
+	require.Len(t, session.Records(), 2)
+	require.NotEmpty(t, sut.Taps())
+
+	// Data of another signal type is not delivered to the session
+	sut.deliver(session.id, pipelines.SignalTypeLog, [][]byte{[]byte("log")})
+	require.Len(t, session.Records(), 2)
+	require.Empty(t, subscriber)
+
+	// Once the limit is captured, the session closes itself and the records exceeding the limit are dropped
+	sut.deliver(session.id, pipelines.SignalTypeTrace, [][]byte{[]byte("3"), []byte("4")})
+	require.Len(t, session.Records(), 3)
+	require.Empty(t, sut.Taps())
+	require.False(t, sut.isOpen(session.id, pipelines.SignalTypeTrace))
+	require.Len(t, subscriber, 1)
+
+	sut.deliver(session.id, pipelines.SignalTypeTrace, [][]byte{[]byte("5")})
+	require.Len(t, session.Records(), 3)
+
+	require.Equal(t, []byte("1"), <-session.Records())
+	require.Equal(t, []byte("2"), <-session.Records())
+	require.Equal(t, []byte("3"), <-session.Records())
+
+	// Closing the session after it closed itself does not notify the subscriber again
+	sut.Close(session)
+	require.Len(t, subscriber, 1)
+}
+
+func TestParseStage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Stage
+		wantErr  bool
+	}{
+		{input: "", expected: StageExport},
+		{input: "input", expected: StageInput},
+		{input: "transform", expected: StageTransform},
+		{input: "export", expected: StageExport},
+		{input: "output", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			stage, err := ParseStage(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, stage)
+		})
+	}
+}
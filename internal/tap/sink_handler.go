@@ -0,0 +1,220 @@
+package tap
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+const (
+	maxSinkRequestBytes = 8 << 20 // 8 MB
+
+	// authenticationCacheTTL is the time for which a successfully reviewed token is accepted without reviewing it again.
+	// The OTLP Gateway sends tapped data continuously, so reviewing every request would put load on the API server.
+	authenticationCacheTTL = 1 * time.Minute
+)
+
+// SinkHandler receives the tapped data, which the OTLP Gateway exports as gzip-compressed OTLP/HTTP protobuf, and hands it over to the open sessions.
+// Only the OTLP Gateway is allowed to send data. It authenticates with a service account token for the SinkAudience.
+// Data of sessions that are not open (anymore) is discarded without decoding it, because the gateway keeps sending it until
+// the tap is removed from its configuration.
+type SinkHandler struct {
+	c        client.Client
+	registry *Registry
+	// username is the user of the service account of the OTLP Gateway
+	username string
+	logger   logr.Logger
+	now      func() time.Time
+
+	mu sync.Mutex
+	// authenticated maps the hashes of successfully reviewed tokens to the time at which they have to be reviewed again
+	authenticated map[[sha256.Size]byte]time.Time
+}
+
+func NewSinkHandler(c client.Client, registry *Registry, gatewayServiceAccount types.NamespacedName, logger logr.Logger) *SinkHandler {
+	return &SinkHandler{
+		c:             c,
+		registry:      registry,
+		username:      fmt.Sprintf("system:serviceaccount:%s:%s", gatewayServiceAccount.Namespace, gatewayServiceAccount.Name),
+		logger:        logger,
+		now:           time.Now,
+		authenticated: make(map[[sha256.Size]byte]time.Time),
+	}
+}
+
+func (h *SinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if status, err := h.authenticate(r.Context(), r); err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	// The OTLP/HTTP exporter appends the signal-specific path to the endpoint of the session: <SinkPath><session ID>/v1/<signal>
+	id, signalPath, found := strings.Cut(strings.TrimPrefix(r.URL.Path, SinkPath), "/")
+	if !found || id == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var (
+		signalType pipelines.SignalType
+		split      func([]byte) ([][]byte, error)
+	)
+
+	switch signalPath {
+	case "v1/traces":
+		signalType, split = pipelines.SignalTypeTrace, splitTraces
+	case "v1/logs":
+		signalType, split = pipelines.SignalTypeLog, splitLogs
+	case "v1/metrics":
+		signalType, split = pipelines.SignalTypeMetric, splitMetrics
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	defer r.Body.Close()
+
+	if !h.registry.isOpen(id, signalType) {
+		writeExportResponse(w)
+		return
+	}
+
+	body, err := readBody(w, r)
+	if err != nil {
+		h.logger.Error(err, "Failed to read tapped data")
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	records, err := split(body)
+	if err != nil {
+		h.logger.Error(err, "Failed to decode tapped data")
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	h.registry.deliver(id, signalType, records)
+
+	writeExportResponse(w)
+}
+
+// writeExportResponse writes an empty export response, which tells the exporter that all data has been accepted.
+func writeExportResponse(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+}
+
+// readBody reads the body of the request, decompressing it if the gateway sends it gzip-compressed.
+// Both the compressed and the decompressed body are limited to maxSinkRequestBytes.
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	var body io.Reader = http.MaxBytesReader(w, r.Body, maxSinkRequestBytes)
+
+	switch encoding := r.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case "gzip":
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+
+		body = gz
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(body, maxSinkRequestBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) > maxSinkRequestBytes {
+		return nil, fmt.Errorf("decompressed body exceeds %d bytes", maxSinkRequestBytes)
+	}
+
+	return b, nil
+}
+
+// authenticate checks with a TokenReview that the bearer token of the request is a token of the OTLP Gateway service account for the SinkAudience.
+// It returns the HTTP status code to respond with if the caller is not authenticated.
+func (h *SinkHandler) authenticate(ctx context.Context, r *http.Request) (int, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		return http.StatusUnauthorized, errors.New("missing bearer token")
+	}
+
+	tokenHash := sha256.Sum256([]byte(token))
+
+	if h.isAuthenticated(tokenHash) {
+		return http.StatusOK, nil
+	}
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{SinkAudience},
+		},
+	}
+	if err := h.c.Create(ctx, tokenReview); err != nil {
+		h.logger.Error(err, "Failed to review token")
+		return http.StatusInternalServerError, errors.New("failed to authenticate")
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return http.StatusUnauthorized, errors.New("invalid bearer token")
+	}
+
+	if tokenReview.Status.User.Username != h.username {
+		return http.StatusForbidden, fmt.Errorf("user %q is not allowed to send tapped data", tokenReview.Status.User.Username)
+	}
+
+	h.markAuthenticated(tokenHash)
+
+	return http.StatusOK, nil
+}
+
+func (h *SinkHandler) isAuthenticated(tokenHash [sha256.Size]byte) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	expiry, found := h.authenticated[tokenHash]
+
+	return found && h.now().Before(expiry)
+}
+
+func (h *SinkHandler) markAuthenticated(tokenHash [sha256.Size]byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+
+	// The tokens are rotated regularly, so expired entries are removed to keep the cache small
+	for hash, expiry := range h.authenticated {
+		if !now.Before(expiry) {
+			delete(h.authenticated, hash)
+		}
+	}
+
+	h.authenticated[tokenHash] = now.Add(authenticationCacheTTL)
+}
@@ -0,0 +1,314 @@
+package tap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+func TestSinkHandler(t *testing.T) {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Spans().AppendEmpty().SetName("span-1")
+	ss.Spans().AppendEmpty().SetName("span-2")
+
+	var traceMarshaler ptrace.ProtoMarshaler
+
+	tracesProto, err := traceMarshaler.MarshalTraces(traces)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	lrs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStr("log-1")
+	lrs.AppendEmpty().Body().SetStr("log-2")
+	lrs.AppendEmpty().Body().SetStr("log-3")
+
+	var logMarshaler plog.ProtoMarshaler
+
+	logsProto, err := logMarshaler.MarshalLogs(logs)
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric-1")
+
+	var metricMarshaler pmetric.ProtoMarshaler
+
+	metricsProto, err := metricMarshaler.MarshalMetrics(metrics)
+	require.NoError(t, err)
+
+	// The paths refer to the sessions with the placeholders {trace}, {log}, and {metric}
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		token           string
+		body            []byte
+		contentEncoding string
+		expectedStatus  int
+		expectedRecords int
+	}{
+		{
+			name:            "traces are split into spans",
+			method:          http.MethodPost,
+			path:            "{trace}/v1/traces",
+			token:           "gateway",
+			body:            tracesProto,
+			expectedStatus:  http.StatusOK,
+			expectedRecords: 2,
+		},
+		{
+			name:            "logs are split into log records",
+			method:          http.MethodPost,
+			path:            "{log}/v1/logs",
+			token:           "gateway",
+			body:            logsProto,
+			expectedStatus:  http.StatusOK,
+			expectedRecords: 3,
+		},
+		{
+			name:            "metrics are split into metrics",
+			method:          http.MethodPost,
+			path:            "{metric}/v1/metrics",
+			token:           "gateway",
+			body:            metricsProto,
+			expectedStatus:  http.StatusOK,
+			expectedRecords: 1,
+		},
+		{
+			name:            "gzip-compressed data is decompressed",
+			method:          http.MethodPost,
+			path:            "{trace}/v1/traces",
+			token:           "gateway",
+			body:            gzipped(t, tracesProto),
+			contentEncoding: "gzip",
+			expectedStatus:  http.StatusOK,
+			expectedRecords: 2,
+		},
+		{
+			name:           "data of closed session is discarded",
+			method:         http.MethodPost,
+			path:           "closed/v1/traces",
+			token:          "gateway",
+			body:           []byte("not proto"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodGet,
+			path:           "{trace}/v1/traces",
+			token:          "gateway",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "missing token",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/traces",
+			body:           tracesProto,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid token",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/traces",
+			token:          "invalid",
+			body:           tracesProto,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "token of other service account",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/traces",
+			token:          "other",
+			body:           tracesProto,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "signal does not match session",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/logs",
+			token:          "gateway",
+			body:           logsProto,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing session",
+			method:         http.MethodPost,
+			path:           "v1/traces",
+			token:          "gateway",
+			body:           tracesProto,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "unknown signal",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/profiles",
+			token:          "gateway",
+			body:           tracesProto,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:            "unsupported content encoding",
+			method:          http.MethodPost,
+			path:            "{trace}/v1/traces",
+			token:           "gateway",
+			body:            tracesProto,
+			contentEncoding: "zstd",
+			expectedStatus:  http.StatusBadRequest,
+		},
+		{
+			name:           "invalid body",
+			method:         http.MethodPost,
+			path:           "{trace}/v1/traces",
+			token:          "gateway",
+			body:           []byte("not proto"),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444")
+			traceSession, err := registry.Open(pipelines.SignalTypeTrace, "my-pipeline", StageExport, 10, 1)
+			require.NoError(t, err)
+			logSession, err := registry.Open(pipelines.SignalTypeLog, "my-pipeline", StageExport, 10, 1)
+			require.NoError(t, err)
+			metricSession, err := registry.Open(pipelines.SignalTypeMetric, "my-pipeline", StageExport, 10, 1)
+			require.NoError(t, err)
+
+			path := strings.NewReplacer("{trace}", traceSession.id, "{log}", logSession.id, "{metric}", metricSession.id).Replace(tt.path)
+
+			sut := NewSinkHandler(newSinkFakeClient(nil), registry, gatewayServiceAccount, logr.Discard())
+
+			req := httptest.NewRequest(tt.method, SinkPath+path, bytes.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+
+			rr := httptest.NewRecorder()
+			sut.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedRecords, len(traceSession.Records())+len(logSession.Records())+len(metricSession.Records()))
+		})
+	}
+
+	t.Run("record is encoded as JSON and keeps resource and scope", func(t *testing.T) {
+		records, err := splitTraces(tracesProto)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+
+		var unmarshaler ptrace.JSONUnmarshaler
+
+		record, err := unmarshaler.UnmarshalTraces(records[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, record.SpanCount())
+
+		serviceName, found := record.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+		require.True(t, found)
+		require.Equal(t, "checkout", serviceName.Str())
+		require.Equal(t, "span-2", record.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	})
+
+	t.Run("reviewed token is cached", func(t *testing.T) {
+		var reviews int
+
+		sut := NewSinkHandler(newSinkFakeClient(&reviews), NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444"), gatewayServiceAccount, logr.Discard())
+
+		now := time.Now()
+		sut.now = func() time.Time { return now }
+
+		send := func() int {
+			req := httptest.NewRequest(http.MethodPost, SinkPath+"closed/v1/traces", bytes.NewReader(tracesProto))
+			req.Header.Set("Authorization", "Bearer gateway")
+
+			rr := httptest.NewRecorder()
+			sut.ServeHTTP(rr, req)
+
+			return rr.Code
+		}
+
+		require.Equal(t, http.StatusOK, send())
+		require.Equal(t, http.StatusOK, send())
+		require.Equal(t, 1, reviews)
+
+		// The token is reviewed again once the cache entry expires
+		now = now.Add(authenticationCacheTTL)
+
+		require.Equal(t, http.StatusOK, send())
+		require.Equal(t, 2, reviews)
+	})
+}
+
+func gzipped(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+var gatewayServiceAccount = types.NamespacedName{Name: "telemetry-otlp-gateway", Namespace: "kyma-system"}
+
+// newSinkFakeClient returns a client, which authenticates the token "gateway" as the service account of the OTLP Gateway, and the token "other"
+// as another service account, if the SinkAudience is requested. It counts the reviewed tokens in reviews, if given.
+func newSinkFakeClient(reviews *int) client.Client {
+	return fake.NewClientBuilder().
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				review, ok := obj.(*authenticationv1.TokenReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+
+				if reviews != nil {
+					*reviews++
+				}
+
+				if !slices.Equal(review.Spec.Audiences, []string{SinkAudience}) {
+					return nil
+				}
+
+				switch review.Spec.Token {
+				case "gateway":
+					review.Status.Authenticated = true
+					review.Status.User.Username = "system:serviceaccount:kyma-system:telemetry-otlp-gateway"
+				case "other":
+					review.Status.Authenticated = true
+					review.Status.User.Username = "system:serviceaccount:default:other"
+				}
+
+				return nil
+			},
+		}).
+		Build()
+}
@@ -0,0 +1,89 @@
+package tap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+)
+
+const (
+	sinkReadHeaderTimeout = 10 * time.Second
+	sinkShutdownTimeout   = 10 * time.Second
+)
+
+// SinkServer serves the SinkHandler on its own TLS listener, so that the tapped data neither competes with the webhook requests
+// of the API server nor has to be admitted to the port of the webhook server. It uses the serving certificate of the webhook server,
+// which also covers the Service name under which the OTLP Gateway reaches the sink.
+type SinkServer struct {
+	handler http.Handler
+	port    int
+	certDir string
+	logger  logr.Logger
+}
+
+func NewSinkServer(handler http.Handler, port int, certDir string, logger logr.Logger) *SinkServer {
+	return &SinkServer{
+		handler: handler,
+		port:    port,
+		certDir: certDir,
+		logger:  logger,
+	}
+}
+
+// Start serves the sink until the context is canceled. The serving certificate is reloaded whenever it is rotated.
+func (s *SinkServer) Start(ctx context.Context) error {
+	certWatcher, err := certwatcher.New(filepath.Join(s.certDir, "tls.crt"), filepath.Join(s.certDir, "tls.key"))
+	if err != nil {
+		return fmt.Errorf("failed to load serving certificate of the tap sink: %w", err)
+	}
+
+	go func() {
+		if err := certWatcher.Start(ctx); err != nil {
+			s.logger.Error(err, "Failed to watch serving certificate of the tap sink")
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle(SinkPath, s.handler)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.port),
+		Handler:           mux,
+		ReadHeaderTimeout: sinkReadHeaderTimeout,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certWatcher.GetCertificate,
+		},
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), sinkShutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.logger.Error(err, "Failed to shut down tap sink server")
+		}
+	}()
+
+	s.logger.Info("Starting tap sink server", "port", s.port)
+
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// NeedLeaderElection returns false, because the sink serves the sessions that are opened at the same manager instance.
+func (s *SinkServer) NeedLeaderElection() bool {
+	return false
+}
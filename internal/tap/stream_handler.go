@@ -0,0 +1,294 @@
+package tap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+)
+
+const (
+	defaultLimit         = 10
+	maxLimit             = 1000
+	defaultTimeout       = 1 * time.Minute
+	maxTimeout           = 10 * time.Minute
+	defaultSamplingRatio = 1.0
+
+	// tapSubresource is the subresource of the pipeline resources that a user must be allowed to create to open a tap
+	tapSubresource = "tap"
+)
+
+// StreamHandler serves the endpoint that users call to tap a pipeline: GET <StreamPath><resource>/<name>?stage=<stage>&limit=<n>&timeout=<duration>&sampling=<ratio>
+// The caller is authenticated with the bearer token of the request, and must be allowed to create the tap subresource of the pipeline.
+// The pipeline must have the tap enabled, and only one tap per pipeline can be open at a time. The handler opens a session, which attaches
+// the tap to the gateway configuration, and streams up to limit records, sampled by the gateway with the given ratio, as newline-delimited JSON.
+// The session is closed, and the tap removed from the gateway configuration, once the limit or the timeout is reached, or the caller disconnects.
+type StreamHandler struct {
+	c        client.Client
+	registry *Registry
+	logger   logr.Logger
+}
+
+func NewStreamHandler(c client.Client, registry *Registry, logger logr.Logger) *StreamHandler {
+	return &StreamHandler{
+		c:        c,
+		registry: registry,
+		logger:   logger,
+	}
+}
+
+type streamRequest struct {
+	resource     string
+	pipelineName string
+	stage        Stage
+	limit        int
+	timeout      time.Duration
+	// samplingRatio is the ratio of the records passing the stage that are streamed, between 0 (exclusive) and 1 (inclusive)
+	samplingRatio float64
+}
+
+func (h *StreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := parseStreamRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if status, err := h.authorize(r.Context(), r, req); err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	signalType, status, err := h.signalTypeOf(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	session, err := h.registry.Open(signalType, req.pipelineName, req.stage, req.limit, req.samplingRatio)
+	if errors.Is(err, ErrPipelineTapped) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	defer h.registry.Close(session)
+
+	h.logger.Info("Tap opened", "resource", req.resource, "pipeline", req.pipelineName, "stage", req.stage, "limit", req.limit, "sampling", req.samplingRatio)
+
+	h.stream(r.Context(), w, session, req)
+
+	h.logger.Info("Tap closed", "resource", req.resource, "pipeline", req.pipelineName)
+}
+
+func (h *StreamHandler) stream(ctx context.Context, w http.ResponseWriter, session *Session, req streamRequest) {
+	ctx, cancel := context.WithTimeout(ctx, req.timeout)
+	defer cancel()
+
+	flusher, _ := w.(http.Flusher)
+	flush := func() {
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	for written := 0; written < req.limit; written++ {
+		select {
+		case <-ctx.Done():
+			return
+		case record := <-session.Records():
+			if _, err := w.Write(append(record, '\n')); err != nil {
+				return
+			}
+
+			flush()
+		}
+	}
+}
+
+// authorize authenticates the caller with a TokenReview and checks with a SubjectAccessReview whether the caller is allowed to tap the pipeline.
+// It returns the HTTP status code to respond with if the caller is not authorized.
+func (h *StreamHandler) authorize(ctx context.Context, r *http.Request, req streamRequest) (int, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		return http.StatusUnauthorized, errors.New("missing bearer token")
+	}
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := h.c.Create(ctx, tokenReview); err != nil {
+		h.logger.Error(err, "Failed to review token")
+		return http.StatusInternalServerError, errors.New("failed to authenticate")
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return http.StatusUnauthorized, errors.New("invalid bearer token")
+	}
+
+	user := tokenReview.Status.User
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	accessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:       telemetryv1beta1.GroupVersion.Group,
+				Resource:    req.resource,
+				Subresource: tapSubresource,
+				Name:        req.pipelineName,
+				Verb:        "create",
+			},
+		},
+	}
+	if err := h.c.Create(ctx, accessReview); err != nil {
+		h.logger.Error(err, "Failed to review access")
+		return http.StatusInternalServerError, errors.New("failed to authorize")
+	}
+
+	if !accessReview.Status.Allowed {
+		return http.StatusForbidden, fmt.Errorf("user %q cannot create %s/%s for %q", user.Username, req.resource, tapSubresource, req.pipelineName)
+	}
+
+	return http.StatusOK, nil
+}
+
+// signalTypeOf checks that the pipeline exists, is processed by the OTLP Gateway, and has the tap enabled, and returns its signal type.
+// It returns the HTTP status code to respond with if the pipeline cannot be tapped.
+func (h *StreamHandler) signalTypeOf(ctx context.Context, req streamRequest) (pipelines.SignalType, int, error) {
+	key := types.NamespacedName{Name: req.pipelineName}
+
+	var (
+		obj        client.Object
+		signalType pipelines.SignalType
+	)
+
+	switch req.resource {
+	case "tracepipelines":
+		obj, signalType = &telemetryv1beta1.TracePipeline{}, pipelines.SignalTypeTrace
+	case "metricpipelines":
+		obj, signalType = &telemetryv1beta1.MetricPipeline{}, pipelines.SignalTypeMetric
+	case "logpipelines":
+		obj, signalType = &telemetryv1beta1.LogPipeline{}, pipelines.SignalTypeLog
+	}
+
+	if err := h.c.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", http.StatusNotFound, fmt.Errorf("%s %q not found", req.resource, req.pipelineName)
+		}
+
+		h.logger.Error(err, "Failed to get pipeline")
+
+		return "", http.StatusInternalServerError, errors.New("failed to get pipeline")
+	}
+
+	var (
+		suspended  bool
+		tapEnabled bool
+	)
+
+	switch p := obj.(type) {
+	case *telemetryv1beta1.TracePipeline:
+		suspended, tapEnabled = p.Spec.Suspend, sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+	case *telemetryv1beta1.MetricPipeline:
+		suspended, tapEnabled = p.Spec.Suspend, sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+	case *telemetryv1beta1.LogPipeline:
+		if p.Spec.Output.OTLP == nil {
+			return "", http.StatusBadRequest, errors.New("only log pipelines with an OTLP output can be tapped")
+		}
+
+		suspended, tapEnabled = p.Spec.Suspend, sharedtypesutils.IsTapEnabled(p.Spec.Tap)
+	}
+
+	if suspended {
+		return "", http.StatusBadRequest, errors.New("suspended pipelines cannot be tapped")
+	}
+
+	if !tapEnabled {
+		return "", http.StatusBadRequest, errors.New("the tap of the pipeline is not enabled, set spec.tap.enabled to true")
+	}
+
+	return signalType, http.StatusOK, nil
+}
+
+func parseStreamRequest(r *http.Request) (streamRequest, error) {
+	resource, name, found := strings.Cut(strings.TrimPrefix(r.URL.Path, StreamPath), "/")
+	if !found || name == "" || strings.Contains(name, "/") {
+		return streamRequest{}, fmt.Errorf("path must be %s<resource>/<name>", StreamPath)
+	}
+
+	switch resource {
+	case "tracepipelines", "metricpipelines", "logpipelines":
+	default:
+		return streamRequest{}, fmt.Errorf("unknown resource %q, must be one of tracepipelines, metricpipelines, logpipelines", resource)
+	}
+
+	query := r.URL.Query()
+
+	stage, err := ParseStage(query.Get("stage"))
+	if err != nil {
+		return streamRequest{}, err
+	}
+
+	limit := defaultLimit
+	if s := query.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return streamRequest{}, fmt.Errorf("limit must be a number between 1 and %d", maxLimit)
+		}
+	}
+
+	timeout := defaultTimeout
+	if s := query.Get("timeout"); s != "" {
+		timeout, err = time.ParseDuration(s)
+		if err != nil || timeout <= 0 || timeout > maxTimeout {
+			return streamRequest{}, fmt.Errorf("timeout must be a positive duration of at most %s", maxTimeout)
+		}
+	}
+
+	samplingRatio := defaultSamplingRatio
+	if s := query.Get("sampling"); s != "" {
+		samplingRatio, err = strconv.ParseFloat(s, 64)
+		if err != nil || samplingRatio <= 0 || samplingRatio > 1 {
+			return streamRequest{}, errors.New("sampling must be a ratio greater than 0 and at most 1")
+		}
+	}
+
+	return streamRequest{
+		resource:      resource,
+		pipelineName:  name,
+		stage:         stage,
+		limit:         limit,
+		timeout:       timeout,
+		samplingRatio: samplingRatio,
+	}, nil
+}
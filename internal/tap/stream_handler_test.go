@@ -0,0 +1,270 @@
+package tap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+// newFakeClient returns a client, which authenticates the tokens "allowed" and "forbidden", and allows the user "allowed" to tap pipelines.
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = telemetryv1beta1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				switch review := obj.(type) {
+				case *authenticationv1.TokenReview:
+					if review.Spec.Token == "allowed" || review.Spec.Token == "forbidden" {
+						review.Status.Authenticated = true
+						review.Status.User.Username = review.Spec.Token
+					}
+				case *authorizationv1.SubjectAccessReview:
+					attrs := review.Spec.ResourceAttributes
+					review.Status.Allowed = review.Spec.User == "allowed" &&
+						attrs.Group == telemetryv1beta1.GroupVersion.Group &&
+						attrs.Subresource == "tap" &&
+						attrs.Verb == "create"
+				default:
+					return c.Create(ctx, obj, opts...)
+				}
+
+				return nil
+			},
+		}).
+		Build()
+}
+
+func TestStreamHandlerRejects(t *testing.T) {
+	tracePipeline := testutils.NewTracePipelineBuilder().WithName("traces").WithTap(true).Build()
+	untappablePipeline := testutils.NewTracePipelineBuilder().WithName("untappable").Build()
+	suspendedPipeline := testutils.NewTracePipelineBuilder().WithName("suspended").WithTap(true).WithSuspend(true).Build()
+	fluentBitPipeline := testutils.NewLogPipelineBuilder().WithName("fluent-bit").WithTap(true).Build()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "invalid method",
+			method:         http.MethodPost,
+			path:           "tracepipelines/traces",
+			token:          "allowed",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unknown resource",
+			path:           "telemetryroutes/traces",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing pipeline name",
+			path:           "tracepipelines/",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown stage",
+			path:           "tracepipelines/traces?stage=output",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "limit too high",
+			path:           "tracepipelines/traces?limit=1001",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "timeout too long",
+			path:           "tracepipelines/traces?timeout=1h",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "sampling ratio zero",
+			path:           "tracepipelines/traces?sampling=0",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "sampling ratio too high",
+			path:           "tracepipelines/traces?sampling=1.5",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing token",
+			path:           "tracepipelines/traces",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid token",
+			path:           "tracepipelines/traces",
+			token:          "invalid",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "user not allowed to tap",
+			path:           "tracepipelines/traces",
+			token:          "forbidden",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "pipeline not found",
+			path:           "metricpipelines/traces",
+			token:          "allowed",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "suspended pipeline",
+			path:           "tracepipelines/suspended",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "tap not enabled",
+			path:           "tracepipelines/untappable",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "log pipeline without OTLP output",
+			path:           "logpipelines/fluent-bit",
+			token:          "allowed",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444")
+			sut := NewStreamHandler(newFakeClient(&tracePipeline, &untappablePipeline, &suspendedPipeline, &fluentBitPipeline), registry, logr.Discard())
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req := httptest.NewRequest(method, StreamPath+tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			rr := httptest.NewRecorder()
+			sut.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Empty(t, registry.Taps())
+		})
+	}
+}
+
+func TestStreamHandlerStreamsRecords(t *testing.T) {
+	logPipeline := testutils.NewLogPipelineBuilder().WithName("logs").WithTap(true).WithOTLPOutput().Build()
+
+	registry := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444")
+	sut := NewStreamHandler(newFakeClient(&logPipeline), registry, logr.Discard())
+
+	req := httptest.NewRequest(http.MethodGet, StreamPath+"logpipelines/logs?stage=transform&limit=2&sampling=0.5", nil)
+	req.Header.Set("Authorization", "Bearer allowed")
+
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+
+	go func() {
+		sut.ServeHTTP(rr, req)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(registry.Taps()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tapped := registry.Taps()[0]
+	require.Equal(t, pipelines.SignalTypeLog, tapped.SignalType)
+	require.Equal(t, "logs", tapped.PipelineName)
+	require.Equal(t, StageTransform, tapped.Stage)
+	require.Equal(t, 0.5, tapped.SamplingRatio)
+
+	id := strings.TrimPrefix(tapped.Endpoint, "https://telemetry-manager-webhook.kyma-system.svc:9444"+SinkPath)
+	registry.deliver(id, pipelines.SignalTypeLog, [][]byte{[]byte(`{"record":1}`), []byte(`{"record":2}`), []byte(`{"record":3}`)})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after the limit was reached")
+	}
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+	require.Equal(t, "{\"record\":1}\n{\"record\":2}\n", rr.Body.String())
+
+	// The session is closed, and the tap removed from the gateway configuration, once the limit is reached
+	require.Empty(t, registry.Taps())
+}
+
+func TestStreamHandlerTimeout(t *testing.T) {
+	tracePipeline := testutils.NewTracePipelineBuilder().WithName("traces").WithTap(true).Build()
+
+	registry := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444")
+	sut := NewStreamHandler(newFakeClient(&tracePipeline), registry, logr.Discard())
+
+	req := httptest.NewRequest(http.MethodGet, StreamPath+"tracepipelines/traces?timeout=100ms", nil)
+	req.Header.Set("Authorization", "Bearer allowed")
+
+	rr := httptest.NewRecorder()
+	sut.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Empty(t, rr.Body.String())
+	require.Empty(t, registry.Taps())
+}
+
+func TestStreamHandlerRejectsTappedPipeline(t *testing.T) {
+	tracePipeline := testutils.NewTracePipelineBuilder().WithName("traces").WithTap(true).Build()
+
+	registry := NewRegistry("https://telemetry-manager-webhook.kyma-system.svc:9444")
+	session, err := registry.Open(pipelines.SignalTypeTrace, "traces", StageInput, 10, 1)
+	require.NoError(t, err)
+
+	sut := NewStreamHandler(newFakeClient(&tracePipeline), registry, logr.Discard())
+
+	req := httptest.NewRequest(http.MethodGet, StreamPath+"tracepipelines/traces", nil)
+	req.Header.Set("Authorization", "Bearer allowed")
+
+	rr := httptest.NewRecorder()
+	sut.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+
+	// The rejected request does not close the open session
+	require.Len(t, registry.Taps(), 1)
+
+	registry.Close(session)
+}
@@ -0,0 +1,50 @@
+// Package tap implements live taps on pipelines. Opening a tap attaches a sampled exporter to the tapped stage of the pipeline
+// in the OTLP Gateway configuration, which sends the data to the sink endpoint of the manager. The manager streams the received
+// records to the user who opened the tap. Once the requested number of records is captured, the timeout is reached, or the user
+// disconnects, the tap is closed and the exporter is removed from the gateway configuration again.
+package tap
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// SinkAudience is the audience of the service account token, with which the OTLP Gateway authenticates at the sink endpoint.
+// The API rejects it as the audience of the service account token authentication of an output, so that no backend receives a token for the sink.
+const SinkAudience = "telemetry-manager-tap-sink"
+
+// Stage is the position in a pipeline at which the data is tapped.
+type Stage string
+
+const (
+	// StageInput taps the data as it enters the pipeline. Only the redaction of the pipeline is applied.
+	StageInput Stage = "input"
+	// StageTransform taps the data after the user-defined transforms are applied.
+	StageTransform Stage = "transform"
+	// StageExport taps the data right before it is exported, after the user-defined filters are applied.
+	StageExport Stage = "export"
+)
+
+// ParseStage parses the given string into a Stage. An empty string defaults to StageExport.
+func ParseStage(s string) (Stage, error) {
+	switch Stage(s) {
+	case "":
+		return StageExport, nil
+	case StageInput, StageTransform, StageExport:
+		return Stage(s), nil
+	default:
+		return "", fmt.Errorf("unknown stage %q, must be one of %s, %s, %s", s, StageInput, StageTransform, StageExport)
+	}
+}
+
+// Tap describes an open tap, which the OTLP Gateway attaches to the configuration of the tapped pipeline.
+type Tap struct {
+	SignalType   pipelines.SignalType
+	PipelineName string
+	Stage        Stage
+	// SamplingRatio is the ratio of the records passing the stage that the gateway sends to the sink, between 0 (exclusive) and 1 (inclusive)
+	SamplingRatio float64
+	// Endpoint is the OTLP/HTTP endpoint of the session at the sink, to which the gateway sends the tapped data
+	Endpoint string
+}
@@ -46,6 +46,13 @@ func IsRuntimeInputEnabled(i *telemetryv1beta1.LogPipelineInput) bool {
 	return i.Runtime != nil && ptr.Deref(i.Runtime.Enabled, true)
 }
 
+// IsAgentRequired returns true if the pipeline has an input that is collected by the Log Agent.
+func IsAgentRequired(pipeline *telemetryv1beta1.LogPipeline) bool {
+	input := pipeline.Spec.Input
+
+	return input.Runtime != nil && input.Runtime.Enabled != nil && *input.Runtime.Enabled
+}
+
 // ContainsCustomPlugin returns true if the pipeline contains any custom filters or outputs
 func ContainsCustomPlugin(lp *telemetryv1beta1.LogPipeline) bool {
 	return IsCustomOutputDefined(&lp.Spec.Output) || IsCustomFilterDefined(lp.Spec.FluentBitFilters)
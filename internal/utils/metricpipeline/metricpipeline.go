@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -31,6 +32,10 @@ func IsRuntimeInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
 	return input.Runtime != nil && input.Runtime.Enabled != nil && *input.Runtime.Enabled
 }
 
+func IsControlPlaneInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.ControlPlane != nil && input.ControlPlane.Enabled != nil && *input.ControlPlane.Enabled
+}
+
 func IsPrometheusDiagnosticInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
 	return input.Prometheus.DiagnosticMetrics != nil && input.Prometheus.DiagnosticMetrics.Enabled != nil && *input.Prometheus.DiagnosticMetrics.Enabled
 }
@@ -111,8 +116,182 @@ func IsRuntimeJobInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
 	return *input.Runtime.Resources.Job.Enabled
 }
 
+func IsRuntimeReplicaSetInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime ReplicaSet metrics should be disabled by default if any of the fields (Resources, ReplicaSet or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.ReplicaSet == nil || input.Runtime.Resources.ReplicaSet.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.ReplicaSet.Enabled
+}
+
+func IsRuntimeCronJobInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime CronJob metrics should be disabled by default if any of the fields (Resources, CronJob or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.CronJob == nil || input.Runtime.Resources.CronJob.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.CronJob.Enabled
+}
+
+func IsRuntimeHorizontalPodAutoscalerInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime HorizontalPodAutoscaler metrics should be disabled by default if any of the fields (Resources, HorizontalPodAutoscaler or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.HorizontalPodAutoscaler == nil || input.Runtime.Resources.HorizontalPodAutoscaler.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.HorizontalPodAutoscaler.Enabled
+}
+
+func IsRuntimePersistentVolumeClaimInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime PersistentVolumeClaim metrics should be disabled by default if any of the fields (Resources, PersistentVolumeClaim or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.PersistentVolumeClaim == nil || input.Runtime.Resources.PersistentVolumeClaim.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.PersistentVolumeClaim.Enabled
+}
+
+func IsRuntimeResourceQuotaInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime ResourceQuota metrics should be disabled by default if any of the fields (Resources, ResourceQuota or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.ResourceQuota == nil || input.Runtime.Resources.ResourceQuota.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.ResourceQuota.Enabled
+}
+
+func IsRuntimeNamespaceInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime Namespace metrics should be disabled by default if any of the fields (Resources, Namespace or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.Namespace == nil || input.Runtime.Resources.Namespace.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.Namespace.Enabled
+}
+
+func IsRuntimeHostInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Runtime Host metrics should be disabled by default if any of the fields (Resources, Host or Enabled) is nil
+	if input.Runtime.Resources == nil || input.Runtime.Resources.Host == nil || input.Runtime.Resources.Host.Enabled == nil {
+		return false
+	}
+
+	return *input.Runtime.Resources.Host.Enabled
+}
+
+func IsControlPlaneAPIServerInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Control plane API server metrics should be enabled by default if any of the fields (Components, APIServer or Enabled) is nil
+	if input.ControlPlane.Components == nil || input.ControlPlane.Components.APIServer == nil || input.ControlPlane.Components.APIServer.Enabled == nil {
+		return true
+	}
+
+	return *input.ControlPlane.Components.APIServer.Enabled
+}
+
+func IsControlPlaneCoreDNSInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Control plane CoreDNS metrics should be enabled by default if any of the fields (Components, CoreDNS or Enabled) is nil
+	if input.ControlPlane.Components == nil || input.ControlPlane.Components.CoreDNS == nil || input.ControlPlane.Components.CoreDNS.Enabled == nil {
+		return true
+	}
+
+	return *input.ControlPlane.Components.CoreDNS.Enabled
+}
+
+func IsControlPlaneKubeProxyInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	// Control plane kube-proxy metrics should be enabled by default if any of the fields (Components, KubeProxy or Enabled) is nil
+	if input.ControlPlane.Components == nil || input.ControlPlane.Components.KubeProxy == nil || input.ControlPlane.Components.KubeProxy.Enabled == nil {
+		return true
+	}
+
+	return *input.ControlPlane.Components.KubeProxy.Enabled
+}
+
+// RuntimeInputCollectionInterval returns the collection interval override of the runtime input, or nil if the pipeline uses the interval from the Telemetry CR.
+func RuntimeInputCollectionInterval(input telemetryv1beta1.MetricPipelineInput) *metav1.Duration {
+	if input.Runtime == nil {
+		return nil
+	}
+
+	return input.Runtime.CollectionInterval
+}
+
+// PrometheusInputCollectionInterval returns the collection interval override of the prometheus input, or nil if the pipeline uses the interval from the Telemetry CR.
+func PrometheusInputCollectionInterval(input telemetryv1beta1.MetricPipelineInput) *metav1.Duration {
+	if input.Prometheus == nil {
+		return nil
+	}
+
+	return input.Prometheus.CollectionInterval
+}
+
+// IstioInputCollectionInterval returns the collection interval override of the istio input, or nil if the pipeline uses the interval from the Telemetry CR.
+func IstioInputCollectionInterval(input telemetryv1beta1.MetricPipelineInput) *metav1.Duration {
+	if input.Istio == nil {
+		return nil
+	}
+
+	return input.Istio.CollectionInterval
+}
+
+// IsAgentRequired returns true if the pipeline has an input that is collected by the Metric Agent.
+func IsAgentRequired(pipeline *telemetryv1beta1.MetricPipeline) bool {
+	input := pipeline.Spec.Input
+
+	return IsRuntimeInputEnabled(input) || IsPrometheusInputEnabled(input) || IsIstioInputEnabled(input) || IsControlPlaneInputEnabled(input)
+}
+
+func IsPrometheusNativeHistogramsEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Prometheus != nil && input.Prometheus.NativeHistograms != nil && input.Prometheus.NativeHistograms.Enabled != nil && *input.Prometheus.NativeHistograms.Enabled
+}
+
 func IsDeltaTemporality(output telemetryv1beta1.MetricPipelineOutput) bool {
-	return *output.OTLP.Temporality == telemetryv1beta1.TemporalityDelta
+	return output.OTLP != nil && output.OTLP.Temporality != nil && *output.OTLP.Temporality == telemetryv1beta1.TemporalityDelta
+}
+
+// IsExemplarsDropped returns true if the output of the pipeline is configured to drop exemplars
+func IsExemplarsDropped(output telemetryv1beta1.MetricPipelineOutput) bool {
+	if output.OTLP != nil && output.OTLP.Exemplars != nil {
+		return *output.OTLP.Exemplars == telemetryv1beta1.ExemplarsDrop
+	}
+
+	if output.Prometheus != nil && output.Prometheus.Exemplars != nil {
+		return *output.Prometheus.Exemplars == telemetryv1beta1.ExemplarsDrop
+	}
+
+	return false
+}
+
+func IsPrometheusOutputDefined(output telemetryv1beta1.MetricPipelineOutput) bool {
+	return output.Prometheus != nil
+}
+
+func IsPrometheusOutputResourceToLabelsEnabled(output telemetryv1beta1.MetricPipelineOutput) bool {
+	// Resource attributes should be converted to labels by default if any of the fields (ResourceToLabels or Enabled) is nil
+	if output.Prometheus == nil || output.Prometheus.ResourceToLabels == nil || output.Prometheus.ResourceToLabels.Enabled == nil {
+		return true
+	}
+
+	return *output.Prometheus.ResourceToLabels.Enabled
+}
+
+// PrometheusOutputMetricExpiration returns the metric expiration of the prometheus output, or nil if the default expiration applies.
+func PrometheusOutputMetricExpiration(output telemetryv1beta1.MetricPipelineOutput) *metav1.Duration {
+	if output.Prometheus == nil {
+		return nil
+	}
+
+	return output.Prometheus.MetricExpiration
+}
+
+// IsPrometheusOutputDefinedInAny returns true if any of the given MetricPipelines defines a prometheus output
+func IsPrometheusOutputDefinedInAny(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		if IsPrometheusOutputDefined(pipelines[i].Spec.Output) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // OTLPOutputPorts returns the list of ports of the backends defined in all given MetricPipelines
@@ -120,6 +299,10 @@ func OTLPOutputPorts(ctx context.Context, c client.Reader, allPipelines []teleme
 	backendPorts := []string{}
 
 	for _, pipeline := range allPipelines {
+		if pipeline.Spec.Output.OTLP == nil {
+			continue
+		}
+
 		endpoint, err := sharedtypesutils.ResolveValue(ctx, c, pipeline.Spec.Output.OTLP.Endpoint)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve the value of the OTLP output endpoint: %w", err)
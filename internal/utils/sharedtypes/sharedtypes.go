@@ -3,6 +3,7 @@ package sharedtypes
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -53,3 +54,30 @@ func IsTransformDefined(transforms []telemetryv1beta1.TransformSpec) bool {
 func IsOTLPInputEnabled(input *telemetryv1beta1.OTLPInput) bool {
 	return input == nil || input.Enabled == nil || *input.Enabled
 }
+
+func IsHeartbeatEnabled(heartbeat *telemetryv1beta1.Heartbeat) bool {
+	return heartbeat != nil && heartbeat.Enabled
+}
+
+func IsTapEnabled(tap *telemetryv1beta1.Tap) bool {
+	return tap != nil && tap.Enabled
+}
+
+// IsNamespaceFilterDefined returns true if the namespace selector restricts the namespaces of an input.
+func IsNamespaceFilterDefined(selector *telemetryv1beta1.NamespaceSelector) bool {
+	return selector != nil && (len(selector.Include) > 0 || len(selector.Exclude) > 0)
+}
+
+// IsNamespaceSelected returns true if the namespace passes the namespace filter that is generated for the namespace selector of an input.
+// A namespace is selected if it is listed in Include (if Include is set) and not listed in Exclude. Without a selector, all namespaces are selected.
+func IsNamespaceSelected(selector *telemetryv1beta1.NamespaceSelector, namespace string) bool {
+	if !IsNamespaceFilterDefined(selector) {
+		return true
+	}
+
+	if len(selector.Include) > 0 && !slices.Contains(selector.Include, namespace) {
+		return false
+	}
+
+	return !slices.Contains(selector.Exclude, namespace)
+}
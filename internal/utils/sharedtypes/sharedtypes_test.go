@@ -341,3 +341,160 @@ func TestIsOTLPInputEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestIsHeartbeatEnabled(t *testing.T) {
+	tests := []struct {
+		name      string
+		heartbeat *telemetryv1beta1.Heartbeat
+		expected  bool
+	}{
+		{
+			name:      "nil heartbeat defaults to disabled",
+			heartbeat: nil,
+			expected:  false,
+		},
+		{
+			name:      "explicitly enabled",
+			heartbeat: &telemetryv1beta1.Heartbeat{Enabled: true},
+			expected:  true,
+		},
+		{
+			name:      "explicitly disabled",
+			heartbeat: &telemetryv1beta1.Heartbeat{Enabled: false},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsHeartbeatEnabled(tt.heartbeat)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestIsTapEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		tap      *telemetryv1beta1.Tap
+		expected bool
+	}{
+		{
+			name:     "nil tap defaults to disabled",
+			tap:      nil,
+			expected: false,
+		},
+		{
+			name:     "explicitly enabled",
+			tap:      &telemetryv1beta1.Tap{Enabled: true},
+			expected: true,
+		},
+		{
+			name:     "explicitly disabled",
+			tap:      &telemetryv1beta1.Tap{Enabled: false},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsTapEnabled(tt.tap)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestIsNamespaceFilterDefined(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *telemetryv1beta1.NamespaceSelector
+		expected bool
+	}{
+		{
+			name:     "nil selector",
+			selector: nil,
+			expected: false,
+		},
+		{
+			name:     "empty selector",
+			selector: &telemetryv1beta1.NamespaceSelector{},
+			expected: false,
+		},
+		{
+			name:     "include defined",
+			selector: &telemetryv1beta1.NamespaceSelector{Include: []string{"ns-1"}},
+			expected: true,
+		},
+		{
+			name:     "exclude defined",
+			selector: &telemetryv1beta1.NamespaceSelector{Exclude: []string{"ns-1"}},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsNamespaceFilterDefined(tt.selector)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestIsNamespaceSelected(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  *telemetryv1beta1.NamespaceSelector
+		namespace string
+		expected  bool
+	}{
+		{
+			name:      "nil selector selects all namespaces",
+			selector:  nil,
+			namespace: "kyma-system",
+			expected:  true,
+		},
+		{
+			name:      "empty selector selects all namespaces",
+			selector:  &telemetryv1beta1.NamespaceSelector{},
+			namespace: "kyma-system",
+			expected:  true,
+		},
+		{
+			name:      "included namespace",
+			selector:  &telemetryv1beta1.NamespaceSelector{Include: []string{"ns-1", "ns-2"}},
+			namespace: "ns-2",
+			expected:  true,
+		},
+		{
+			name:      "namespace not included",
+			selector:  &telemetryv1beta1.NamespaceSelector{Include: []string{"ns-1", "ns-2"}},
+			namespace: "ns-3",
+			expected:  false,
+		},
+		{
+			name:      "excluded namespace",
+			selector:  &telemetryv1beta1.NamespaceSelector{Exclude: []string{"kyma-system", "kube-system"}},
+			namespace: "kube-system",
+			expected:  false,
+		},
+		{
+			name:      "namespace not excluded",
+			selector:  &telemetryv1beta1.NamespaceSelector{Exclude: []string{"kyma-system", "kube-system"}},
+			namespace: "ns-1",
+			expected:  true,
+		},
+		{
+			name:      "namespace both included and excluded",
+			selector:  &telemetryv1beta1.NamespaceSelector{Include: []string{"ns-1"}, Exclude: []string{"ns-1"}},
+			namespace: "ns-1",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsNamespaceSelected(tt.selector, tt.namespace)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -30,6 +30,11 @@ type LogPipelineBuilder struct {
 	variables        []telemetryv1beta1.FluentBitVariable
 	transforms       []telemetryv1beta1.TransformSpec
 	filters          []telemetryv1beta1.FilterSpec
+	suspend          bool
+	heartbeat        bool
+	tap              bool
+	redaction        *telemetryv1beta1.Redaction
+	routes           []telemetryv1beta1.OutputRoute
 
 	statusConditions []metav1.Condition
 }
@@ -291,6 +296,42 @@ func (b *LogPipelineBuilder) WithFilter(filter telemetryv1beta1.FilterSpec) *Log
 	return b
 }
 
+func (b *LogPipelineBuilder) WithSuspend(suspend bool) *LogPipelineBuilder {
+	b.suspend = suspend
+	return b
+}
+
+func (b *LogPipelineBuilder) WithHeartbeat(enabled bool) *LogPipelineBuilder {
+	b.heartbeat = enabled
+	return b
+}
+
+func (b *LogPipelineBuilder) WithTap(enabled bool) *LogPipelineBuilder {
+	b.tap = enabled
+	return b
+}
+
+func (b *LogPipelineBuilder) WithRedaction(redaction telemetryv1beta1.Redaction) *LogPipelineBuilder {
+	b.redaction = &redaction
+	return b
+}
+
+// WithRoute adds an output route, which sends the data matching the conditions to the default OTLP output modified by the given options.
+func (b *LogPipelineBuilder) WithRoute(name string, conditions []string, opts ...OTLPOutputOption) *LogPipelineBuilder {
+	output := defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(output)
+	}
+
+	b.routes = append(b.routes, telemetryv1beta1.OutputRoute{
+		Name:       name,
+		Conditions: conditions,
+		Output:     telemetryv1beta1.OutputRouteOutput{OTLP: output},
+	})
+
+	return b
+}
+
 func (b *LogPipelineBuilder) WithDeletionTimeStamp(ts metav1.Time) *LogPipelineBuilder {
 	b.deletionTimeStamp = ts
 	return b
@@ -337,6 +378,11 @@ func (b *LogPipelineBuilder) Build() telemetryv1beta1.LogPipeline {
 			FluentBitVariables: b.variables,
 			Transforms:         b.transforms,
 			Filters:            b.filters,
+			Suspend:            b.suspend,
+			Heartbeat:          heartbeatSpec(b.heartbeat),
+			Tap:                tapSpec(b.tap),
+			Redaction:          b.redaction,
+			Routes:             b.routes,
 		},
 		Status: telemetryv1beta1.LogPipelineStatus{
 			Conditions: b.statusConditions,
@@ -368,3 +414,19 @@ func defaultOTLPOutput() *telemetryv1beta1.OTLPOutput {
 		Protocol: telemetryv1beta1.OTLPProtocolGRPC,
 	}
 }
+
+func heartbeatSpec(enabled bool) *telemetryv1beta1.Heartbeat {
+	if !enabled {
+		return nil
+	}
+
+	return &telemetryv1beta1.Heartbeat{Enabled: true}
+}
+
+func tapSpec(enabled bool) *telemetryv1beta1.Tap {
+	if !enabled {
+		return nil
+	}
+
+	return &telemetryv1beta1.Tap{Enabled: true}
+}
@@ -17,16 +17,24 @@ type MetricPipelineBuilder struct {
 	labels      map[string]string
 	annotations map[string]string
 
-	inRuntime    *telemetryv1beta1.MetricPipelineRuntimeInput
-	inPrometheus *telemetryv1beta1.MetricPipelinePrometheusInput
-	inIstio      *telemetryv1beta1.MetricPipelineIstioInput
-	inOTLP       *telemetryv1beta1.OTLPInput
+	inRuntime      *telemetryv1beta1.MetricPipelineRuntimeInput
+	inPrometheus   *telemetryv1beta1.MetricPipelinePrometheusInput
+	inIstio        *telemetryv1beta1.MetricPipelineIstioInput
+	inOTLP         *telemetryv1beta1.OTLPInput
+	inControlPlane *telemetryv1beta1.MetricPipelineControlPlaneInput
 
-	outOTLP *telemetryv1beta1.MetricPipelineOTLPOutput
-	oauth2  *telemetryv1beta1.OAuth2Options
+	outOTLP       *telemetryv1beta1.MetricPipelineOTLPOutput
+	outPrometheus *telemetryv1beta1.MetricPipelinePrometheusOutput
+	oauth2        *telemetryv1beta1.OAuth2Options
 
 	transforms       []telemetryv1beta1.TransformSpec
 	filter           []telemetryv1beta1.FilterSpec
+	aggregation      *telemetryv1beta1.MetricPipelineAggregation
+	suspend          bool
+	heartbeat        bool
+	tap              bool
+	redaction        *telemetryv1beta1.Redaction
+	routes           []telemetryv1beta1.OutputRoute
 	statusConditions []metav1.Condition
 }
 
@@ -275,6 +283,50 @@ func (b *MetricPipelineBuilder) WithPrometheusInputDiagnosticMetrics(enable bool
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithControlPlaneInput(enable bool) *MetricPipelineBuilder {
+	if b.inControlPlane == nil {
+		b.inControlPlane = &telemetryv1beta1.MetricPipelineControlPlaneInput{}
+	}
+
+	b.inControlPlane.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithControlPlaneInputAPIServerMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeControlPlaneInputComponents()
+
+	b.inControlPlane.Components.APIServer = &telemetryv1beta1.MetricPipelineControlPlaneInputComponent{Enabled: &enable}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithControlPlaneInputCoreDNSMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeControlPlaneInputComponents()
+
+	b.inControlPlane.Components.CoreDNS = &telemetryv1beta1.MetricPipelineControlPlaneInputComponent{Enabled: &enable}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithControlPlaneInputKubeProxyMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeControlPlaneInputComponents()
+
+	b.inControlPlane.Components.KubeProxy = &telemetryv1beta1.MetricPipelineControlPlaneInputComponent{Enabled: &enable}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) initializeControlPlaneInputComponents() {
+	if b.inControlPlane == nil {
+		b.inControlPlane = &telemetryv1beta1.MetricPipelineControlPlaneInput{}
+	}
+
+	if b.inControlPlane.Components == nil {
+		b.inControlPlane.Components = &telemetryv1beta1.MetricPipelineControlPlaneInputComponents{}
+	}
+}
+
 func (b *MetricPipelineBuilder) WithIstioInputDiagnosticMetrics(enable bool) *MetricPipelineBuilder {
 	if b.inIstio == nil {
 		b.inIstio = &telemetryv1beta1.MetricPipelineIstioInput{}
@@ -361,6 +413,90 @@ func (b *MetricPipelineBuilder) WithRuntimeInputJobMetrics(enable bool) *MetricP
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithRuntimeInputReplicaSetMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.ReplicaSet == nil {
+		b.inRuntime.Resources.ReplicaSet = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.ReplicaSet.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputCronJobMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.CronJob == nil {
+		b.inRuntime.Resources.CronJob = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.CronJob.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputHorizontalPodAutoscalerMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.HorizontalPodAutoscaler == nil {
+		b.inRuntime.Resources.HorizontalPodAutoscaler = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.HorizontalPodAutoscaler.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputPersistentVolumeClaimMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.PersistentVolumeClaim == nil {
+		b.inRuntime.Resources.PersistentVolumeClaim = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.PersistentVolumeClaim.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputResourceQuotaMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.ResourceQuota == nil {
+		b.inRuntime.Resources.ResourceQuota = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.ResourceQuota.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputNamespaceMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.Namespace == nil {
+		b.inRuntime.Resources.Namespace = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.Namespace.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRuntimeInputHostMetrics(enable bool) *MetricPipelineBuilder {
+	b.initializeRuntimeInputResources()
+
+	if b.inRuntime.Resources.Host == nil {
+		b.inRuntime.Resources.Host = &telemetryv1beta1.MetricPipelineRuntimeInputResource{}
+	}
+
+	b.inRuntime.Resources.Host.Enabled = &enable
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithRuntimeInputDaemonSetMetrics(enable bool) *MetricPipelineBuilder {
 	b.initializeRuntimeInputResources()
 
@@ -395,6 +531,46 @@ func (b *MetricPipelineBuilder) WithRuntimeInputAdditionalMetrics(metrics ...str
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithRuntimeInputCollectionInterval(interval time.Duration) *MetricPipelineBuilder {
+	if b.inRuntime == nil {
+		b.inRuntime = &telemetryv1beta1.MetricPipelineRuntimeInput{}
+	}
+
+	b.inRuntime.CollectionInterval = &metav1.Duration{Duration: interval}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithPrometheusInputCollectionInterval(interval time.Duration) *MetricPipelineBuilder {
+	if b.inPrometheus == nil {
+		b.inPrometheus = &telemetryv1beta1.MetricPipelinePrometheusInput{}
+	}
+
+	b.inPrometheus.CollectionInterval = &metav1.Duration{Duration: interval}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithPrometheusInputNativeHistograms(enable bool) *MetricPipelineBuilder {
+	if b.inPrometheus == nil {
+		b.inPrometheus = &telemetryv1beta1.MetricPipelinePrometheusInput{}
+	}
+
+	b.inPrometheus.NativeHistograms = &telemetryv1beta1.MetricPipelinePrometheusInputNativeHistograms{Enabled: &enable}
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithIstioInputCollectionInterval(interval time.Duration) *MetricPipelineBuilder {
+	if b.inIstio == nil {
+		b.inIstio = &telemetryv1beta1.MetricPipelineIstioInput{}
+	}
+
+	b.inIstio.CollectionInterval = &metav1.Duration{Duration: interval}
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithMetricPipelineOTLPOutput(opts ...OTLPOutputOption) *MetricPipelineBuilder {
 	for _, opt := range opts {
 		opt(&b.outOTLP.OTLPOutput)
@@ -403,11 +579,24 @@ func (b *MetricPipelineBuilder) WithMetricPipelineOTLPOutput(opts ...OTLPOutputO
 	return b
 }
 
+// WithPrometheusOutput replaces the default OTLP output of the pipeline with a prometheus output.
+func (b *MetricPipelineBuilder) WithPrometheusOutput(output telemetryv1beta1.MetricPipelinePrometheusOutput) *MetricPipelineBuilder {
+	b.outOTLP = nil
+	b.outPrometheus = &output
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithTemporality(temporality telemetryv1beta1.TemporalityType) *MetricPipelineBuilder {
 	b.outOTLP.Temporality = &temporality
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithExemplars(exemplars telemetryv1beta1.ExemplarsType) *MetricPipelineBuilder {
+	b.outOTLP.Exemplars = &exemplars
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithOAuth2(opts ...OAuth2Option) *MetricPipelineBuilder {
 	if b.oauth2 == nil {
 		b.oauth2 = &telemetryv1beta1.OAuth2Options{}
@@ -437,6 +626,47 @@ func (b *MetricPipelineBuilder) WithFilter(filter telemetryv1beta1.FilterSpec) *
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithSuspend(suspend bool) *MetricPipelineBuilder {
+	b.suspend = suspend
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithHeartbeat(enabled bool) *MetricPipelineBuilder {
+	b.heartbeat = enabled
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithTap(enabled bool) *MetricPipelineBuilder {
+	b.tap = enabled
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithRedaction(redaction telemetryv1beta1.Redaction) *MetricPipelineBuilder {
+	b.redaction = &redaction
+	return b
+}
+
+// WithRoute adds an output route, which sends the data matching the conditions to the default OTLP output modified by the given options.
+func (b *MetricPipelineBuilder) WithRoute(name string, conditions []string, opts ...OTLPOutputOption) *MetricPipelineBuilder {
+	output := defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(output)
+	}
+
+	b.routes = append(b.routes, telemetryv1beta1.OutputRoute{
+		Name:       name,
+		Conditions: conditions,
+		Output:     telemetryv1beta1.OutputRouteOutput{OTLP: output},
+	})
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithAggregation(aggregation telemetryv1beta1.MetricPipelineAggregation) *MetricPipelineBuilder {
+	b.aggregation = &aggregation
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithStatusCondition(cond metav1.Condition) *MetricPipelineBuilder {
 	b.statusConditions = append(b.statusConditions, cond)
 	return b
@@ -463,16 +693,24 @@ func (b *MetricPipelineBuilder) Build() telemetryv1beta1.MetricPipeline {
 		},
 		Spec: telemetryv1beta1.MetricPipelineSpec{
 			Input: telemetryv1beta1.MetricPipelineInput{
-				Runtime:    b.inRuntime,
-				Prometheus: b.inPrometheus,
-				Istio:      b.inIstio,
-				OTLP:       b.inOTLP,
+				Runtime:      b.inRuntime,
+				Prometheus:   b.inPrometheus,
+				Istio:        b.inIstio,
+				OTLP:         b.inOTLP,
+				ControlPlane: b.inControlPlane,
 			},
 			Output: telemetryv1beta1.MetricPipelineOutput{
-				OTLP: b.outOTLP,
+				OTLP:       b.outOTLP,
+				Prometheus: b.outPrometheus,
 			},
-			Transforms: b.transforms,
-			Filters:    b.filter,
+			Transforms:  b.transforms,
+			Filters:     b.filter,
+			Aggregation: b.aggregation,
+			Suspend:     b.suspend,
+			Heartbeat:   heartbeatSpec(b.heartbeat),
+			Tap:         tapSpec(b.tap),
+			Redaction:   b.redaction,
+			Routes:      b.routes,
 		},
 	}
 
@@ -155,6 +155,47 @@ func OTLPEndpointPath(path string) OTLPOutputOption {
 	}
 }
 
+func OTLPProxyURL(proxyURL string) OTLPOutputOption {
+	return func(output *telemetryv1beta1.OTLPOutput) {
+		output.ProxyURL = &telemetryv1beta1.ValueType{Value: proxyURL}
+	}
+}
+
+func OTLPProxyURLFromSecret(secretName, secretNamespace, proxyURLKey string) OTLPOutputOption {
+	return func(output *telemetryv1beta1.OTLPOutput) {
+		output.ProxyURL = &telemetryv1beta1.ValueType{
+			ValueFrom: &telemetryv1beta1.ValueFromSource{
+				SecretKeyRef: &telemetryv1beta1.SecretKeyRef{
+					Name:      secretName,
+					Namespace: secretNamespace,
+					Key:       proxyURLKey,
+				},
+			},
+		}
+	}
+}
+
+func OTLPProxyBasicAuth(user, password string) OTLPOutputOption {
+	return func(output *telemetryv1beta1.OTLPOutput) {
+		output.ProxyAuthentication = &telemetryv1beta1.ProxyAuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{
+				User:     telemetryv1beta1.ValueType{Value: user},
+				Password: telemetryv1beta1.ValueType{Value: password},
+			},
+		}
+	}
+}
+
+func OTLPServiceAccountToken(audience string) OTLPOutputOption {
+	return func(output *telemetryv1beta1.OTLPOutput) {
+		output.Authentication = &telemetryv1beta1.AuthenticationOptions{
+			ServiceAccountToken: &telemetryv1beta1.ServiceAccountTokenAuthOptions{
+				Audience: audience,
+			},
+		}
+	}
+}
+
 type OAuth2Option func(oauth2 *telemetryv1beta1.OAuth2Options)
 
 func OAuth2ClientID(clientID string) OAuth2Option {
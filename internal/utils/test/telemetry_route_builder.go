@@ -0,0 +1,97 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type TelemetryRouteBuilder struct {
+	randSource rand.Source
+
+	name      string
+	namespace string
+
+	signals          []telemetryv1beta1.TelemetryRouteSignal
+	statusConditions []metav1.Condition
+	outOTLP          *telemetryv1beta1.OTLPOutput
+}
+
+func NewTelemetryRouteBuilder() *TelemetryRouteBuilder {
+	return &TelemetryRouteBuilder{
+		randSource: rand.NewSource(time.Now().UnixNano()),
+		namespace:  "default",
+		outOTLP: &telemetryv1beta1.OTLPOutput{
+			Endpoint: telemetryv1beta1.ValueType{Value: "https://localhost:4317"},
+		},
+	}
+}
+
+func (b *TelemetryRouteBuilder) WithName(name string) *TelemetryRouteBuilder {
+	b.name = name
+	return b
+}
+
+func (b *TelemetryRouteBuilder) WithNamespace(namespace string) *TelemetryRouteBuilder {
+	b.namespace = namespace
+	return b
+}
+
+func (b *TelemetryRouteBuilder) WithSignals(signals ...telemetryv1beta1.TelemetryRouteSignal) *TelemetryRouteBuilder {
+	b.signals = append(b.signals, signals...)
+	return b
+}
+
+func (b *TelemetryRouteBuilder) WithStatusCondition(cond metav1.Condition) *TelemetryRouteBuilder {
+	b.statusConditions = append(b.statusConditions, cond)
+	return b
+}
+
+func (b *TelemetryRouteBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *TelemetryRouteBuilder {
+	for _, opt := range opts {
+		opt(b.outOTLP)
+	}
+
+	return b
+}
+
+func (b *TelemetryRouteBuilder) Build() telemetryv1beta1.TelemetryRoute {
+	name := b.name
+	if name == "" {
+		name = fmt.Sprintf("test-%d", b.randSource.Int63())
+	}
+
+	signals := b.signals
+	if len(signals) == 0 {
+		signals = []telemetryv1beta1.TelemetryRouteSignal{
+			telemetryv1beta1.TelemetryRouteSignalLogs,
+			telemetryv1beta1.TelemetryRouteSignalMetrics,
+			telemetryv1beta1.TelemetryRouteSignalTraces,
+		}
+	}
+
+	return telemetryv1beta1.TelemetryRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  b.namespace,
+			Generation: 1,
+		},
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: telemetryv1beta1.GroupVersion.String(),
+			Kind:       "TelemetryRoute",
+		},
+		Spec: telemetryv1beta1.TelemetryRouteSpec{
+			Signals: signals,
+			Output: telemetryv1beta1.TelemetryRouteOutput{
+				OTLP: b.outOTLP,
+			},
+		},
+		Status: telemetryv1beta1.TelemetryRouteStatus{
+			Conditions: b.statusConditions,
+		},
+	}
+}
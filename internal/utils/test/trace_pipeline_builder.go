@@ -21,6 +21,11 @@ type TracePipelineBuilder struct {
 	statusConditions []metav1.Condition
 	outOTLP          *telemetryv1beta1.OTLPOutput
 	oauth2           *telemetryv1beta1.OAuth2Options
+	suspend          bool
+	heartbeat        bool
+	tap              bool
+	redaction        *telemetryv1beta1.Redaction
+	routes           []telemetryv1beta1.OutputRoute
 }
 
 func NewTracePipelineBuilder() *TracePipelineBuilder {
@@ -89,6 +94,42 @@ func (b *TracePipelineBuilder) WithFilter(filter telemetryv1beta1.FilterSpec) *T
 	return b
 }
 
+func (b *TracePipelineBuilder) WithSuspend(suspend bool) *TracePipelineBuilder {
+	b.suspend = suspend
+	return b
+}
+
+func (b *TracePipelineBuilder) WithHeartbeat(enabled bool) *TracePipelineBuilder {
+	b.heartbeat = enabled
+	return b
+}
+
+func (b *TracePipelineBuilder) WithTap(enabled bool) *TracePipelineBuilder {
+	b.tap = enabled
+	return b
+}
+
+func (b *TracePipelineBuilder) WithRedaction(redaction telemetryv1beta1.Redaction) *TracePipelineBuilder {
+	b.redaction = &redaction
+	return b
+}
+
+// WithRoute adds an output route, which sends the data matching the conditions to the default OTLP output modified by the given options.
+func (b *TracePipelineBuilder) WithRoute(name string, conditions []string, opts ...OTLPOutputOption) *TracePipelineBuilder {
+	output := defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(output)
+	}
+
+	b.routes = append(b.routes, telemetryv1beta1.OutputRoute{
+		Name:       name,
+		Conditions: conditions,
+		Output:     telemetryv1beta1.OutputRouteOutput{OTLP: output},
+	})
+
+	return b
+}
+
 func (b *TracePipelineBuilder) Build() telemetryv1beta1.TracePipeline {
 	name := b.name
 	if name == "" {
@@ -111,6 +152,11 @@ func (b *TracePipelineBuilder) Build() telemetryv1beta1.TracePipeline {
 			},
 			Transforms: b.transforms,
 			Filters:    b.filters,
+			Suspend:    b.suspend,
+			Heartbeat:  heartbeatSpec(b.heartbeat),
+			Tap:        tapSpec(b.tap),
+			Redaction:  b.redaction,
+			Routes:     b.routes,
 		},
 		Status: telemetryv1beta1.TracePipelineStatus{
 			Conditions: b.statusConditions,
@@ -0,0 +1,387 @@
+package collectorconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/connector/forwardconnector"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/processor/batchprocessor"
+	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+const oauth2ExtensionType = "oauth2client"
+
+var (
+	envVarPlaceholderRegexp    = regexp.MustCompile(`^\$\{([A-Za-z0-9_]+)\}$`)
+	envVarPlaceholderAnyRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_]+)\}`)
+)
+
+const (
+	kindReceiver  = "receiver"
+	kindProcessor = "processor"
+	kindExporter  = "exporter"
+	kindConnector = "connector"
+	kindExtension = "extension"
+)
+
+type ConfigInvalidError struct {
+	Err error
+}
+
+func (e *ConfigInvalidError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConfigInvalidError) Unwrap() error {
+	return e.Err
+}
+
+func IsConfigInvalidError(err error) bool {
+	var errConfigInvalid *ConfigInvalidError
+	return errors.As(err, &errConfigInvalid)
+}
+
+// Validator checks a rendered OpenTelemetry Collector configuration before it is rolled out.
+// Components are validated against the component factories that the collector image is built with, so that a configuration
+// that the collector would reject at startup (and crash-loop on) is detected in the manager.
+// The configuration of components with a factory linked into the manager is loaded and validated by the factory.
+// Components without a linked factory (see componentTypesWithoutFactory) are checked structurally.
+type Validator struct {
+	factories map[string]map[component.Type]component.Factory
+}
+
+// deprecatedTypeAliasHolder is implemented by factories, which still accept the former type of a renamed component.
+type deprecatedTypeAliasHolder interface {
+	DeprecatedAlias() component.Type
+}
+
+func NewValidator() *Validator {
+	factories := map[string][]component.Factory{
+		kindReceiver: {
+			filelogreceiver.NewFactory(),
+			hostmetricsreceiver.NewFactory(),
+			otlpreceiver.NewFactory(),
+			prometheusreceiver.NewFactory(),
+		},
+		kindProcessor: {
+			batchprocessor.NewFactory(),
+			cumulativetodeltaprocessor.NewFactory(),
+			filterprocessor.NewFactory(),
+			groupbyattrsprocessor.NewFactory(),
+			intervalprocessor.NewFactory(),
+			memorylimiterprocessor.NewFactory(),
+			transformprocessor.NewFactory(),
+		},
+		kindExporter: {
+			otlpexporter.NewFactory(),
+			otlphttpexporter.NewFactory(),
+			prometheusexporter.NewFactory(),
+		},
+		kindConnector: {
+			forwardconnector.NewFactory(),
+		},
+		kindExtension: {
+			bearertokenauthextension.NewFactory(),
+			filestorage.NewFactory(),
+			healthcheckextension.NewFactory(),
+			oauth2clientauthextension.NewFactory(),
+		},
+	}
+
+	v := &Validator{
+		factories: make(map[string]map[component.Type]component.Factory, len(factories)),
+	}
+
+	for kind, kindFactories := range factories {
+		v.factories[kind] = make(map[component.Type]component.Factory, len(kindFactories))
+
+		for _, f := range kindFactories {
+			v.factories[kind][f.Type()] = f
+
+			if aliasHolder, ok := f.(deprecatedTypeAliasHolder); ok && aliasHolder.DeprecatedAlias().String() != "" {
+				v.factories[kind][aliasHolder.DeprecatedAlias()] = f
+			}
+		}
+	}
+
+	return v
+}
+
+// Validate validates the given collector configuration together with the environment variables, which are injected into the collector.
+// It returns a ConfigInvalidError if the collector would fail to start with the configuration.
+func (v *Validator) Validate(cfg *common.Config, envVars common.EnvVars) error {
+	if cfg == nil {
+		return nil
+	}
+
+	validations := []func() error{
+		func() error { return validateServicePipelines(cfg) },
+		func() error { return validateServiceExtensions(cfg) },
+		func() error { return validateOAuth2Extensions(cfg, envVars) },
+		func() error { return v.validateComponents(cfg, envVars) },
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return &ConfigInvalidError{Err: err}
+		}
+	}
+
+	return nil
+}
+
+func validateServicePipelines(cfg *common.Config) error {
+	for _, pipelineID := range sortedKeys(cfg.Service.Pipelines) {
+		pipeline := cfg.Service.Pipelines[pipelineID]
+
+		if len(pipeline.Receivers) == 0 {
+			return fmt.Errorf("service pipeline '%s' has no receivers", pipelineID)
+		}
+
+		if len(pipeline.Exporters) == 0 {
+			return fmt.Errorf("service pipeline '%s' has no exporters", pipelineID)
+		}
+
+		for _, id := range pipeline.Receivers {
+			if !isDefined(id, cfg.Receivers, cfg.Connectors) {
+				return fmt.Errorf("service pipeline '%s' references undefined receiver '%s'", pipelineID, id)
+			}
+		}
+
+		for _, id := range pipeline.Processors {
+			if !isDefined(id, cfg.Processors) {
+				return fmt.Errorf("service pipeline '%s' references undefined processor '%s'", pipelineID, id)
+			}
+		}
+
+		for _, id := range pipeline.Exporters {
+			if !isDefined(id, cfg.Exporters, cfg.Connectors) {
+				return fmt.Errorf("service pipeline '%s' references undefined exporter '%s'", pipelineID, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateServiceExtensions(cfg *common.Config) error {
+	for _, id := range cfg.Service.Extensions {
+		if !isDefined(id, cfg.Extensions) {
+			return fmt.Errorf("service references undefined extension '%s'", id)
+		}
+	}
+
+	return nil
+}
+
+// validateComponents checks that every component has a type of the collector image and validates the configuration
+// of the components with a linked factory.
+func (v *Validator) validateComponents(cfg *common.Config, envVars common.EnvVars) error {
+	components := []struct {
+		kind string
+		ids  map[string]any
+	}{
+		{kind: kindReceiver, ids: cfg.Receivers},
+		{kind: kindProcessor, ids: cfg.Processors},
+		{kind: kindExporter, ids: cfg.Exporters},
+		{kind: kindConnector, ids: cfg.Connectors},
+		{kind: kindExtension, ids: cfg.Extensions},
+	}
+
+	for _, c := range components {
+		for _, id := range sortedKeys(c.ids) {
+			var componentID component.ID
+			if err := componentID.UnmarshalText([]byte(id)); err != nil {
+				return fmt.Errorf("invalid %s ID '%s': %w", c.kind, id, err)
+			}
+
+			factory, ok := v.factories[c.kind][componentID.Type()]
+			if !ok {
+				if !slices.Contains(componentTypesWithoutFactory[c.kind], componentID.Type().String()) {
+					return fmt.Errorf("%s '%s' has the type '%s', which is not part of the collector image", c.kind, id, componentID.Type())
+				}
+
+				continue
+			}
+
+			validate := !slices.Contains(componentTypesWithoutConfigValidation[c.kind], factory.Type().String())
+			if err := validateComponentConfig(factory, c.ids[id], envVars, validate); err != nil {
+				return fmt.Errorf("invalid configuration of %s '%s': %w", c.kind, id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateComponentConfig loads the rendered component configuration the same way as the collector does
+// (through confmap into the default config of the factory, with environment variables expanded), which rejects unknown keys and values of the wrong type.
+// If validate is set, the validation of the component runs on the loaded configuration.
+func validateComponentConfig(factory component.Factory, rendered any, envVars common.EnvVars, validate bool) error {
+	raw, err := toStringMap(rendered)
+	if err != nil {
+		return err
+	}
+
+	componentCfg := factory.CreateDefaultConfig()
+	if err := confmap.NewFromStringMap(expandEnvVars(raw, envVars).(map[string]any)).Unmarshal(componentCfg); err != nil {
+		return err
+	}
+
+	if !validate {
+		return nil
+	}
+
+	return confmap.Validate(componentCfg)
+}
+
+// expandEnvVars replaces the environment variable placeholders in all string values with the values of the collector environment.
+// Placeholders of unknown variables are kept, so that the validation of the component reports the unresolved value.
+func expandEnvVars(value any, envVars common.EnvVars) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		expanded := make(map[string]any, len(typed))
+		for k, v := range typed {
+			expanded[k] = expandEnvVars(v, envVars)
+		}
+
+		return expanded
+	case []any:
+		expanded := make([]any, len(typed))
+		for i, v := range typed {
+			expanded[i] = expandEnvVars(v, envVars)
+		}
+
+		return expanded
+	case string:
+		return envVarPlaceholderAnyRegexp.ReplaceAllStringFunc(typed, func(placeholder string) string {
+			name := envVarPlaceholderAnyRegexp.FindStringSubmatch(placeholder)[1]
+			if resolved, ok := envVars[name]; ok {
+				return string(resolved)
+			}
+
+			return placeholder
+		})
+	default:
+		return value
+	}
+}
+
+func validateOAuth2Extensions(cfg *common.Config, envVars common.EnvVars) error {
+	for _, id := range sortedKeys(cfg.Extensions) {
+		if !strings.HasPrefix(id, oauth2ExtensionType+"/") && id != oauth2ExtensionType {
+			continue
+		}
+
+		raw, err := toStringMap(cfg.Extensions[id])
+		if err != nil {
+			return fmt.Errorf("invalid configuration of extension '%s': %w", id, err)
+		}
+
+		for _, key := range []string{"client_id", "client_secret", "token_url"} {
+			value, err := resolveRequiredValue(raw, key, envVars)
+			if err != nil {
+				return fmt.Errorf("invalid configuration of extension '%s': %w", id, err)
+			}
+
+			if key == "token_url" {
+				if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+					return fmt.Errorf("invalid configuration of extension '%s': token_url is not a valid URL", id)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveRequiredValue returns the value of the given key. Environment variable placeholders are resolved against the
+// environment variables of the collector, so that missing or empty variables are detected as well.
+func resolveRequiredValue(raw map[string]any, key string, envVars common.EnvVars) (string, error) {
+	value, _ := raw[key].(string)
+	if value == "" {
+		return "", fmt.Errorf("%s is missing", key)
+	}
+
+	match := envVarPlaceholderRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return value, nil
+	}
+
+	resolved, ok := envVars[match[1]]
+	if !ok || len(resolved) == 0 {
+		return "", fmt.Errorf("%s references the missing environment variable '%s'", key, match[1])
+	}
+
+	return string(resolved), nil
+}
+
+// toStringMap converts a rendered component configuration (typically a struct with yaml tags) into the generic
+// representation which is read by the collector.
+func toStringMap(rendered any) (map[string]any, error) {
+	if rendered == nil {
+		return map[string]any{}, nil
+	}
+
+	bytes, err := yaml.Marshal(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal component config: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(bytes, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal component config: %w", err)
+	}
+
+	if raw == nil {
+		raw = map[string]any{}
+	}
+
+	return raw, nil
+}
+
+func isDefined(id string, components ...map[string]any) bool {
+	for _, c := range components {
+		if _, ok := c[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,263 @@
+package collectorconfig
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+// goldenFilesNotDeployable lists golden files of the config builder tests, which are not meant to be rolled out.
+// They cover the config rendering only, for example with OTTL placeholders that are not valid for the signal type.
+var goldenFilesNotDeployable = []string{
+	"logagent/user-defined-transform-filter.yaml",
+	"logagent/user-defined-transforms.yaml",
+	"metricagent/oauth2-authentication.yaml", // pipeline without agent input
+	"metricagent/setup-comprehensive.yaml",
+	"metricagent/user-defined-filters.yaml",
+	"metricagent/user-defined-transform-filter.yaml",
+	"metricagent/user-defined-transforms.yaml",
+	"otlpgateway/metric-comprehensive.yaml",
+	"otlpgateway/user-defined-transform-filter.yaml",
+	"otlpgateway/user-defined-transforms.yaml",
+}
+
+func TestValidate_GoldenFiles(t *testing.T) {
+	sut := NewValidator()
+
+	for _, dir := range []string{"logagent", "metricagent", "otlpgateway"} {
+		files, err := filepath.Glob(filepath.Join("..", "..", "otelcollector", "config", dir, "testdata", "*.yaml"))
+		require.NoError(t, err)
+		require.NotEmpty(t, files)
+
+		for _, file := range files {
+			name := dir + "/" + filepath.Base(file)
+
+			t.Run(name, func(t *testing.T) {
+				cfg, envVars := readConfig(t, file)
+
+				err := sut.Validate(cfg, envVars)
+				if slices.Contains(goldenFilesNotDeployable, name) {
+					require.True(t, IsConfigInvalidError(err))
+					return
+				}
+
+				require.NoError(t, err)
+			})
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(cfg *common.Config, envVars common.EnvVars)
+		expectedError string
+	}{
+		{
+			name:   "valid config",
+			modify: func(_ *common.Config, _ common.EnvVars) {},
+		},
+		{
+			name: "undefined exporter",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				delete(cfg.Exporters, "otlp_http/tracepipeline-test-trace")
+			},
+			expectedError: "service pipeline 'traces/test-trace' references undefined exporter 'otlp_http/tracepipeline-test-trace'",
+		},
+		{
+			name: "undefined processor",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				delete(cfg.Processors, "batch")
+			},
+			expectedError: "references undefined processor 'batch'",
+		},
+		{
+			name: "undefined extension",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				delete(cfg.Extensions, "oauth2client/tracepipeline-test-trace")
+			},
+			expectedError: "service references undefined extension 'oauth2client/tracepipeline-test-trace'",
+		},
+		{
+			name: "processor type not part of the collector image",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Processors["exponential_histogram_converter"] = map[string]any{"max_size": 160}
+			},
+			expectedError: "processor 'exponential_histogram_converter' has the type 'exponential_histogram_converter', which is not part of the collector image",
+		},
+		{
+			name: "connector type not part of the collector image",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Connectors["spanmetrics"] = map[string]any{}
+			},
+			expectedError: "connector 'spanmetrics' has the type 'spanmetrics', which is not part of the collector image",
+		},
+		{
+			name: "unknown field in exporter",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Exporters["otlp_http/tracepipeline-test-trace"] = map[string]any{
+					"endpoint": "https://backend.example.com:4318",
+					"unknown":  true,
+				}
+			},
+			expectedError: "invalid configuration of exporter 'otlp_http/tracepipeline-test-trace'",
+		},
+		{
+			name: "invalid batch processor config",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Processors["batch"] = map[string]any{
+					"send_batch_size":     1024,
+					"send_batch_max_size": 512,
+				}
+			},
+			expectedError: "invalid configuration of processor 'batch'",
+		},
+		{
+			name: "exporter with deprecated type alias",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Exporters["otlphttp/alias"] = map[string]any{"endpoint": "https://backend.example.com:4318"}
+			},
+		},
+		{
+			name: "exporter endpoint from missing environment variable",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Exporters["otlp_grpc/missing-endpoint"] = map[string]any{"endpoint": "${OTLP_ENDPOINT_MISSING}"}
+			},
+			expectedError: "invalid configuration of exporter 'otlp_grpc/missing-endpoint'",
+		},
+		{
+			name: "pipeline without exporters",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				p := cfg.Service.Pipelines["traces/test-trace"]
+				p.Exporters = nil
+				cfg.Service.Pipelines["traces/test-trace"] = p
+			},
+			expectedError: "service pipeline 'traces/test-trace' has no exporters",
+		},
+		{
+			name: "invalid OTTL statement in transform processor",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Processors["transform/user-defined-test-trace"] = map[string]any{
+					"error_mode": "ignore",
+					"trace_statements": []any{
+						map[string]any{"statements": []any{`set(span.attributes["foo"], "bar"`}},
+					},
+				}
+			},
+			expectedError: "invalid configuration of processor 'transform/user-defined-test-trace'",
+		},
+		{
+			name: "unknown field in filter processor",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Processors["filter/user-defined-test-trace"] = map[string]any{
+					"unknown": true,
+				}
+			},
+			expectedError: "invalid configuration of processor 'filter/user-defined-test-trace'",
+		},
+		{
+			name: "OAuth2 extension without client secret",
+			modify: func(cfg *common.Config, _ common.EnvVars) {
+				cfg.Extensions["oauth2client/tracepipeline-test-trace"] = &common.OAuth2ExtensionConfig{
+					TokenURL: "https://auth.example.com/token",
+					ClientID: "client",
+				}
+			},
+			expectedError: "invalid configuration of extension 'oauth2client/tracepipeline-test-trace': client_secret is missing",
+		},
+		{
+			name: "OAuth2 extension referencing missing environment variable",
+			modify: func(_ *common.Config, envVars common.EnvVars) {
+				delete(envVars, "OAUTH2_CLIENT_ID_TRACEPIPELINE_TEST_TRACE")
+			},
+			expectedError: "client_id references the missing environment variable 'OAUTH2_CLIENT_ID_TRACEPIPELINE_TEST_TRACE'",
+		},
+		{
+			name: "OAuth2 extension with invalid token URL",
+			modify: func(_ *common.Config, envVars common.EnvVars) {
+				envVars["OAUTH2_TOKEN_URL_TRACEPIPELINE_TEST_TRACE"] = []byte("not-a-url")
+			},
+			expectedError: "token_url is not a valid URL",
+		},
+	}
+
+	sut := NewValidator()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, envVars := readConfig(t, filepath.Join("..", "..", "otelcollector", "config", "otlpgateway", "testdata", "oauth2-authentication.yaml"))
+			tt.modify(cfg, envVars)
+
+			err := sut.Validate(cfg, envVars)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.True(t, IsConfigInvalidError(err))
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestComponentTypeLists(t *testing.T) {
+	sut := NewValidator()
+
+	for kind, types := range componentTypesWithoutFactory {
+		for _, typ := range types {
+			_, linked := sut.factories[kind][component.MustNewType(typ)]
+			require.False(t, linked, "%s type '%s' has a linked factory and must be removed from componentTypesWithoutFactory", kind, typ)
+		}
+	}
+
+	for kind, types := range componentTypesWithoutConfigValidation {
+		for _, typ := range types {
+			factory, linked := sut.factories[kind][component.MustNewType(typ)]
+			require.True(t, linked, "%s type '%s' has no linked factory", kind, typ)
+			require.Equal(t, typ, factory.Type().String(), "%s type '%s' must be listed with the type of the factory", kind, typ)
+		}
+	}
+}
+
+var placeholderRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_]+)\}`)
+
+// envVarValue returns a realistic value for an environment variable referenced by a golden config file,
+// so that the configuration passes the validation of the component factories.
+func envVarValue(name string) string {
+	switch {
+	case strings.HasPrefix(name, "OTLP_ENDPOINT"):
+		return "https://backend.example.com:4317"
+	case strings.HasPrefix(name, "PROXY_URL"):
+		return "http://proxy.example.com:3128"
+	default:
+		return "https://auth.example.com/token"
+	}
+}
+
+// readConfig reads a golden config file and provides values for all referenced environment variables.
+func readConfig(t *testing.T, path string) (*common.Config, common.EnvVars) {
+	t.Helper()
+
+	bytes, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var cfg common.Config
+	require.NoError(t, yaml.Unmarshal(bytes, &cfg))
+
+	envVars := make(common.EnvVars)
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(string(bytes), -1) {
+		envVars[match[1]] = []byte(envVarValue(match[1]))
+	}
+
+	return &cfg, envVars
+}
@@ -0,0 +1,39 @@
+package collectorconfig
+
+// componentTypesWithoutFactory lists the component types of the OpenTelemetry Collector image of the Telemetry module,
+// whose factories are not linked into the manager. The components with a linked factory are registered in NewValidator.
+// These are the components of the opentelemetry-collector-components repository (kymastats, istio_enrichment, istio_noise_filter,
+// service_enrichment) and upstream components, whose modules are not released for the collector version of the manager or
+// depend on a different Kubernetes client version.
+// The collector rejects a configuration that contains any other component type at startup, so every generated component
+// must either have a linked factory or be listed here.
+var componentTypesWithoutFactory = map[string][]string{
+	kindReceiver: {
+		"k8s_cluster",
+		"kubelet_stats",
+		"kymastats",
+	},
+	kindProcessor: {
+		"istio_enrichment",
+		"istio_noise_filter",
+		"k8s_attributes",
+		"service_enrichment",
+	},
+	kindConnector: {
+		"routing",
+	},
+	kindExtension: {
+		"cgroup_runtime",
+		"k8s_leader_elector",
+		"pprof",
+	},
+}
+
+// componentTypesWithoutConfigValidation lists the component types with a linked factory, whose configuration is only loaded but not validated.
+// Their validation checks files of the collector Pod (certificates, the root file system of the host), which don't exist in the manager.
+var componentTypesWithoutConfigValidation = map[string][]string{
+	kindReceiver: {
+		"host_metrics",
+		"prometheus",
+	},
+}
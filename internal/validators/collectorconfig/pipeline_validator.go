@@ -0,0 +1,85 @@
+package collectorconfig
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/logagent"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metricagent"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpgateway"
+	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
+)
+
+// PipelineValidator renders the OTLP Gateway configuration and, for pipelines with agent inputs, the agent configuration
+// for a single pipeline and validates them.
+// This attributes an invalid shared collector configuration to the pipeline that causes it, so that the pipeline can be
+// excluded from the configuration.
+type PipelineValidator struct {
+	configBuilder            *otlpgateway.Builder
+	logAgentConfigBuilder    *logagent.Builder
+	metricAgentConfigBuilder *metricagent.Builder
+	validator                *Validator
+}
+
+func NewPipelineValidator(reader client.Reader) *PipelineValidator {
+	return &PipelineValidator{
+		configBuilder:            &otlpgateway.Builder{Reader: reader},
+		logAgentConfigBuilder:    &logagent.Builder{Reader: reader},
+		metricAgentConfigBuilder: &metricagent.Builder{Reader: reader},
+		validator:                NewValidator(),
+	}
+}
+
+// ValidateTracePipeline returns a ConfigInvalidError if the OTLP Gateway configuration generated for the TracePipeline is invalid.
+func (v *PipelineValidator) ValidateTracePipeline(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) error {
+	return v.validate(ctx, otlpgateway.BuildOptions{TracePipelines: []telemetryv1beta1.TracePipeline{*pipeline}})
+}
+
+// ValidateMetricPipeline returns a ConfigInvalidError if the OTLP Gateway or Metric Agent configuration generated for the MetricPipeline is invalid.
+func (v *PipelineValidator) ValidateMetricPipeline(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
+	if err := v.validate(ctx, otlpgateway.BuildOptions{MetricPipelines: []telemetryv1beta1.MetricPipeline{*pipeline}}); err != nil {
+		return err
+	}
+
+	if !metricpipelineutils.IsAgentRequired(pipeline) {
+		return nil
+	}
+
+	cfg, envVars, err := v.metricAgentConfigBuilder.Build(ctx, []telemetryv1beta1.MetricPipeline{*pipeline}, metricagent.BuildOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to build agent config: %w", err)
+	}
+
+	return v.validator.Validate(cfg, envVars)
+}
+
+// ValidateLogPipeline returns a ConfigInvalidError if the OTLP Gateway or Log Agent configuration generated for the LogPipeline is invalid.
+func (v *PipelineValidator) ValidateLogPipeline(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
+	if err := v.validate(ctx, otlpgateway.BuildOptions{LogPipelines: []telemetryv1beta1.LogPipeline{*pipeline}}); err != nil {
+		return err
+	}
+
+	if !logpipelineutils.IsAgentRequired(pipeline) {
+		return nil
+	}
+
+	cfg, envVars, err := v.logAgentConfigBuilder.Build(ctx, []telemetryv1beta1.LogPipeline{*pipeline}, logagent.BuildOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to build agent config: %w", err)
+	}
+
+	return v.validator.Validate(cfg, envVars)
+}
+
+func (v *PipelineValidator) validate(ctx context.Context, opts otlpgateway.BuildOptions) error {
+	cfg, envVars, err := v.configBuilder.Build(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to build config: %w", err)
+	}
+
+	return v.validator.Validate(cfg, envVars)
+}
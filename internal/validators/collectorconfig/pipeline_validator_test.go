@@ -0,0 +1,61 @@
+package collectorconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestPipelineValidator(t *testing.T) {
+	sut := NewPipelineValidator(fake.NewClientBuilder().Build())
+
+	t.Run("valid pipelines", func(t *testing.T) {
+		tracePipeline := testutils.NewTracePipelineBuilder().WithName("test").Build()
+		require.NoError(t, sut.ValidateTracePipeline(t.Context(), &tracePipeline))
+
+		metricPipeline := testutils.NewMetricPipelineBuilder().WithName("test").Build()
+		require.NoError(t, sut.ValidateMetricPipeline(t.Context(), &metricPipeline))
+
+		logPipeline := testutils.NewLogPipelineBuilder().WithName("test").WithOTLPOutput().Build()
+		require.NoError(t, sut.ValidateLogPipeline(t.Context(), &logPipeline))
+	})
+
+	t.Run("valid pipelines with agent inputs", func(t *testing.T) {
+		metricPipeline := testutils.NewMetricPipelineBuilder().WithName("test").WithRuntimeInput(true).WithPrometheusInput(true).Build()
+		require.NoError(t, sut.ValidateMetricPipeline(t.Context(), &metricPipeline))
+
+		logPipeline := testutils.NewLogPipelineBuilder().WithName("test").WithRuntimeInput(true).WithOTLPOutput().Build()
+		require.NoError(t, sut.ValidateLogPipeline(t.Context(), &logPipeline))
+	})
+
+	t.Run("log pipeline with agent input and statement rejected by the transform processor", func(t *testing.T) {
+		pipeline := testutils.NewLogPipelineBuilder().
+			WithName("test").
+			WithRuntimeInput(true).
+			WithOTLPOutput().
+			WithTransform(telemetryv1beta1.TransformSpec{
+				Statements: []string{`set(log.attributes["foo"], "bar"`},
+			}).
+			Build()
+
+		err := sut.ValidateLogPipeline(t.Context(), &pipeline)
+		require.True(t, IsConfigInvalidError(err))
+	})
+
+	t.Run("pipeline with statement rejected by the transform processor", func(t *testing.T) {
+		pipeline := testutils.NewTracePipelineBuilder().
+			WithName("test").
+			WithTransform(telemetryv1beta1.TransformSpec{
+				Statements: []string{`set(span.attributes["foo"], "bar"`},
+			}).
+			Build()
+
+		err := sut.ValidateTracePipeline(t.Context(), &pipeline)
+		require.True(t, IsConfigInvalidError(err))
+		require.ErrorContains(t, err, "invalid configuration of processor 'transform/tracepipeline-user-defined-test'")
+	})
+}
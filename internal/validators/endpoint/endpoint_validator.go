@@ -25,6 +25,7 @@ type EndpointValidationParams struct {
 	Protocol   telemetryv1beta1.OTLPProtocol
 	OutputTLS  *telemetryv1beta1.OutputTLS
 	OTLPOAuth2 *telemetryv1beta1.OAuth2Options
+	ProxyURL   *telemetryv1beta1.ValueType
 }
 
 type Validator struct {
@@ -38,6 +39,8 @@ var (
 	ErrGRPCOAuth2NoTLS    = errors.New("OAuth2 requires TLS when using gRPC protocol")
 	ErrHTTPWithTLS        = errors.New("HTTP scheme with TLS not allowed")
 	ErrGRPCWithPath       = errors.New("gRPC endpoints cannot contain paths")
+	ErrGRPCWithProxy      = errors.New("proxy is only supported with HTTP protocol")
+	ErrProxyInvalid       = errors.New("proxy URL must be an absolute URL with HTTP or HTTPS scheme and without path and user info")
 )
 
 type EndpointInvalidError struct {
@@ -121,9 +124,40 @@ func (v *Validator) Validate(ctx context.Context, params EndpointValidationParam
 		}
 	}
 
+	// proxy validation
+	if params.ProxyURL != nil {
+		if err := v.validateProxyURL(ctx, *params.ProxyURL, params.Protocol); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (v *Validator) validateProxyURL(ctx context.Context, proxyURL telemetryv1beta1.ValueType, protocol telemetryv1beta1.OTLPProtocol) error {
+	// The gRPC exporter has no proxy setting
+	if protocol != OTLPProtocolHTTP {
+		return &EndpointInvalidError{Err: ErrGRPCWithProxy}
+	}
+
+	proxyURLValue, err := resolveValue(ctx, v.Client, proxyURL)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(proxyURLValue)
+	if err != nil {
+		return &EndpointInvalidError{Err: ErrProxyInvalid}
+	}
+
+	// The HTTP client of the exporter ignores the path of a proxy URL, and the proxy credentials are configured separately
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") || u.User != nil {
+		return &EndpointInvalidError{Err: ErrProxyInvalid}
+	}
+
+	return validatePort(u.Host, true)
+}
+
 func resolveValue(ctx context.Context, c client.Reader, value telemetryv1beta1.ValueType) (string, error) {
 	if value.Value != "" {
 		return value.Value, nil
@@ -0,0 +1,72 @@
+package prometheusoutput
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
+)
+
+type PrometheusOutputInUseError struct {
+	Err error
+}
+
+func (e *PrometheusOutputInUseError) Error() string {
+	return e.Err.Error()
+}
+
+func IsPrometheusOutputInUseError(err error) bool {
+	var errPrometheusOutputInUse *PrometheusOutputInUseError
+	return errors.As(err, &errPrometheusOutputInUse)
+}
+
+// Validator ensures that only one MetricPipeline exposes a Prometheus output, because all pipelines share the same exporter port.
+// If several pipelines define a Prometheus output, the oldest one keeps it and all others are rejected.
+type Validator struct {
+	Client client.Reader
+}
+
+func (v *Validator) Validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
+	if !metricpipelineutils.IsPrometheusOutputDefined(pipeline.Spec.Output) {
+		return nil
+	}
+
+	var allPipelines telemetryv1beta1.MetricPipelineList
+	if err := v.Client.List(ctx, &allPipelines); err != nil {
+		return &errortypes.APIRequestFailedError{
+			Err: fmt.Errorf("failed to list MetricPipelines: %w", err),
+		}
+	}
+
+	for i := range allPipelines.Items {
+		other := &allPipelines.Items[i]
+		if other.Name == pipeline.Name || other.DeletionTimestamp != nil {
+			continue
+		}
+
+		if !metricpipelineutils.IsPrometheusOutputDefined(other.Spec.Output) {
+			continue
+		}
+
+		if isOlder(other, pipeline) {
+			return &PrometheusOutputInUseError{
+				Err: fmt.Errorf("the Prometheus output is already used by MetricPipeline '%s'. Only one MetricPipeline can define a Prometheus output", other.Name),
+			}
+		}
+	}
+
+	return nil
+}
+
+func isOlder(a, b *telemetryv1beta1.MetricPipeline) bool {
+	if a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.Name < b.Name
+	}
+
+	return a.CreationTimestamp.Before(&b.CreationTimestamp)
+}
@@ -0,0 +1,117 @@
+package prometheusoutput
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+
+	newPipeline := func(name string, created time.Time, prometheus bool) *telemetryv1beta1.MetricPipeline {
+		builder := testutils.NewMetricPipelineBuilder().WithName(name)
+		if prometheus {
+			builder = builder.WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{})
+		}
+
+		pipeline := builder.Build()
+		pipeline.CreationTimestamp = metav1.NewTime(created)
+
+		return &pipeline
+	}
+
+	tests := []struct {
+		name      string
+		pipeline  *telemetryv1beta1.MetricPipeline
+		existing  []client.Object
+		expectErr bool
+	}{
+		{
+			name:     "pipeline without prometheus output",
+			pipeline: newPipeline("b", now, false),
+			existing: []client.Object{newPipeline("a", now.Add(-time.Hour), true)},
+		},
+		{
+			name:     "only pipeline with prometheus output",
+			pipeline: newPipeline("b", now, true),
+			existing: []client.Object{newPipeline("a", now.Add(-time.Hour), false)},
+		},
+		{
+			name:      "older pipeline already uses prometheus output",
+			pipeline:  newPipeline("b", now, true),
+			existing:  []client.Object{newPipeline("a", now.Add(-time.Hour), true)},
+			expectErr: true,
+		},
+		{
+			name:     "newer pipeline also uses prometheus output",
+			pipeline: newPipeline("a", now.Add(-time.Hour), true),
+			existing: []client.Object{newPipeline("b", now, true)},
+		},
+		{
+			name:      "same creation timestamp, lower name wins",
+			pipeline:  newPipeline("b", now, true),
+			existing:  []client.Object{newPipeline("a", now, true)},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := append([]client.Object{tt.pipeline}, tt.existing...)
+			fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).Build()
+
+			sut := &Validator{Client: fakeClient}
+
+			err := sut.Validate(t.Context(), tt.pipeline)
+			if tt.expectErr {
+				require.True(t, IsPrometheusOutputInUseError(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_ListFails(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+				return errors.New("transient error")
+			},
+		}).
+		Build()
+
+	sut := &Validator{Client: fakeClient}
+
+	pipeline := testutils.NewMetricPipelineBuilder().
+		WithPrometheusOutput(telemetryv1beta1.MetricPipelinePrometheusOutput{}).
+		Build()
+
+	err := sut.Validate(t.Context(), &pipeline)
+
+	apiRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err)
+	require.NotNil(t, apiRequestFailed)
+}
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, telemetryv1beta1.AddToScheme(scheme))
+
+	return scheme
+}
@@ -43,6 +43,13 @@ func TestValidate(t *testing.T) {
 				WithRuntimeInputAdditionalMetrics("k8s.container.status.state").
 				Build(),
 		},
+		{
+			name: "valid k8scluster resourcequota metric",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithRuntimeInput(true).
+				WithRuntimeInputAdditionalMetrics("k8s.resource_quota.used").
+				Build(),
+		},
 		{
 			name: "invalid metric",
 			pipeline: testutils.NewMetricPipelineBuilder().
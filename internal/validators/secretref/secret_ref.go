@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 )
@@ -23,6 +24,7 @@ var (
 	ErrSecretKeyNotFound      = errors.New("one or more keys in a referenced Secret are missing")
 	ErrSecretRefNotFound      = errors.New("one or more referenced Secrets are missing")
 	ErrSecretRefMissingFields = errors.New("secret reference is missing field/s")
+	ErrSecretRefNamespace     = errors.New("referenced Secrets must be located in the namespace of the resource")
 )
 
 // ValidateTracePipeline validates the secret references in a TracePipeline, ensuring that the references are valid,
@@ -43,6 +45,21 @@ func (v *Validator) ValidateLogPipeline(ctx context.Context, pipeline *telemetry
 	return v.validate(ctx, GetSecretRefsLogPipeline(pipeline))
 }
 
+// ValidateTelemetryRoute validates the secret references in a TelemetryRoute, ensuring that the referenced Secrets are located
+// in the namespace of the route, are valid, exist and contain the required keys. It returns an error otherwise.
+// Restricting the references to the own namespace prevents a route from exposing Secrets of other tenants.
+func (v *Validator) ValidateTelemetryRoute(ctx context.Context, route *telemetryv1beta1.TelemetryRoute) error {
+	refs := GetSecretRefsTelemetryRoute(route)
+
+	for _, ref := range refs {
+		if ref.Namespace != route.Namespace {
+			return fmt.Errorf("%w: Secret '%s' of Namespace '%s' is not in Namespace '%s'", ErrSecretRefNamespace, ref.Name, ref.Namespace, route.Namespace)
+		}
+	}
+
+	return v.validate(ctx, refs)
+}
+
 func (v *Validator) validate(ctx context.Context, refs []telemetryv1beta1.SecretKeyRef) error {
 	for _, ref := range refs {
 		if _, err := GetValue(ctx, v.Client, ref); err != nil {
@@ -99,21 +116,71 @@ func checkForMissingFields(ref telemetryv1beta1.SecretKeyRef) error {
 }
 
 func GetSecretRefsTracePipeline(tp *telemetryv1beta1.TracePipeline) []telemetryv1beta1.SecretKeyRef {
-	return getSecretRefsInOTLPOutput(tp.Spec.Output.OTLP)
+	return append(getSecretRefsInOTLPOutput(tp.Spec.Output.OTLP), getSecretRefsInOutputRoutes(tp.Spec.Routes)...)
 }
 
 func GetSecretRefsMetricPipeline(mp *telemetryv1beta1.MetricPipeline) []telemetryv1beta1.SecretKeyRef {
-	return getSecretRefsInOTLPOutput(&mp.Spec.Output.OTLP.OTLPOutput)
+	if mp.Spec.Output.OTLP == nil {
+		return nil
+	}
+
+	return append(getSecretRefsInOTLPOutput(&mp.Spec.Output.OTLP.OTLPOutput), getSecretRefsInOutputRoutes(mp.Spec.Routes)...)
 }
 
 func GetSecretRefsLogPipeline(lp *telemetryv1beta1.LogPipeline) []telemetryv1beta1.SecretKeyRef {
 	if lp.Spec.Output.OTLP != nil {
-		return getSecretRefsInOTLPOutput(lp.Spec.Output.OTLP)
+		return append(getSecretRefsInOTLPOutput(lp.Spec.Output.OTLP), getSecretRefsInOutputRoutes(lp.Spec.Routes)...)
 	}
 
 	return getSecretRefsFluentBitLogPipeline(lp)
 }
 
+func GetSecretRefsTelemetryRoute(tr *telemetryv1beta1.TelemetryRoute) []telemetryv1beta1.SecretKeyRef {
+	if tr.Spec.Output.OTLP == nil {
+		return nil
+	}
+
+	return getSecretRefsInOTLPOutput(tr.Spec.Output.OTLP)
+}
+
+// GetSecretRefsExternalIngestion returns the secret references of the external ingestion configuration of the Telemetry CR.
+func GetSecretRefsExternalIngestion(spec *operatorv1beta1.ExternalIngestionSpec) []telemetryv1beta1.SecretKeyRef {
+	if spec == nil {
+		return nil
+	}
+
+	var operatorRefs []operatorv1beta1.SecretKeyRef
+
+	if spec.Authentication.BearerToken != nil {
+		operatorRefs = append(operatorRefs, *spec.Authentication.BearerToken)
+	}
+
+	if spec.Authentication.MTLS != nil {
+		operatorRefs = append(operatorRefs, spec.Authentication.MTLS.ClientCA)
+	}
+
+	if spec.TLS != nil {
+		operatorRefs = append(operatorRefs, spec.TLS.Cert, spec.TLS.Key)
+	}
+
+	refs := make([]telemetryv1beta1.SecretKeyRef, 0, len(operatorRefs))
+	for _, ref := range operatorRefs {
+		refs = append(refs, telemetryv1beta1.SecretKeyRef{Name: ref.Name, Namespace: ref.Namespace, Key: ref.Key})
+	}
+
+	return refs
+}
+
+func getSecretRefsInOutputRoutes(routes []telemetryv1beta1.OutputRoute) []telemetryv1beta1.SecretKeyRef {
+	var refs []telemetryv1beta1.SecretKeyRef
+
+	for i := range routes {
+		refs = append(refs, getSecretRefsInOTLPOutput(routes[i].Output.OTLP)...)
+	}
+
+	return refs
+}
+
 func getSecretRefsFluentBitLogPipeline(lp *telemetryv1beta1.LogPipeline) []telemetryv1beta1.SecretKeyRef {
 	var refs []telemetryv1beta1.SecretKeyRef
 
@@ -167,6 +234,13 @@ func getSecretRefsInOTLPOutput(otlpOut *telemetryv1beta1.OTLPOutput) []telemetry
 		refs = appendIfSecretRef(refs, &header.ValueType)
 	}
 
+	refs = appendIfSecretRef(refs, otlpOut.ProxyURL)
+
+	if otlpOut.ProxyAuthentication != nil && otlpOut.ProxyAuthentication.Basic != nil {
+		refs = appendIfSecretRef(refs, &otlpOut.ProxyAuthentication.Basic.User)
+		refs = appendIfSecretRef(refs, &otlpOut.ProxyAuthentication.Basic.Password)
+	}
+
 	if otlpOut.TLS != nil && !otlpOut.TLS.Insecure {
 		refs = appendIfSecretRef(refs, otlpOut.TLS.CA)
 		refs = appendIfSecretRef(refs, otlpOut.TLS.Cert)
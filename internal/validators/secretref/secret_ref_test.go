@@ -640,7 +640,146 @@ func TestGetSecretRefsInOTLPOutput_TLSFieldsAndInsecure(t *testing.T) {
 	require.Empty(t, refs)
 }
 
+func TestGetSecretRefsInOTLPOutput_ProxyFields(t *testing.T) {
+	otlp := &telemetryv1beta1.OTLPOutput{
+		Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+		ProxyURL: &telemetryv1beta1.ValueType{ValueFrom: &telemetryv1beta1.ValueFromSource{SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "proxy", Key: "url"}}},
+		ProxyAuthentication: &telemetryv1beta1.ProxyAuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{
+				User:     telemetryv1beta1.ValueType{Value: "proxy-user"},
+				Password: telemetryv1beta1.ValueType{ValueFrom: &telemetryv1beta1.ValueFromSource{SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "proxy", Key: "password"}}},
+			},
+		},
+	}
+	refs := getSecretRefsInOTLPOutput(otlp)
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "proxy", Key: "url"},
+		{Name: "proxy", Key: "password"},
+	}, refs)
+}
+
+func TestGetSecretRefs_OutputRoutes(t *testing.T) {
+	secretValue := func(name, key string) telemetryv1beta1.ValueType {
+		return telemetryv1beta1.ValueType{ValueFrom: &telemetryv1beta1.ValueFromSource{SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: name, Namespace: "default", Key: key}}}
+	}
+	routes := []telemetryv1beta1.OutputRoute{
+		{
+			Name:       "errors",
+			Conditions: []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"},
+			Output: telemetryv1beta1.OutputRouteOutput{
+				OTLP: &telemetryv1beta1.OTLPOutput{
+					Endpoint: secretValue("errors", "endpoint"),
+					Headers:  []telemetryv1beta1.Header{{Name: "x-token", ValueType: secretValue("errors", "token")}},
+				},
+			},
+		},
+	}
+	expected := []telemetryv1beta1.SecretKeyRef{
+		{Name: "default-output", Namespace: "default", Key: "endpoint"},
+		{Name: "errors", Namespace: "default", Key: "endpoint"},
+		{Name: "errors", Namespace: "default", Key: "token"},
+	}
+
+	t.Run("trace pipeline", func(t *testing.T) {
+		tp := telemetryv1beta1.TracePipeline{Spec: telemetryv1beta1.TracePipelineSpec{
+			Output: telemetryv1beta1.TracePipelineOutput{OTLP: &telemetryv1beta1.OTLPOutput{Endpoint: secretValue("default-output", "endpoint")}},
+			Routes: routes,
+		}}
+		require.ElementsMatch(t, expected, GetSecretRefsTracePipeline(&tp))
+	})
+
+	t.Run("metric pipeline", func(t *testing.T) {
+		mp := telemetryv1beta1.MetricPipeline{Spec: telemetryv1beta1.MetricPipelineSpec{
+			Output: telemetryv1beta1.MetricPipelineOutput{OTLP: &telemetryv1beta1.MetricPipelineOTLPOutput{OTLPOutput: telemetryv1beta1.OTLPOutput{Endpoint: secretValue("default-output", "endpoint")}}},
+			Routes: routes,
+		}}
+		require.ElementsMatch(t, expected, GetSecretRefsMetricPipeline(&mp))
+	})
+
+	t.Run("log pipeline", func(t *testing.T) {
+		lp := telemetryv1beta1.LogPipeline{Spec: telemetryv1beta1.LogPipelineSpec{
+			Output: telemetryv1beta1.LogPipelineOutput{OTLP: &telemetryv1beta1.OTLPOutput{Endpoint: secretValue("default-output", "endpoint")}},
+			Routes: routes,
+		}}
+		require.ElementsMatch(t, expected, GetSecretRefsLogPipeline(&lp))
+	})
+}
+
 func TestAppendIfSecretRef_NonSecretValue(t *testing.T) {
 	refs := appendIfSecretRef(nil, &telemetryv1beta1.ValueType{Value: "not-a-secret"})
 	require.Empty(t, refs)
 }
+
+func TestValidateTelemetryRoute(t *testing.T) {
+	existingSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "team-a",
+		},
+		Data: map[string][]byte{
+			"myKey": []byte("myValue"),
+		},
+	}
+	foreignSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "team-b",
+		},
+		Data: map[string][]byte{
+			"myKey": []byte("myValue"),
+		},
+	}
+
+	tests := []struct {
+		name        string
+		secretNs    string
+		secretKey   string
+		expectError error
+	}{
+		{
+			name:        "secret in same namespace",
+			secretNs:    "team-a",
+			secretKey:   "myKey",
+			expectError: nil,
+		},
+		{
+			name:        "secret in other namespace",
+			secretNs:    "team-b",
+			secretKey:   "myKey",
+			expectError: ErrSecretRefNamespace,
+		},
+		{
+			name:        "secret key not present",
+			secretNs:    "team-a",
+			secretKey:   "notExistent",
+			expectError: ErrSecretKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := &telemetryv1beta1.TelemetryRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-route", Namespace: "team-a"},
+				Spec: telemetryv1beta1.TelemetryRouteSpec{
+					Output: telemetryv1beta1.TelemetryRouteOutput{
+						OTLP: &telemetryv1beta1.OTLPOutput{
+							Endpoint: telemetryv1beta1.ValueType{
+								ValueFrom: &telemetryv1beta1.ValueFromSource{
+									SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "my-secret", Namespace: tt.secretNs, Key: tt.secretKey},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithObjects(&existingSecret, &foreignSecret).Build()
+			secretRefValidator := Validator{
+				Client: client,
+			}
+
+			err := secretRefValidator.ValidateTelemetryRoute(t.Context(), route)
+			require.ErrorIs(t, err, tt.expectError)
+		})
+	}
+}
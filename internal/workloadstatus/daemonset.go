@@ -2,13 +2,22 @@ package workloadstatus
 
 import (
 	"context"
+	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	reasonCrashLoopBackOff = "CrashLoopBackOff"
+
+	// labelKeyPodTemplateGeneration is set by the DaemonSet controller on every Pod to the DaemonSet generation the Pod was created for.
+	labelKeyPodTemplateGeneration = "pod-template-generation"
+)
+
 type DaemonSetProber struct {
 	client.Client
 }
@@ -39,3 +48,57 @@ func (dsp *DaemonSetProber) IsReady(ctx context.Context, name types.NamespacedNa
 	// Assume that there is an update or rollout of pods is in progress
 	return &RolloutInProgressError{}
 }
+
+// IsRolledOut returns true if the DaemonSet controller observed the current generation and all scheduled Pods are updated and ready.
+func (dsp *DaemonSetProber) IsRolledOut(ctx context.Context, name types.NamespacedName) (bool, error) {
+	var ds appsv1.DaemonSet
+	if err := dsp.Get(ctx, name, &ds); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, ErrDaemonSetFetching
+	}
+
+	if ds.Status.ObservedGeneration < ds.Generation {
+		return false, nil
+	}
+
+	desired := ds.Status.DesiredNumberScheduled
+
+	return ds.Status.UpdatedNumberScheduled == desired && ds.Status.NumberReady >= desired, nil
+}
+
+// IsCrashLooping returns true if a container of a Pod of the current DaemonSet generation is in CrashLoopBackOff.
+// Pods of previous generations are ignored, so that a crash loop is only attributed to the latest rollout.
+func (dsp *DaemonSetProber) IsCrashLooping(ctx context.Context, name types.NamespacedName) (bool, error) {
+	var ds appsv1.DaemonSet
+	if err := dsp.Get(ctx, name, &ds); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, ErrDaemonSetFetching
+	}
+
+	var pods corev1.PodList
+	if err := dsp.List(ctx, &pods, client.InNamespace(name.Namespace), client.MatchingLabels(ds.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+
+	currentGeneration := strconv.FormatInt(ds.Generation, 10)
+
+	for _, pod := range pods.Items {
+		if pod.Labels[labelKeyPodTemplateGeneration] != currentGeneration {
+			continue
+		}
+
+		for _, c := range pod.Status.ContainerStatuses {
+			if c.State.Waiting != nil && c.State.Waiting.Reason == reasonCrashLoopBackOff {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -264,3 +264,142 @@ func TestDaemonSetRollout(t *testing.T) {
 	err := sut.IsReady(t.Context(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
 	require.True(t, IsRolloutInProgressError(err))
 }
+
+func TestDaemonSetProber_IsCrashLooping(t *testing.T) {
+	currentGeneration := map[string]string{"app": "foo", "pod-template-generation": "2"}
+	previousGeneration := map[string]string{"app": "foo", "pod-template-generation": "1"}
+
+	tests := []struct {
+		summary  string
+		pods     []corev1.Pod
+		expected bool
+	}{
+		{
+			summary: "all pods running",
+			pods: []corev1.Pod{
+				testutils.NewPodBuilder("pod-0", "telemetry-system").WithLabels(currentGeneration).WithRunningStatus().Build(),
+			},
+			expected: false,
+		},
+		{
+			summary: "pod of current generation crash-loops",
+			pods: []corev1.Pod{
+				testutils.NewPodBuilder("pod-0", "telemetry-system").WithLabels(currentGeneration).WithRunningStatus().Build(),
+				testutils.NewPodBuilder("pod-1", "telemetry-system").WithLabels(currentGeneration).WithCrashBackOffStatus().Build(),
+			},
+			expected: true,
+		},
+		{
+			summary: "pod of previous generation crash-loops",
+			pods: []corev1.Pod{
+				testutils.NewPodBuilder("pod-0", "telemetry-system").WithLabels(currentGeneration).WithRunningStatus().Build(),
+				testutils.NewPodBuilder("pod-1", "telemetry-system").WithLabels(previousGeneration).WithCrashBackOffStatus().Build(),
+			},
+			expected: false,
+		},
+		{
+			summary: "pod of current generation is OOMKilled",
+			pods: []corev1.Pod{
+				testutils.NewPodBuilder("pod-0", "telemetry-system").WithLabels(currentGeneration).WithOOMStatus().Build(),
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.summary, func(t *testing.T) {
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "telemetry-system", Generation: 2},
+				Spec: appsv1.DaemonSetSpec{Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "foo"},
+				}},
+			}
+
+			fakeClient := fake.NewClientBuilder().WithObjects(daemonSet).WithLists(&corev1.PodList{Items: tc.pods}).Build()
+			sut := DaemonSetProber{fakeClient}
+
+			crashLooping, err := sut.IsCrashLooping(t.Context(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, crashLooping)
+		})
+	}
+}
+
+func TestDaemonSetProber_IsCrashLooping_DaemonSetNotFound(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	sut := DaemonSetProber{fakeClient}
+
+	crashLooping, err := sut.IsCrashLooping(t.Context(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
+	require.NoError(t, err)
+	require.False(t, crashLooping)
+}
+
+func TestDaemonSetProber_IsRolledOut(t *testing.T) {
+	tests := []struct {
+		summary            string
+		generation         int64
+		observedGeneration int64
+		desiredScheduled   int32
+		updatedScheduled   int32
+		numberReady        int32
+		expected           bool
+	}{
+		{
+			summary:            "all pods updated and ready",
+			generation:         2,
+			observedGeneration: 2,
+			desiredScheduled:   3,
+			updatedScheduled:   3,
+			numberReady:        3,
+			expected:           true,
+		},
+		{
+			summary:            "current generation not observed yet",
+			generation:         2,
+			observedGeneration: 1,
+			desiredScheduled:   3,
+			updatedScheduled:   3,
+			numberReady:        3,
+			expected:           false,
+		},
+		{
+			summary:            "pods not updated yet",
+			generation:         2,
+			observedGeneration: 2,
+			desiredScheduled:   3,
+			updatedScheduled:   1,
+			numberReady:        3,
+			expected:           false,
+		},
+		{
+			summary:            "pods not ready",
+			generation:         2,
+			observedGeneration: 2,
+			desiredScheduled:   3,
+			updatedScheduled:   3,
+			numberReady:        2,
+			expected:           false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.summary, func(t *testing.T) {
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "telemetry-system", Generation: tc.generation},
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: tc.desiredScheduled,
+					NumberReady:            tc.numberReady,
+					UpdatedNumberScheduled: tc.updatedScheduled,
+					ObservedGeneration:     tc.observedGeneration,
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().WithObjects(daemonSet).Build()
+			sut := DaemonSetProber{fakeClient}
+
+			rolledOut, err := sut.IsRolledOut(t.Context(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rolledOut)
+		})
+	}
+}
@@ -67,11 +67,15 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/metrics"
 	"github.com/kyma-project/telemetry-manager/internal/nodesize"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/heartbeat"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	selfmonitorwebhook "github.com/kyma-project/telemetry-manager/internal/selfmonitor/webhook"
 	"github.com/kyma-project/telemetry-manager/internal/storagemigration"
+	"github.com/kyma-project/telemetry-manager/internal/tap"
 	loggerutils "github.com/kyma-project/telemetry-manager/internal/utils/logger"
 	"github.com/kyma-project/telemetry-manager/internal/vpastatus"
 	"github.com/kyma-project/telemetry-manager/internal/webhookcert"
@@ -106,6 +110,7 @@ var (
 
 const (
 	webhookServiceName = names.ManagerWebhookService
+	eventRecorderName  = "telemetry-manager"
 )
 
 //go:generate bin/envdoc -output docs/config.md -dir . -types=envConfig -files=*.go
@@ -251,10 +256,14 @@ func setupControllersAndWebhooks(mgr manager.Manager, globals config.Global, env
 		tracePipelineReconcileChan  = make(chan event.GenericEvent)
 		metricPipelineReconcileChan = make(chan event.GenericEvent)
 		logPipelineReconcileChan    = make(chan event.GenericEvent)
+		telemetryRouteReconcileChan = make(chan event.GenericEvent)
 		otlpGatewayReconcileChan    = make(chan event.GenericEvent)
+		telemetryReconcileChan      = make(chan event.GenericEvent)
 	)
 
-	secretWatchClient, err := secretwatch.NewClient(mgr.GetConfig(), tracePipelineReconcileChan, metricPipelineReconcileChan, logPipelineReconcileChan)
+	eventRecorder := commonstatus.NewConditionEventRecorder(mgr.GetEventRecorder(eventRecorderName))
+
+	secretWatchClient, err := secretwatch.NewClient(mgr.GetConfig(), tracePipelineReconcileChan, metricPipelineReconcileChan, logPipelineReconcileChan, telemetryRouteReconcileChan, otlpGatewayReconcileChan, telemetryReconcileChan)
 	if err != nil {
 		return fmt.Errorf("failed to create secret watch client: %w", err)
 	}
@@ -263,25 +272,44 @@ func setupControllersAndWebhooks(mgr manager.Manager, globals config.Global, env
 		return fmt.Errorf("failed to add secret watch stop runnable: %w", err)
 	}
 
-	if err := setupTracePipelineController(globals, envCfg, mgr, tracePipelineReconcileChan, secretWatchClient); err != nil {
+	gatewayExporterQuerier, err := prober.NewGatewayExporterQuerier(types.NamespacedName{Name: names.SelfMonitor, Namespace: globals.TargetNamespace()})
+	if err != nil {
+		return fmt.Errorf("failed to create gateway exporter querier: %w", err)
+	}
+
+	heartbeatSender := heartbeat.NewSender(mgr.GetClient(), gatewayExporterQuerier, ctrl.Log, globals.TargetNamespace())
+	if err := mgr.Add(heartbeatSender); err != nil {
+		return fmt.Errorf("failed to add heartbeat sender runnable: %w", err)
+	}
+
+	tapRegistry := tap.NewRegistry(
+		fmt.Sprintf("https://%s.%s.svc:%d", webhookServiceName, globals.ManagerNamespace(), mgrports.TapSink),
+		tap.WithOTLPGatewaySubscriber(otlpGatewayReconcileChan, globals.TargetNamespace()),
+	)
+
+	if err := setupTracePipelineController(globals, envCfg, mgr, tracePipelineReconcileChan, secretWatchClient, eventRecorder, heartbeatSender); err != nil {
 		return fmt.Errorf("failed to enable trace pipeline controller: %w", err)
 	}
 
-	if err := setupOTLPGatewayController(globals, envCfg, mgr, otlpGatewayReconcileChan, nodeSizeTracker); err != nil {
+	if err := setupOTLPGatewayController(globals, envCfg, mgr, otlpGatewayReconcileChan, secretWatchClient, nodeSizeTracker, tapRegistry); err != nil {
 		return fmt.Errorf("failed to enable OTLP Gateway controller: %w", err)
 	}
 
-	if err := setupMetricPipelineController(globals, envCfg, mgr, metricPipelineReconcileChan, secretWatchClient, nodeSizeTracker); err != nil {
+	if err := setupMetricPipelineController(globals, envCfg, mgr, metricPipelineReconcileChan, secretWatchClient, nodeSizeTracker, eventRecorder, heartbeatSender); err != nil {
 		return fmt.Errorf("failed to enable metric pipeline controller: %w", err)
 	}
 
-	if err := setupLogPipelineController(globals, envCfg, mgr, logPipelineReconcileChan, secretWatchClient, nodeSizeTracker); err != nil {
+	if err := setupLogPipelineController(globals, envCfg, mgr, logPipelineReconcileChan, secretWatchClient, nodeSizeTracker, eventRecorder, heartbeatSender); err != nil {
 		return fmt.Errorf("failed to enable log pipeline controller: %w", err)
 	}
 
+	if err := setupTelemetryRouteController(globals, mgr, telemetryRouteReconcileChan, secretWatchClient, eventRecorder); err != nil {
+		return fmt.Errorf("failed to enable telemetry route controller: %w", err)
+	}
+
 	webhookCertConfig := createWebhookConfig(globals)
 
-	if err := setupTelemetryController(globals, envCfg, webhookCertConfig, mgr); err != nil {
+	if err := setupTelemetryController(globals, envCfg, webhookCertConfig, mgr, telemetryReconcileChan, eventRecorder); err != nil {
 		return fmt.Errorf("failed to enable telemetry module controller: %w", err)
 	}
 
@@ -310,8 +338,26 @@ func setupControllersAndWebhooks(mgr manager.Manager, globals config.Global, env
 		selfmonitorwebhook.WithTracePipelineSubscriber(tracePipelineReconcileChan),
 		selfmonitorwebhook.WithMetricPipelineSubscriber(metricPipelineReconcileChan),
 		selfmonitorwebhook.WithLogPipelineSubscriber(logPipelineReconcileChan),
+		selfmonitorwebhook.WithTelemetryRouteSubscriber(telemetryRouteReconcileChan),
 		selfmonitorwebhook.WithLogger(ctrl.Log.WithName("self-monitor-webhook"))))
 
+	mgr.GetWebhookServer().Register(tap.StreamPath, tap.NewStreamHandler(mgr.GetClient(), tapRegistry, ctrl.Log.WithName("tap")))
+
+	tapSinkServer := tap.NewSinkServer(
+		tap.NewSinkHandler(
+			mgr.GetClient(),
+			tapRegistry,
+			types.NamespacedName{Name: names.OTLPGateway, Namespace: globals.TargetNamespace()},
+			ctrl.Log.WithName("tap-sink"),
+		),
+		mgrports.TapSink,
+		certDir,
+		ctrl.Log.WithName("tap-sink"),
+	)
+	if err := mgr.Add(tapSinkServer); err != nil {
+		return fmt.Errorf("failed to add tap sink server: %w", err)
+	}
+
 	return nil
 }
 
@@ -477,7 +523,7 @@ func setupAdmissionsWebhooks(mgr manager.Manager) error {
 	return nil
 }
 
-func setupTelemetryController(globals config.Global, cfg envConfig, webhookCertConfig webhookcert.Config, mgr manager.Manager) error {
+func setupTelemetryController(globals config.Global, cfg envConfig, webhookCertConfig webhookcert.Config, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, eventRecorder commonstatus.EventRecorder) error {
 	setupLog.Info("Setting up telemetry controller")
 
 	selectedSelfMonitorImage := cfg.SelfMonitorImage
@@ -493,9 +539,11 @@ func setupTelemetryController(globals config.Global, cfg envConfig, webhookCertC
 			SelfMonitorImage:                  selectedSelfMonitorImage,
 			SelfMonitorPriorityClassName:      normalPriorityClassName,
 			WebhookCert:                       webhookCertConfig,
+			EventRecorder:                     eventRecorder,
 		},
 		mgr.GetClient(),
 		mgr.GetScheme(),
+		reconcileTriggerChan,
 	)
 
 	if err := telemetryController.SetupWithManager(mgr); err != nil {
@@ -505,7 +553,7 @@ func setupTelemetryController(globals config.Global, cfg envConfig, webhookCertC
 	return nil
 }
 
-func setupLogPipelineController(globals config.Global, cfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker) error {
+func setupLogPipelineController(globals config.Global, cfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker, eventRecorder commonstatus.EventRecorder, heartbeatTracker commonstatus.HeartbeatTracker) error {
 	setupLog.Info("Setting up logpipeline controller")
 
 	logPipelineController, err := telemetrycontrollers.NewLogPipelineController(
@@ -518,6 +566,8 @@ func setupLogPipelineController(globals config.Global, cfg envConfig, mgr manage
 			FluentBitPriorityClassName: highPriorityClassName,
 			LogAgentPriorityClassName:  highPriorityClassName,
 			RestConfig:                 mgr.GetConfig(),
+			EventRecorder:              eventRecorder,
+			HeartbeatTracker:           heartbeatTracker,
 		},
 		mgr.GetClient(),
 		reconcileTriggerChan,
@@ -535,7 +585,7 @@ func setupLogPipelineController(globals config.Global, cfg envConfig, mgr manage
 	return nil
 }
 
-func setupTracePipelineController(globals config.Global, envCfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client) error {
+func setupTracePipelineController(globals config.Global, envCfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, eventRecorder commonstatus.EventRecorder, heartbeatTracker commonstatus.HeartbeatTracker) error {
 	setupLog.Info("Setting up tracepipeline controller")
 
 	tracePipelineController, err := telemetrycontrollers.NewTracePipelineController(
@@ -543,6 +593,8 @@ func setupTracePipelineController(globals config.Global, envCfg envConfig, mgr m
 			Global:             globals,
 			RestConfig:         mgr.GetConfig(),
 			OTelCollectorImage: envCfg.OTelCollectorImage,
+			EventRecorder:      eventRecorder,
+			HeartbeatTracker:   heartbeatTracker,
 		},
 		mgr.GetClient(),
 		reconcileTriggerChan,
@@ -559,7 +611,30 @@ func setupTracePipelineController(globals config.Global, envCfg envConfig, mgr m
 	return nil
 }
 
-func setupOTLPGatewayController(globals config.Global, envCfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, nodeSizeTracker *nodesize.Tracker) error {
+func setupTelemetryRouteController(globals config.Global, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, eventRecorder commonstatus.EventRecorder) error {
+	setupLog.Info("Setting up telemetryroute controller")
+
+	telemetryRouteController, err := telemetrycontrollers.NewTelemetryRouteController(
+		telemetrycontrollers.TelemetryRouteControllerConfig{
+			Global:        globals,
+			EventRecorder: eventRecorder,
+		},
+		mgr.GetClient(),
+		reconcileTriggerChan,
+		secretWatchClient,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create telemetryroute controller: %w", err)
+	}
+
+	if err := telemetryRouteController.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to setup telemetryroute controller: %w", err)
+	}
+
+	return nil
+}
+
+func setupOTLPGatewayController(globals config.Global, envCfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker, tapRegistry *tap.Registry) error {
 	setupLog.Info("Setting up OTLP Gateway controller")
 
 	otlpGatewayController, err := telemetrycontrollers.NewOTLPGatewayController(
@@ -568,9 +643,15 @@ func setupOTLPGatewayController(globals config.Global, envCfg envConfig, mgr man
 			RestConfig:                   mgr.GetConfig(),
 			OTelCollectorImage:           envCfg.OTelCollectorImage,
 			OTLPGatewayPriorityClassName: highPriorityClassName,
+			TapRegistry:                  tapRegistry,
+			TapSinkCASecret: types.NamespacedName{
+				Name:      names.ManagerWebhookCertSecret,
+				Namespace: globals.TargetNamespace(),
+			},
 		},
 		mgr.GetClient(),
 		reconcileTriggerChan,
+		secretWatchClient,
 		nodeSizeTracker,
 	)
 	if err != nil {
@@ -584,7 +665,7 @@ func setupOTLPGatewayController(globals config.Global, envCfg envConfig, mgr man
 	return nil
 }
 
-func setupMetricPipelineController(globals config.Global, cfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker) error {
+func setupMetricPipelineController(globals config.Global, cfg envConfig, mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent, secretWatchClient *secretwatch.Client, nodeSizeTracker *nodesize.Tracker, eventRecorder commonstatus.EventRecorder, heartbeatTracker commonstatus.HeartbeatTracker) error {
 	setupLog.Info("Setting up metricpipeline controller")
 
 	metricPipelineController, err := telemetrycontrollers.NewMetricPipelineController(
@@ -593,6 +674,8 @@ func setupMetricPipelineController(globals config.Global, cfg envConfig, mgr man
 			MetricAgentPriorityClassName: highPriorityClassName,
 			OTelCollectorImage:           cfg.OTelCollectorImage,
 			RestConfig:                   mgr.GetConfig(),
+			EventRecorder:                eventRecorder,
+			HeartbeatTracker:             heartbeatTracker,
 		},
 		mgr.GetClient(),
 		reconcileTriggerChan,
@@ -0,0 +1,52 @@
+package misc
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	kitk8s "github.com/kyma-project/telemetry-manager/test/testkit/k8s"
+	"github.com/kyma-project/telemetry-manager/test/testkit/suite"
+)
+
+func TestRejectTelemetryRouteCreation(t *testing.T) {
+	suite.SetupTest(t, suite.LabelMisc)
+
+	tests := []struct {
+		name     string
+		route    telemetryv1beta1.TelemetryRoute
+		errorMsg string
+		field    string
+	}{
+		{
+			name: "serviceaccount-token",
+			route: testutils.NewTelemetryRouteBuilder().
+				WithOTLPOutput(testutils.OTLPServiceAccountToken("https://backend.example.com")).
+				Build(),
+			errorMsg: "Service account token authentication is not available for a TelemetryRoute",
+			field:    "spec.output",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.route.Name = tc.name
+
+			err := kitk8s.CreateObjects(t, &tc.route)
+
+			Expect(err).ShouldNot(Succeed(), "unexpected success for TelemetryRoute '%s', this test expects an error", tc.route.Name)
+
+			errStatus := &apierrors.StatusError{}
+
+			ok := errors.As(err, &errStatus)
+			Expect(ok).To(BeTrue(), "TelemetryRoute '%s' has wrong error type %s", tc.route.Name, err.Error())
+			Expect(errStatus.Status().Details).ToNot(BeNil(), "error of TelemetryRoute '%s' has no details %w", tc.route.Name, err.Error())
+			Expect(errStatus.Status().Details.Causes).To(HaveLen(1), "TelemetryRoute '%s' has more or less than 1 cause: %+v", tc.route.Name, errStatus.Status().Details.Causes)
+			Expect(errStatus.Status().Details.Causes[0].Field).To(Equal(tc.field), "the first error cause for TelemetryRoute '%s' does not contain expected field %s", tc.route.Name, tc.field)
+			Expect(errStatus.Status().Details.Causes[0].Message).Should(ContainSubstring(tc.errorMsg), "the error for TelemetryRoute '%s' does not contain expected message %s", tc.route.Name, tc.errorMsg)
+		})
+	}
+}
@@ -333,6 +333,17 @@ func TestRejectTracePipelineCreation(t *testing.T) {
 			errorMsg: "OAuth2 authentication requires TLS when using gRPC protocol",
 			field:    "spec.output.otlp",
 		},
+		{
+			name: "otlp-output-serviceaccount-token-reserved-audience",
+			pipeline: testutils.NewTracePipelineBuilder().
+				WithOTLPOutput(
+					testutils.OTLPEndpoint(backendEndpoint),
+					testutils.OTLPServiceAccountToken("telemetry-manager-tap-sink"),
+				).
+				Build(),
+			errorMsg: "The audience 'telemetry-manager-tap-sink' is reserved for the OTLP Gateway",
+			field:    "spec.output.otlp.authentication.serviceAccountToken",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,179 @@
+package selfmonitor
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/test/testkit/assert"
+	kitk8s "github.com/kyma-project/telemetry-manager/test/testkit/k8s"
+	kitk8sobjects "github.com/kyma-project/telemetry-manager/test/testkit/k8s/objects"
+	"github.com/kyma-project/telemetry-manager/test/testkit/kubeprep"
+	kitkyma "github.com/kyma-project/telemetry-manager/test/testkit/kyma"
+	logmatchers "github.com/kyma-project/telemetry-manager/test/testkit/matchers/log"
+	metricmatchers "github.com/kyma-project/telemetry-manager/test/testkit/matchers/metric"
+	tracematchers "github.com/kyma-project/telemetry-manager/test/testkit/matchers/trace"
+	kitbackend "github.com/kyma-project/telemetry-manager/test/testkit/mocks/backend"
+	"github.com/kyma-project/telemetry-manager/test/testkit/periodic"
+	"github.com/kyma-project/telemetry-manager/test/testkit/suite"
+	"github.com/kyma-project/telemetry-manager/test/testkit/unique"
+)
+
+const heartbeatServiceName = "telemetry-heartbeat"
+
+// TestHeartbeat verifies that, without any workload sending data, the synthetic heartbeat is delivered
+// to the backend of every pipeline with enabled heartbeat, and that it is reported in the pipeline status.
+// Pipelines without enabled heartbeat must not receive the heartbeat.
+func TestHeartbeat(t *testing.T) {
+	components := []string{
+		suite.LabelLogGateway,
+		suite.LabelMetricGateway,
+		suite.LabelTraces,
+	}
+
+	labels := []string{suite.LabelSelfMonitor, suite.LabelHeartbeat}
+	labels = append(labels, components...)
+
+	suite.SetupTestWithOptions(t, labels, kubeprep.WithGatewayReplicas(1))
+	enableDebugLogging(t)
+
+	type heartbeatEntry struct {
+		component           string
+		pipelineName        string
+		backend             *kitbackend.Backend
+		backendNoHeartbeat  *kitbackend.Backend
+		pipelineNoHeartbeat string
+	}
+
+	var (
+		allResources []client.Object
+		entries      []heartbeatEntry
+	)
+
+	for _, component := range components {
+		uniquePrefix := unique.Prefix(component)
+		backendNs := uniquePrefix("backend")
+		genNs := uniquePrefix("gen")
+
+		e := heartbeatEntry{
+			component:           component,
+			pipelineName:        fmt.Sprintf("selfmonitor-heartbeat-%s", component),
+			pipelineNoHeartbeat: fmt.Sprintf("selfmonitor-no-heartbeat-%s", component),
+			backend:             kitbackend.New(backendNs, signalTypeForComponent(component), kitbackend.WithName("heartbeat")),
+			backendNoHeartbeat:  kitbackend.New(backendNs, signalTypeForComponent(component), kitbackend.WithName("no-heartbeat")),
+		}
+
+		allResources = append(allResources,
+			kitk8sobjects.NewNamespace(backendNs).K8sObject(),
+			withHeartbeat(buildPipeline(component, e.pipelineName, genNs, e.backend)),
+			buildPipeline(component, e.pipelineNoHeartbeat, genNs, e.backendNoHeartbeat),
+		)
+		allResources = append(allResources, e.backend.K8sObjects()...)
+		allResources = append(allResources, e.backendNoHeartbeat.K8sObjects()...)
+
+		entries = append(entries, e)
+	}
+
+	Expect(kitk8s.CreateObjects(t, allResources...)).To(Succeed())
+
+	for _, component := range components {
+		logDiagnosticsOnFailure(t, component)
+	}
+
+	for _, e := range entries {
+		assert.BackendReachable(t, e.backend)
+		assert.BackendReachable(t, e.backendNoHeartbeat)
+	}
+
+	assert.DeploymentReady(t, kitkyma.SelfMonitorName)
+	assert.DaemonSetReady(t, kitkyma.OTLPGatewayName)
+
+	for _, e := range entries {
+		assertPipelineHealthy(t, e.component, e.pipelineName)
+		assert.BackendDataEventuallyMatches(t, e.backend, haveHeartbeat(e.component))
+		assertHeartbeatDelivered(t, e.component, e.pipelineName)
+	}
+
+	for _, e := range entries {
+		assert.BackendDataConsistentlyMatches(t, e.backendNoHeartbeat, Not(haveHeartbeat(e.component)))
+	}
+}
+
+// withHeartbeat enables the heartbeat for a pipeline built by buildPipeline.
+func withHeartbeat(pipeline client.Object) client.Object {
+	heartbeat := &telemetryv1beta1.Heartbeat{Enabled: true}
+
+	switch p := pipeline.(type) {
+	case *telemetryv1beta1.LogPipeline:
+		p.Spec.Heartbeat = heartbeat
+	case *telemetryv1beta1.MetricPipeline:
+		p.Spec.Heartbeat = heartbeat
+	case *telemetryv1beta1.TracePipeline:
+		p.Spec.Heartbeat = heartbeat
+	default:
+		panic(fmt.Sprintf("unsupported pipeline type: %T", pipeline))
+	}
+
+	return pipeline
+}
+
+// haveHeartbeat matches a backend response that contains the synthetic heartbeat of the given component.
+func haveHeartbeat(component string) gomegatypes.GomegaMatcher {
+	switch component {
+	case suite.LabelLogGateway:
+		return HaveHTTPBody(logmatchers.HaveFlatLogs(ContainElement(
+			logmatchers.HaveResourceAttributes(HaveKeyWithValue("service.name", heartbeatServiceName)),
+		)))
+	case suite.LabelMetricGateway:
+		return HaveHTTPBody(metricmatchers.HaveFlatMetrics(ContainElement(
+			metricmatchers.HaveResourceAttributes(HaveKeyWithValue("service.name", heartbeatServiceName)),
+		)))
+	case suite.LabelTraces:
+		return HaveHTTPBody(tracematchers.HaveFlatTraces(ContainElement(
+			tracematchers.HaveResourceAttributes(HaveKeyWithValue("service.name", heartbeatServiceName)),
+		)))
+	default:
+		panic("unsupported component: " + component)
+	}
+}
+
+// assertHeartbeatAccepted waits until the pipeline reports a heartbeat accepted by the OTLP Gateway in its status, and checks that the flow is healthy,
+// which means that the exporter sends the heartbeat.
+func assertHeartbeatDelivered(t *testing.T, component, pipelineName string) {
+	t.Helper()
+
+	t.Logf("Waiting for pipeline %s to report a delivered heartbeat", pipelineName)
+
+	key := types.NamespacedName{Name: pipelineName}
+
+	Eventually(func(g Gomega) {
+		var lastHeartbeatDelivered *metav1.Time
+
+		switch component {
+		case suite.LabelLogGateway:
+			var pipeline telemetryv1beta1.LogPipeline
+			g.Expect(suite.K8sClient.Get(t.Context(), key, &pipeline)).To(Succeed())
+			lastHeartbeatDelivered = pipeline.Status.LastHeartbeatDelivered
+		case suite.LabelMetricGateway:
+			var pipeline telemetryv1beta1.MetricPipeline
+			g.Expect(suite.K8sClient.Get(t.Context(), key, &pipeline)).To(Succeed())
+			lastHeartbeatDelivered = pipeline.Status.LastHeartbeatDelivered
+		case suite.LabelTraces:
+			var pipeline telemetryv1beta1.TracePipeline
+			g.Expect(suite.K8sClient.Get(t.Context(), key, &pipeline)).To(Succeed())
+			lastHeartbeatDelivered = pipeline.Status.LastHeartbeatDelivered
+		default:
+			panic("unsupported component: " + component)
+		}
+
+		g.Expect(lastHeartbeatDelivered).NotTo(BeNil(), "component %s: no heartbeat delivered", component)
+	}, periodic.FlowHealthConditionTransitionTimeout, periodic.TelemetryInterval).Should(Succeed())
+
+	assertFlowHealthyNow(NewWithT(t), t, component, pipelineName)
+}
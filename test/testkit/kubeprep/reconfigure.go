@@ -203,6 +203,7 @@ func waitForCRDsDeletion(t TestingT, k8sClient client.Client) error {
 		"logpipelines.telemetry.kyma-project.io",
 		"tracepipelines.telemetry.kyma-project.io",
 		"metricpipelines.telemetry.kyma-project.io",
+		"telemetryroutes.telemetry.kyma-project.io",
 		"telemetries.operator.kyma-project.io",
 		"logparsers.telemetry.kyma-project.io",
 	}
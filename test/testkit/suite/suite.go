@@ -140,6 +140,8 @@ const (
 	LabelBackpressure = "backpressure"
 	// LabelOutage defines the label for outage scenario selfmonitor tests
 	LabelOutage = "outage"
+	// LabelHeartbeat defines the label for heartbeat scenario selfmonitor tests
+	LabelHeartbeat = "heartbeat"
 
 	// LabelCustomLabelAnnotation defines the label for custom label/annotation tests
 	LabelCustomLabelAnnotation = "custom-label-annotation"
@@ -47,6 +47,12 @@ func (ld defaulter) Default(ctx context.Context, pipeline *telemetryv1alpha1.Log
 		pipeline.Spec.Output.OTLP.Protocol = ld.DefaultOTLPProtocol
 	}
 
+	for i := range pipeline.Spec.Routes {
+		if pipeline.Spec.Routes[i].Output.OTLP != nil && pipeline.Spec.Routes[i].Output.OTLP.Protocol == "" {
+			pipeline.Spec.Routes[i].Output.OTLP.Protocol = ld.DefaultOTLPProtocol
+		}
+	}
+
 	return nil
 }
 
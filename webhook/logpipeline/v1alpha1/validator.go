@@ -47,6 +47,25 @@ func validate(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) (adm
 		return nil, err
 	}
 
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	routes, err := webhookutils.ConvertRoutesToBeta(pipeline.Spec.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRoutes(ctx, routes); err != nil {
+		return nil, err
+	}
+
+	if err := webhookutils.ValidateRoutesWithoutAgentInput(routes, isApplicationInputEnabled(&pipeline.Spec.Input)); err != nil {
+		return nil, err
+	}
+
 	if isCustomFilterDefined(pipeline.Spec.FluentBitFilters) {
 		warnings = append(warnings, renderDeprecationWarning(pipeline.Name, "filters"))
 	}
@@ -120,3 +139,12 @@ func isFilesDefined(v []telemetryv1alpha1.FluentBitFile) bool {
 func isApplicationInputEnabled(i *telemetryv1alpha1.LogPipelineInput) bool {
 	return i.Application != nil && ptr.Deref(i.Application.Enabled, false)
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeLog, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForOtelLogPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -46,6 +46,12 @@ func (ld defaulter) Default(ctx context.Context, pipeline *telemetryv1beta1.LogP
 		pipeline.Spec.Output.OTLP.Protocol = ld.DefaultOTLPOutputProtocol
 	}
 
+	for i := range pipeline.Spec.Routes {
+		if pipeline.Spec.Routes[i].Output.OTLP != nil && pipeline.Spec.Routes[i].Output.OTLP.Protocol == "" {
+			pipeline.Spec.Routes[i].Output.OTLP.Protocol = ld.DefaultOTLPOutputProtocol
+		}
+	}
+
 	if isOTLPPipeline(pipeline) {
 		if pipeline.Spec.Input.OTLP == nil {
 			pipeline.Spec.Input.OTLP = &telemetryv1beta1.OTLPInput{}
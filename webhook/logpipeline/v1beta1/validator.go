@@ -41,6 +41,20 @@ func validate(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) (admi
 		return nil, err
 	}
 
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateRoutes(ctx, pipeline.Spec.Routes); err != nil {
+		return nil, err
+	}
+
+	if err := webhookutils.ValidateRoutesWithoutAgentInput(pipeline.Spec.Routes, logpipelineutils.IsAgentRequired(pipeline)); err != nil {
+		return nil, err
+	}
+
 	if logpipelineutils.IsCustomFilterDefined(pipeline.Spec.FluentBitFilters) {
 		warnings = append(warnings, renderDeprecationWarning(pipeline.Name, "filters"))
 	}
@@ -84,3 +98,12 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeLog, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForOtelLogPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
 )
 
 func TestLogPipelineValidator_ValidateCreate(t *testing.T) {
@@ -74,6 +75,25 @@ func TestLogPipelineValidator_ValidateCreate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "routes with otlp input",
+			pipeline: new(testutils.NewLogPipelineBuilder().
+				WithRuntimeInput(false).
+				WithOTLPInput(true).
+				WithOTLPOutput().
+				WithRoute("errors", []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}).
+				Build()),
+			expectErr: false,
+		},
+		{
+			name: "routes with runtime input",
+			pipeline: new(testutils.NewLogPipelineBuilder().
+				WithRuntimeInput(true).
+				WithOTLPOutput().
+				WithRoute("errors", []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}).
+				Build()),
+			expectErr: true,
+		},
 		{
 			name: "empty fields - should pass",
 			pipeline: &telemetryv1beta1.LogPipeline{
@@ -14,14 +14,21 @@ func TestDefault(t *testing.T) {
 	sut := defaulter{
 		ExcludeNamespaces: namespaces.System(),
 		RuntimeInputResources: runtimeInputResourceDefaults{
-			Pod:         true,
-			Container:   true,
-			Node:        true,
-			Volume:      true,
-			DaemonSet:   true,
-			Deployment:  true,
-			StatefulSet: true,
-			Job:         true,
+			Pod:                     true,
+			Container:               true,
+			Node:                    true,
+			Volume:                  true,
+			DaemonSet:               true,
+			Deployment:              true,
+			StatefulSet:             true,
+			Job:                     true,
+			ReplicaSet:              false,
+			CronJob:                 false,
+			HorizontalPodAutoscaler: false,
+			PersistentVolumeClaim:   false,
+			ResourceQuota:           false,
+			Namespace:               false,
+			Host:                    false,
 		},
 		DefaultOTLPOutputProtocol:    telemetryv1alpha1.OTLPProtocolGRPC,
 		DefaultOTLPOutputTemporality: telemetryv1beta1.TemporalityPreserve,
@@ -216,6 +223,34 @@ func TestDefault(t *testing.T) {
 								DaemonSet: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
 									Enabled: new(true),
 								},
+
+								ReplicaSet: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								CronJob: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								HorizontalPodAutoscaler: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								PersistentVolumeClaim: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								ResourceQuota: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Namespace: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Host: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
 							},
 						},
 					},
@@ -285,6 +320,34 @@ func TestDefault(t *testing.T) {
 								DaemonSet: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
 									Enabled: new(true),
 								},
+
+								ReplicaSet: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								CronJob: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								HorizontalPodAutoscaler: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								PersistentVolumeClaim: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								ResourceQuota: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Namespace: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Host: &telemetryv1alpha1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
 							},
 						},
 					},
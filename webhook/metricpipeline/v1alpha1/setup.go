@@ -13,14 +13,21 @@ func SetupWithManager(mgr ctrl.Manager) error {
 		WithDefaulter(&defaulter{
 			ExcludeNamespaces: namespaces.System(),
 			RuntimeInputResources: runtimeInputResourceDefaults{
-				Pod:         true,
-				Container:   true,
-				Node:        true,
-				Volume:      true,
-				DaemonSet:   true,
-				Deployment:  true,
-				StatefulSet: true,
-				Job:         true,
+				Pod:                     true,
+				Container:               true,
+				Node:                    true,
+				Volume:                  true,
+				DaemonSet:               true,
+				Deployment:              true,
+				StatefulSet:             true,
+				Job:                     true,
+				ReplicaSet:              false,
+				CronJob:                 false,
+				HorizontalPodAutoscaler: false,
+				PersistentVolumeClaim:   false,
+				ResourceQuota:           false,
+				Namespace:               false,
+				Host:                    false,
 			},
 			DefaultOTLPOutputProtocol:    telemetryv1alpha1.OTLPProtocolGRPC,
 			DefaultOTLPOutputTemporality: telemetryv1beta1.TemporalityPreserve,
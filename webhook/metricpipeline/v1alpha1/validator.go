@@ -10,6 +10,7 @@ import (
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	webhookutils "github.com/kyma-project/telemetry-manager/webhook/utils"
 )
@@ -41,12 +42,31 @@ func validate(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) (
 		return nil, err
 	}
 
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	routes, err := webhookutils.ConvertRoutesToBeta(pipeline.Spec.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRoutes(ctx, routes); err != nil {
+		return nil, err
+	}
+
 	// validate runtime additional metrics
 	v1beta1MetricPipeline := &telemetryv1beta1.MetricPipeline{}
 	if err := pipeline.ConvertTo(v1beta1MetricPipeline); err != nil {
 		return nil, err
 	}
 
+	if err := webhookutils.ValidateRoutesWithoutAgentInput(routes, metricpipelineutils.IsAgentRequired(v1beta1MetricPipeline)); err != nil {
+		return nil, err
+	}
+
 	runtimeAdditionalMetricsValidator := &runtimemetrics.Validator{}
 	if err := runtimeAdditionalMetricsValidator.Validate(v1beta1MetricPipeline); err != nil {
 		return nil, err
@@ -63,3 +83,12 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeMetric, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForMetricPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -24,14 +24,21 @@ type defaulter struct {
 }
 
 type runtimeInputResourceDefaults struct {
-	Pod         bool
-	Container   bool
-	Node        bool
-	Volume      bool
-	DaemonSet   bool
-	Deployment  bool
-	StatefulSet bool
-	Job         bool
+	Pod                     bool
+	Container               bool
+	Node                    bool
+	Volume                  bool
+	DaemonSet               bool
+	Deployment              bool
+	StatefulSet             bool
+	Job                     bool
+	ReplicaSet              bool
+	CronJob                 bool
+	HorizontalPodAutoscaler bool
+	PersistentVolumeClaim   bool
+	ResourceQuota           bool
+	Namespace               bool
+	Host                    bool
 }
 
 func (md defaulter) Default(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
@@ -104,6 +111,12 @@ func (md defaulter) applyOTLPOutputDefaults(pipeline *telemetryv1beta1.MetricPip
 		pipeline.Spec.Output.OTLP.Protocol = md.DefaultOTLPOutputProtocol
 	}
 
+	for i := range pipeline.Spec.Routes {
+		if pipeline.Spec.Routes[i].Output.OTLP != nil && pipeline.Spec.Routes[i].Output.OTLP.Protocol == "" {
+			pipeline.Spec.Routes[i].Output.OTLP.Protocol = md.DefaultOTLPOutputProtocol
+		}
+	}
+
 	if pipeline.Spec.Output.OTLP != nil && pipeline.Spec.Output.OTLP.Temporality == nil {
 		pipeline.Spec.Output.OTLP.Temporality = &md.DefaultOTLPOutputTemporality
 	}
@@ -161,4 +174,45 @@ func (md defaulter) applyRuntimeInputResourceDefaults(pipeline *telemetryv1beta1
 			Enabled: &md.RuntimeInputResources.Job,
 		}
 	}
+
+	if pipeline.Spec.Input.Runtime.Resources.ReplicaSet == nil {
+		pipeline.Spec.Input.Runtime.Resources.ReplicaSet = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.ReplicaSet,
+		}
+	}
+
+	if pipeline.Spec.Input.Runtime.Resources.CronJob == nil {
+		pipeline.Spec.Input.Runtime.Resources.CronJob = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.CronJob,
+		}
+	}
+
+	if pipeline.Spec.Input.Runtime.Resources.HorizontalPodAutoscaler == nil {
+		pipeline.Spec.Input.Runtime.Resources.HorizontalPodAutoscaler = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.HorizontalPodAutoscaler,
+		}
+	}
+
+	if pipeline.Spec.Input.Runtime.Resources.PersistentVolumeClaim == nil {
+		pipeline.Spec.Input.Runtime.Resources.PersistentVolumeClaim = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.PersistentVolumeClaim,
+		}
+	}
+
+	if pipeline.Spec.Input.Runtime.Resources.ResourceQuota == nil {
+		pipeline.Spec.Input.Runtime.Resources.ResourceQuota = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.ResourceQuota,
+		}
+	}
+
+	if pipeline.Spec.Input.Runtime.Resources.Namespace == nil {
+		pipeline.Spec.Input.Runtime.Resources.Namespace = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.Namespace,
+		}
+	}
+	if pipeline.Spec.Input.Runtime.Resources.Host == nil {
+		pipeline.Spec.Input.Runtime.Resources.Host = &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+			Enabled: &md.RuntimeInputResources.Host,
+		}
+	}
 }
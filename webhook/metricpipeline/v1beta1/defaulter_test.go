@@ -14,14 +14,21 @@ func TestDefault(t *testing.T) {
 		ExcludeNamespaces: namespaces.System(),
 		OTLPInputEnabled:  true,
 		RuntimeInputResources: runtimeInputResourceDefaults{
-			Pod:         true,
-			Container:   true,
-			Node:        true,
-			Volume:      true,
-			DaemonSet:   true,
-			Deployment:  true,
-			StatefulSet: true,
-			Job:         true,
+			Pod:                     true,
+			Container:               true,
+			Node:                    true,
+			Volume:                  true,
+			DaemonSet:               true,
+			Deployment:              true,
+			StatefulSet:             true,
+			Job:                     true,
+			ReplicaSet:              false,
+			CronJob:                 false,
+			HorizontalPodAutoscaler: false,
+			PersistentVolumeClaim:   false,
+			ResourceQuota:           false,
+			Namespace:               false,
+			Host:                    false,
 		},
 		DefaultOTLPOutputProtocol:    telemetryv1beta1.OTLPProtocolGRPC,
 		DefaultOTLPOutputTemporality: telemetryv1beta1.TemporalityPreserve,
@@ -225,6 +232,34 @@ func TestDefault(t *testing.T) {
 								DaemonSet: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
 									Enabled: new(true),
 								},
+
+								ReplicaSet: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								CronJob: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								HorizontalPodAutoscaler: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								PersistentVolumeClaim: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								ResourceQuota: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Namespace: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Host: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
 							},
 						},
 					},
@@ -294,6 +329,34 @@ func TestDefault(t *testing.T) {
 								DaemonSet: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
 									Enabled: new(true),
 								},
+
+								ReplicaSet: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								CronJob: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								HorizontalPodAutoscaler: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								PersistentVolumeClaim: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								ResourceQuota: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Namespace: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
+
+								Host: &telemetryv1beta1.MetricPipelineRuntimeInputResource{
+									Enabled: new(false),
+								},
 							},
 						},
 					},
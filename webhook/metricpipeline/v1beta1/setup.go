@@ -12,14 +12,21 @@ func SetupWithManager(mgr ctrl.Manager) error {
 		WithDefaulter(&defaulter{
 			ExcludeNamespaces: namespaces.System(),
 			RuntimeInputResources: runtimeInputResourceDefaults{
-				Pod:         true,
-				Container:   true,
-				Node:        true,
-				Volume:      true,
-				DaemonSet:   true,
-				Deployment:  true,
-				StatefulSet: true,
-				Job:         true,
+				Pod:                     true,
+				Container:               true,
+				Node:                    true,
+				Volume:                  true,
+				DaemonSet:               true,
+				Deployment:              true,
+				StatefulSet:             true,
+				Job:                     true,
+				ReplicaSet:              false,
+				CronJob:                 false,
+				HorizontalPodAutoscaler: false,
+				PersistentVolumeClaim:   false,
+				ResourceQuota:           false,
+				Namespace:               false,
+				Host:                    false,
 			},
 			OTLPInputEnabled:             true,
 			DefaultOTLPOutputProtocol:    telemetryv1beta1.OTLPProtocolGRPC,
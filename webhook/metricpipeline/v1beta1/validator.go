@@ -9,6 +9,7 @@ import (
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	webhookutils "github.com/kyma-project/telemetry-manager/webhook/utils"
 )
@@ -35,6 +36,20 @@ func validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (a
 		return nil, err
 	}
 
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateRoutes(ctx, pipeline.Spec.Routes); err != nil {
+		return nil, err
+	}
+
+	if err := webhookutils.ValidateRoutesWithoutAgentInput(pipeline.Spec.Routes, metricpipelineutils.IsAgentRequired(pipeline)); err != nil {
+		return nil, err
+	}
+
 	runtimeAdditionalMetricsValidator := &runtimemetrics.Validator{}
 	if err := runtimeAdditionalMetricsValidator.Validate(pipeline); err != nil {
 		return nil, err
@@ -51,3 +66,12 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeMetric, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForMetricPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -68,6 +68,22 @@ func TestMetricPipelineValidator_ValidateCreate_ValidateUpdate(t *testing.T) {
 				Build(),
 			expectErr: true,
 		},
+		{
+			name: "routes with otlp input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithRoute("errors", []string{`metric.name == "http.server.errors"`}).
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "routes with prometheus input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithPrometheusInput(true).
+				WithRoute("errors", []string{`metric.name == "http.server.errors"`}).
+				Build(),
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
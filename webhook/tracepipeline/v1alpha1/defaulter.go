@@ -20,5 +20,11 @@ func (td defaulter) Default(ctx context.Context, pipeline *telemetryv1alpha1.Tra
 		pipeline.Spec.Output.OTLP.Protocol = td.DefaultOTLPOutputProtocol
 	}
 
+	for i := range pipeline.Spec.Routes {
+		if pipeline.Spec.Routes[i].Output.OTLP != nil && pipeline.Spec.Routes[i].Output.OTLP.Protocol == "" {
+			pipeline.Spec.Routes[i].Output.OTLP.Protocol = td.DefaultOTLPOutputProtocol
+		}
+	}
+
 	return nil
 }
@@ -36,7 +36,26 @@ func validate(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) (a
 		return nil, err
 	}
 
-	return nil, validateFilterTransform(ctx, filterSpec, transformSpec)
+	if err := validateFilterTransform(ctx, filterSpec, transformSpec); err != nil {
+		return nil, err
+	}
+
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	routes, err := webhookutils.ConvertRoutesToBeta(pipeline.Spec.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRoutes(ctx, routes); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
 }
 
 func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.FilterSpec, transformSpec []telemetryv1beta1.TransformSpec) error {
@@ -47,3 +66,12 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeTrace, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForTracePipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -58,6 +58,35 @@ func TestDefault(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should set default OTLP protocol of route outputs if not set",
+			input: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Output: telemetryv1beta1.TracePipelineOutput{
+						OTLP: &telemetryv1beta1.OTLPOutput{
+							Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+						},
+					},
+					Routes: []telemetryv1beta1.OutputRoute{
+						{Name: "a", Output: telemetryv1beta1.OutputRouteOutput{OTLP: &telemetryv1beta1.OTLPOutput{}}},
+						{Name: "b", Output: telemetryv1beta1.OutputRouteOutput{OTLP: &telemetryv1beta1.OTLPOutput{Protocol: telemetryv1beta1.OTLPProtocolHTTP}}},
+					},
+				},
+			},
+			expected: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Output: telemetryv1beta1.TracePipelineOutput{
+						OTLP: &telemetryv1beta1.OTLPOutput{
+							Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+						},
+					},
+					Routes: []telemetryv1beta1.OutputRoute{
+						{Name: "a", Output: telemetryv1beta1.OutputRouteOutput{OTLP: &telemetryv1beta1.OTLPOutput{Protocol: telemetryv1beta1.OTLPProtocolGRPC}}},
+						{Name: "b", Output: telemetryv1beta1.OutputRouteOutput{OTLP: &telemetryv1beta1.OTLPOutput{Protocol: telemetryv1beta1.OTLPProtocolHTTP}}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -29,8 +29,22 @@ func (v *validator) ValidateDelete(_ context.Context, pipeline *telemetryv1beta1
 	return nil, nil
 }
 
-func validate(ctx context.Context, obj *telemetryv1beta1.TracePipeline) (admission.Warnings, error) {
-	return nil, validateFilterTransform(ctx, obj.Spec.Filters, obj.Spec.Transforms)
+func validate(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) (admission.Warnings, error) {
+	if err := validateFilterTransform(ctx, pipeline.Spec.Filters, pipeline.Spec.Transforms); err != nil {
+		return nil, err
+	}
+
+	if pipeline.Spec.Redaction != nil {
+		if err := webhookutils.ValidateRedactionPatterns(pipeline.Spec.Redaction.Patterns); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateRoutes(ctx, pipeline.Spec.Routes); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
 }
 
 func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.FilterSpec, transformSpec []telemetryv1beta1.TransformSpec) error {
@@ -41,3 +55,12 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validateRoutes(ctx context.Context, routes []telemetryv1beta1.OutputRoute) error {
+	err := webhookutils.ValidateRouteConditions(ctx, pipelines.SignalTypeTrace, routes)
+	if err != nil {
+		return fmt.Errorf(conditions.MessageForTracePipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
+	}
+
+	return nil
+}
@@ -41,6 +41,39 @@ func TestTracePipelineValidator_ValidateCreate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "invalid redaction pattern",
+			pipeline: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Redaction: &telemetryv1beta1.Redaction{
+						Patterns: []string{`customer-[0-9+`},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid route condition",
+			pipeline: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Routes: []telemetryv1beta1.OutputRoute{
+						{Name: "errors", Conditions: []string{`span.status.code ==`}},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid route condition",
+			pipeline: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Routes: []telemetryv1beta1.OutputRoute{
+						{Name: "errors", Conditions: []string{`span.status.code == STATUS_CODE_ERROR`}},
+					},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "empty fields - should pass",
 			pipeline: &telemetryv1beta1.TracePipeline{
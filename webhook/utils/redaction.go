@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ValidateRedactionPatterns checks that the custom redaction patterns are valid regular expressions in RE2 syntax
+func ValidateRedactionPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid redaction pattern '%s': %w", pattern, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRedactionPatterns(t *testing.T) {
+	require.NoError(t, ValidateRedactionPatterns(nil))
+	require.NoError(t, ValidateRedactionPatterns([]string{`customer-[0-9]+`, `(?i)password=\S+`}))
+
+	err := ValidateRedactionPatterns([]string{`customer-[0-9]+`, `password=(\S+`})
+	require.ErrorContains(t, err, "invalid redaction pattern 'password=(\\S+'")
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+)
+
+// ErrRoutesWithAgentInput is returned if a pipeline with output routes has an input that is collected by an agent
+var ErrRoutesWithAgentInput = errors.New("routes are only supported for pipelines that use the otlp input only, because the agents collecting the other inputs do not apply routes")
+
+// ValidateRoutesWithoutAgentInput checks that a pipeline with output routes has no input that is collected by an agent.
+// Only the OTLP Gateway applies the routes, so the data of an agent would bypass them.
+func ValidateRoutesWithoutAgentInput(routes []telemetryv1beta1.OutputRoute, agentRequired bool) error {
+	if len(routes) > 0 && agentRequired {
+		return ErrRoutesWithAgentInput
+	}
+
+	return nil
+}
+
+// ValidateRouteConditions checks that the conditions of the output routes are valid OTTL conditions for the given signal type
+func ValidateRouteConditions(ctx context.Context, signalType pipelines.SignalType, routes []telemetryv1beta1.OutputRoute) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	conditionValidator, err := ottl.NewFilterSpecValidator(signalType)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Error(err, "Failed to instantiate condition validator")
+		return errFailedToCreatePipeline
+	}
+
+	for _, route := range routes {
+		if err := conditionValidator.ValidateConditions(route.Conditions); err != nil {
+			return fmt.Errorf("route '%s': %w", route.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func ConvertRoutesToBeta(routes []telemetryv1alpha1.OutputRoute) ([]telemetryv1beta1.OutputRoute, error) {
+	return convertSlice(routes, telemetryv1alpha1.Convert_v1alpha1_OutputRoute_To_v1beta1_OutputRoute)
+}
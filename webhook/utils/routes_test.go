@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+func TestValidateRouteConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		signalType  pipelines.SignalType
+		routes      []telemetryv1beta1.OutputRoute
+		expectedErr string
+	}{
+		{
+			name:       "no routes",
+			signalType: pipelines.SignalTypeLog,
+		},
+		{
+			name:       "valid conditions",
+			signalType: pipelines.SignalTypeLog,
+			routes: []telemetryv1beta1.OutputRoute{
+				{Name: "errors", Conditions: []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}},
+				{Name: "payments", Conditions: []string{`resource.attributes["k8s.namespace.name"] == "payments"`, `log.attributes["team"] == "payments"`}},
+			},
+		},
+		{
+			name:       "invalid condition",
+			signalType: pipelines.SignalTypeTrace,
+			routes: []telemetryv1beta1.OutputRoute{
+				{Name: "slow", Conditions: []string{"span.end_time - span.start_time >"}},
+			},
+			expectedErr: "route 'slow'",
+		},
+		{
+			name:       "path of another signal type",
+			signalType: pipelines.SignalTypeMetric,
+			routes: []telemetryv1beta1.OutputRoute{
+				{Name: "errors", Conditions: []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}},
+			},
+			expectedErr: "route 'errors'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRouteConditions(t.Context(), tt.signalType, tt.routes)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestValidateRoutesWithoutAgentInput(t *testing.T) {
+	routes := []telemetryv1beta1.OutputRoute{
+		{Name: "errors", Conditions: []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}},
+	}
+
+	assert.NoError(t, ValidateRoutesWithoutAgentInput(nil, true))
+	assert.NoError(t, ValidateRoutesWithoutAgentInput(routes, false))
+	assert.ErrorIs(t, ValidateRoutesWithoutAgentInput(routes, true), ErrRoutesWithAgentInput)
+}
+
+func TestConvertRoutesToBeta(t *testing.T) {
+	routes, err := ConvertRoutesToBeta([]telemetryv1alpha1.OutputRoute{
+		{
+			Name:       "errors",
+			Conditions: []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"},
+			Output: telemetryv1alpha1.OutputRouteOutput{
+				OTLP: &telemetryv1alpha1.OTLPOutput{Endpoint: telemetryv1alpha1.ValueType{Value: "errors.example.com:4317"}},
+			},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, routes, 1)
+	assert.Equal(t, "errors", routes[0].Name)
+	assert.Equal(t, []string{"log.severity_number >= SEVERITY_NUMBER_ERROR"}, routes[0].Conditions)
+	assert.Equal(t, "errors.example.com:4317", routes[0].Output.OTLP.Endpoint.Value)
+}